@@ -8,14 +8,19 @@ import (
 	"fmt"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
 	imds_sdkv2 "github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
 	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
 	"github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -38,6 +43,7 @@ type Config struct {
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
+	NamingConfig                   *create.NamingConfig
 	Profile                        string
 	Region                         string
 	RetryMode                      aws_sdkv2.RetryMode
@@ -153,6 +159,13 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	// Record AWS API errors in the Context of the call so that interceptors can describe them.
+	cfg.APIOptions = append(cfg.APIOptions, recordAWSErrorsMiddleware)
+	sess.Handlers.Complete.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: "tf.RecordAWSErrors",
+		Fn:   recordAWSErrorsHandler,
+	})
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
@@ -183,6 +196,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.DefaultTagsConfig = c.DefaultTagsConfig
 	client.DNSSuffix = DNSSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
+	client.NamingConfig = c.NamingConfig
 	client.Partition = partition
 	client.Region = c.Region
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
@@ -202,6 +216,31 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	return client, diags
 }
 
+// recordAWSErrorsMiddleware adds AWS SDK for Go v2 middleware that records API errors in the Context of the call.
+func recordAWSErrorsMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("RecordAWSErrors", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		out, metadata, err := next.HandleInitialize(ctx, in)
+
+		if err != nil {
+			// The client wraps the error in an operation error after the middleware stack returns.
+			errs.RecordAWSError(ctx, &smithy.OperationError{
+				ServiceID:     awsmiddleware_sdkv2.GetServiceID(ctx),
+				OperationName: awsmiddleware_sdkv2.GetOperationName(ctx),
+				Err:           err,
+			})
+		}
+
+		return out, metadata, err
+	}), middleware.After)
+}
+
+// recordAWSErrorsHandler is an AWS SDK for Go v1 request handler that records API errors in the Context of the request.
+func recordAWSErrorsHandler(r *request_sdkv1.Request) {
+	if r.Error != nil {
+		errs.RecordAWSError(r.Context(), r.Error)
+	}
+}
+
 func baseSeverityToSdkSeverity(s basediag.Severity) diag.Severity {
 	switch s {
 	case basediag.SeverityWarning:
@@ -4,20 +4,23 @@
 package create
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 )
 
-// Name returns in order the name if non-empty, a prefix generated name if non-empty, or fully generated name prefixed with terraform-
-func Name(name string, namePrefix string) string {
-	return NameWithSuffix(name, namePrefix, "")
+// Name returns in order the name if non-empty, a prefix generated name if non-empty, a name generated from any
+// provider naming template, or fully generated name prefixed with terraform-
+func Name(ctx context.Context, name string, namePrefix string) string {
+	return NameWithSuffix(ctx, name, namePrefix, "")
 }
 
-// NameWithSuffix returns in order the name if non-empty, a prefix generated name if non-empty, or fully generated name prefixed with "terraform-".
-// In the latter two cases, any suffix is appended to the generated name
-func NameWithSuffix(name string, namePrefix string, nameSuffix string) string {
+// NameWithSuffix returns in order the name if non-empty, a prefix generated name if non-empty, a name generated from any
+// provider naming template, or fully generated name prefixed with "terraform-".
+// In the latter three cases, any suffix is appended to the generated name
+func NameWithSuffix(ctx context.Context, name string, namePrefix string, nameSuffix string) string {
 	if name != "" {
 		return name
 	}
@@ -26,6 +29,10 @@ func NameWithSuffix(name string, namePrefix string, nameSuffix string) string {
 		return id.PrefixedUniqueId(namePrefix) + nameSuffix
 	}
 
+	if v, ok := nameFromTemplate(ctx); ok {
+		return v + nameSuffix
+	}
+
 	return id.UniqueId() + nameSuffix
 }
 
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package create
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+)
+
+const (
+	// Names of the values available to naming templates in addition to any configured variables.
+	NamingTemplatePurpose      = "purpose"
+	NamingTemplateResourceType = "resource_type"
+	NamingTemplateService      = "service"
+)
+
+// NamingConfig contains the provider's resource naming configuration.
+type NamingConfig struct {
+	// Template is the default template used to generate a resource's name when neither the name nor name prefix is configured.
+	Template string
+	// Overrides maps resource type names to templates used instead of the default template.
+	Overrides map[string]string
+	// PurposeTag is the key of the resource tag whose value is available to templates as purpose.
+	PurposeTag string
+	// Variables are the values available to templates, in addition to purpose, resource_type and service.
+	Variables map[string]string
+}
+
+// TemplateFor returns the naming template for the specified resource type.
+// An empty string is returned if no template applies.
+func (c *NamingConfig) TemplateFor(resourceType string) string {
+	if c == nil {
+		return ""
+	}
+
+	if v, ok := c.Overrides[resourceType]; ok {
+		return v
+	}
+
+	return c.Template
+}
+
+// Validate returns an error if any of the configured templates can't be used to generate a name.
+// Each template must call unique so that a second resource of the same type doesn't get the same name.
+func (c *NamingConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	for _, k := range []string{NamingTemplatePurpose, NamingTemplateResourceType, NamingTemplateService} {
+		if _, ok := c.Variables[k]; ok {
+			return fmt.Errorf("variable (%s) is reserved", k)
+		}
+	}
+
+	if c.Template != "" {
+		if err := c.validateTemplate("aws_example"); err != nil {
+			return fmt.Errorf("template: %w", err)
+		}
+	}
+
+	for resourceType := range c.Overrides {
+		if err := c.validateTemplate(resourceType); err != nil {
+			return fmt.Errorf("override (%s): %w", resourceType, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *NamingConfig) validateTemplate(resourceType string) error {
+	name1, err := c.ExampleName(resourceType, "example")
+
+	if err != nil {
+		return err
+	}
+
+	name2, err := c.ExampleName(resourceType, "example")
+
+	if err != nil {
+		return err
+	}
+
+	if name1 == name2 {
+		return fmt.Errorf("template (%s) must call unique, otherwise every resource of a type is given the same name", c.TemplateFor(resourceType))
+	}
+
+	return nil
+}
+
+// ExampleName generates a name for the specified resource type from its naming template,
+// using an example value for the purpose tag.
+func (c *NamingConfig) ExampleName(resourceType, servicePackageName string) (string, error) {
+	var tags map[string]string
+
+	if c.PurposeTag != "" {
+		tags = map[string]string{c.PurposeTag: "example"}
+	}
+
+	return c.Name(resourceType, servicePackageName, tags)
+}
+
+// Name generates a name for the specified resource type from its naming template.
+// The resource's tags supply the value of purpose.
+func (c *NamingConfig) Name(resourceType, servicePackageName string, tags map[string]string) (string, error) {
+	text := c.TemplateFor(resourceType)
+
+	if text == "" {
+		return "", fmt.Errorf("no naming template for %s", resourceType)
+	}
+
+	tmpl, err := template.New(resourceType).Option("missingkey=error").Funcs(namingTemplateFuncs).Parse(text)
+
+	if err != nil {
+		return "", err
+	}
+
+	data := make(map[string]string, len(c.Variables)+3)
+	for k, v := range c.Variables {
+		data[k] = v
+	}
+	if v, ok := tags[c.PurposeTag]; ok && c.PurposeTag != "" {
+		data[NamingTemplatePurpose] = v
+	}
+	data[NamingTemplateResourceType] = resourceType
+	data[NamingTemplateService] = servicePackageName
+
+	var sb strings.Builder
+
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", err
+	}
+
+	name := sb.String()
+
+	if name == "" {
+		return "", fmt.Errorf("template generated an empty name")
+	}
+
+	return name, nil
+}
+
+var namingTemplateFuncs = template.FuncMap{
+	"lower":   strings.ToLower,
+	"replace": func(old, new, s string) string { return strings.ReplaceAll(s, old, new) },
+	"unique":  func() string { return id.PrefixedUniqueId("") },
+	"upper":   strings.ToUpper,
+}
+
+// namingInContext represents the resource naming information kept in Context.
+type namingInContext struct {
+	config             *NamingConfig
+	resourceType       string
+	servicePackageName string
+	tags               map[string]string
+}
+
+// NewNamingContext returns a Context enhanced with resource naming information.
+func NewNamingContext(ctx context.Context, config *NamingConfig, resourceType, servicePackageName string) context.Context {
+	v := namingInContext{
+		config:             config,
+		resourceType:       resourceType,
+		servicePackageName: servicePackageName,
+	}
+
+	return context.WithValue(ctx, namingKey, &v)
+}
+
+// SetNamingTags sets the resource tags, including any provider default tags, used by the naming template in Context.
+func SetNamingTags(ctx context.Context, tags map[string]string) {
+	if v, ok := ctx.Value(namingKey).(*namingInContext); ok {
+		v.tags = tags
+	}
+}
+
+// NamingTemplateFromContext returns the naming template that applies to the resource in Context, if any.
+func NamingTemplateFromContext(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(namingKey).(*namingInContext)
+
+	if !ok {
+		return "", false
+	}
+
+	template := v.config.TemplateFor(v.resourceType)
+
+	return template, template != ""
+}
+
+// NameFromTemplate generates a name from the naming template that applies to the resource in Context.
+func NameFromTemplate(ctx context.Context) (string, error) {
+	v, ok := ctx.Value(namingKey).(*namingInContext)
+
+	if !ok {
+		return "", fmt.Errorf("no naming information in Context")
+	}
+
+	return v.config.Name(v.resourceType, v.servicePackageName, v.tags)
+}
+
+// nameFromTemplate generates a name from the naming template that applies to the resource in Context, if any.
+func nameFromTemplate(ctx context.Context) (string, bool) {
+	if _, ok := NamingTemplateFromContext(ctx); !ok {
+		return "", false
+	}
+
+	name, err := NameFromTemplate(ctx)
+
+	if err != nil {
+		// SDK resources' names are validated at plan time so this isn't expected.
+		tflog.Warn(ctx, "generating name from template", map[string]any{
+			"error": err.Error(),
+		})
+
+		return "", false
+	}
+
+	return name, true
+}
+
+type namingKeyType int
+
+var namingKey namingKeyType
@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package create
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+)
+
+func TestNamingConfigName(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName     string
+		Config       *NamingConfig
+		ResourceType string
+		Tags         map[string]string
+		Expected     string
+		ExpectError  bool
+	}{
+		{
+			TestName: "default template",
+			Config: &NamingConfig{
+				Template:  "{{.env}}-{{.app}}-{{.service}}",
+				Variables: map[string]string{"env": "prod", "app": "billing"},
+			},
+			ResourceType: "aws_sqs_queue",
+			Expected:     "prod-billing-sqs",
+		},
+		{
+			TestName: "override",
+			Config: &NamingConfig{
+				Template:  "{{.env}}-{{.app}}-{{.service}}",
+				Overrides: map[string]string{"aws_sqs_queue": "{{.env}}-{{.app}}-{{.resource_type | replace \"_\" \"-\"}}"},
+				Variables: map[string]string{"env": "prod", "app": "billing"},
+			},
+			ResourceType: "aws_sqs_queue",
+			Expected:     "prod-billing-aws-sqs-queue",
+		},
+		{
+			TestName: "other resource type",
+			Config: &NamingConfig{
+				Template:  "{{.env | upper}}",
+				Overrides: map[string]string{"aws_s3_bucket": "{{.env}}-bucket"},
+				Variables: map[string]string{"env": "prod"},
+			},
+			ResourceType: "aws_sqs_queue",
+			Expected:     "PROD",
+		},
+		{
+			TestName: "purpose",
+			Config: &NamingConfig{
+				Template:   "{{.env}}-{{.app}}-{{.purpose}}",
+				PurposeTag: "Purpose",
+				Variables:  map[string]string{"env": "prod", "app": "billing"},
+			},
+			ResourceType: "aws_sqs_queue",
+			Tags:         map[string]string{"Purpose": "invoices", "Owner": "team"},
+			Expected:     "prod-billing-invoices",
+		},
+		{
+			TestName: "missing purpose tag",
+			Config: &NamingConfig{
+				Template:   "{{.env}}-{{.purpose}}",
+				PurposeTag: "Purpose",
+				Variables:  map[string]string{"env": "prod"},
+			},
+			ResourceType: "aws_sqs_queue",
+			Tags:         map[string]string{"Owner": "team"},
+			ExpectError:  true,
+		},
+		{
+			TestName:     "no template",
+			Config:       &NamingConfig{},
+			ResourceType: "aws_sqs_queue",
+			ExpectError:  true,
+		},
+		{
+			TestName: "missing variable",
+			Config: &NamingConfig{
+				Template: "{{.env}}-{{.app}}",
+			},
+			ResourceType: "aws_sqs_queue",
+			ExpectError:  true,
+		},
+		{
+			TestName: "empty name",
+			Config: &NamingConfig{
+				Template:  "{{.env}}",
+				Variables: map[string]string{"env": ""},
+			},
+			ResourceType: "aws_sqs_queue",
+			ExpectError:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.Config.Name(testCase.ResourceType, "sqs", testCase.Tags)
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error")
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestNamingConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName    string
+		Config      *NamingConfig
+		ExpectError bool
+	}{
+		{
+			TestName: "nil",
+		},
+		{
+			TestName: "valid",
+			Config: &NamingConfig{
+				Template:  "{{.env}}-{{unique}}",
+				Overrides: map[string]string{"aws_s3_bucket": "{{.env | lower}}-{{.service}}-{{unique}}"},
+				Variables: map[string]string{"env": "prod"},
+			},
+		},
+		{
+			TestName: "valid purpose",
+			Config: &NamingConfig{
+				Template:   "{{.env}}-{{.purpose}}-{{unique}}",
+				PurposeTag: "Purpose",
+				Variables:  map[string]string{"env": "prod"},
+			},
+		},
+		{
+			TestName: "purpose without purpose tag",
+			Config: &NamingConfig{
+				Template: "{{.purpose}}-{{unique}}",
+			},
+			ExpectError: true,
+		},
+		{
+			TestName: "no unique",
+			Config: &NamingConfig{
+				Template:  "{{.env}}-{{.service}}",
+				Variables: map[string]string{"env": "prod"},
+			},
+			ExpectError: true,
+		},
+		{
+			TestName: "override with no unique",
+			Config: &NamingConfig{
+				Template:  "{{.env}}-{{unique}}",
+				Overrides: map[string]string{"aws_s3_bucket": "{{.env}}-bucket"},
+				Variables: map[string]string{"env": "prod"},
+			},
+			ExpectError: true,
+		},
+		{
+			TestName: "invalid template",
+			Config: &NamingConfig{
+				Template: "{{.env",
+			},
+			ExpectError: true,
+		},
+		{
+			TestName: "invalid override",
+			Config: &NamingConfig{
+				Overrides: map[string]string{"aws_s3_bucket": "{{missing}}"},
+			},
+			ExpectError: true,
+		},
+		{
+			TestName: "reserved variable",
+			Config: &NamingConfig{
+				Template:  "{{.service}}-{{unique}}",
+				Variables: map[string]string{"service": "example"},
+			},
+			ExpectError: true,
+		},
+		{
+			TestName: "reserved purpose variable",
+			Config: &NamingConfig{
+				Template:   "{{.purpose}}-{{unique}}",
+				PurposeTag: "Purpose",
+				Variables:  map[string]string{"purpose": "example"},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.Config.Validate()
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestNameWithNamingContext(t *testing.T) {
+	t.Parallel()
+
+	config := &NamingConfig{
+		Template:  "{{.env}}-{{.app}}-{{unique}}",
+		Variables: map[string]string{"env": "prod", "app": "billing"},
+	}
+	ctx := NewNamingContext(context.Background(), config, "aws_sqs_queue", "sqs")
+
+	if got, expected := Name(ctx, "name", "prefix"), "name"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	if got := Name(ctx, "", "prefix"); !strings.HasPrefix(got, "prefix") {
+		t.Errorf("got %s, expected prefix-generated name", got)
+	}
+
+	got := NameWithSuffix(ctx, "", "", ".fifo")
+
+	if !regexache.MustCompile(`^prod-billing-[[:xdigit:]]{26}\.fifo$`).MatchString(got) {
+		t.Errorf("got %s, expected templated name", got)
+	}
+
+	purposeConfig := &NamingConfig{
+		Template:   "{{.purpose}}-{{unique}}",
+		PurposeTag: "Purpose",
+	}
+	ctx = NewNamingContext(context.Background(), purposeConfig, "aws_sqs_queue", "sqs")
+	SetNamingTags(ctx, map[string]string{"Purpose": "invoices"})
+
+	if got := Name(ctx, "", ""); !regexache.MustCompile(`^invoices-[[:xdigit:]]{26}$`).MatchString(got) {
+		t.Errorf("got %s, expected templated name with purpose", got)
+	}
+
+	if got := Name(context.Background(), "", ""); !strings.HasPrefix(got, "terraform-") {
+		t.Errorf("got %s, expected terraform- generated name", got)
+	}
+
+	if got := Name(NewNamingContext(context.Background(), nil, "aws_sqs_queue", "sqs"), "", ""); !strings.HasPrefix(got, "terraform-") {
+		t.Errorf("got %s, expected terraform- generated name", got)
+	}
+}
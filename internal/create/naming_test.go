@@ -4,6 +4,7 @@
 package create
 
 import (
+	"context"
 	"testing"
 )
 
@@ -138,7 +139,7 @@ func TestNamePrefixFromName(t *testing.T) {
 
 		for i := 0; i < 10; i++ {
 			prefix := "test-"
-			input := Name("", prefix)
+			input := Name(context.Background(), "", prefix)
 			got := NamePrefixFromName(input)
 
 			if got == nil {
@@ -240,7 +241,7 @@ func TestNamePrefixFromNameWithSuffix(t *testing.T) {
 
 		for i := 0; i < 10; i++ {
 			prefix := "test-"
-			input := NameWithSuffix("", prefix, "suffix")
+			input := NameWithSuffix(context.Background(), "", prefix, "suffix")
 			got := NamePrefixFromNameWithSuffix(input, "suffix")
 
 			if got == nil {
@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/YakDriver/regexache"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// AWSErrorInfo is structured information about an error returned by an AWS API.
+type AWSErrorInfo struct {
+	Code       string
+	Message    string
+	Operation  string
+	RequestID  string
+	Service    string
+	StatusCode int
+}
+
+// apiError is implemented by AWS SDK for Go v2 API errors, e.g. smithy.APIError.
+type apiError interface {
+	error
+	errorMessager
+	ErrorCode() string
+}
+
+// operationError is implemented by AWS SDK for Go v2 operation errors, e.g. smithy.OperationError.
+type operationError interface {
+	error
+	Operation() string
+	Service() string
+}
+
+// NewAWSErrorInfo returns structured information about an AWS SDK for Go v1 or v2 API error.
+func NewAWSErrorInfo(err error) (*AWSErrorInfo, bool) {
+	if err == nil {
+		return nil, false
+	}
+
+	info := &AWSErrorInfo{}
+
+	if v, ok := As[operationError](err); ok {
+		info.Service = v.Service()
+		info.Operation = v.Operation()
+	}
+
+	if v, ok := As[*awshttp.ResponseError](err); ok {
+		info.RequestID = v.ServiceRequestID()
+		info.StatusCode = v.HTTPStatusCode()
+	}
+
+	if v, ok := As[apiError](err); ok {
+		info.Code = v.ErrorCode()
+		info.Message = v.ErrorMessage()
+	} else if v, ok := As[awserr.Error](err); ok {
+		info.Code = v.Code()
+		info.Message = v.Message()
+
+		if v, ok := v.(awserr.RequestFailure); ok {
+			info.RequestID = v.RequestID()
+			info.StatusCode = v.StatusCode()
+		}
+	}
+
+	if info.Code == "" {
+		return nil, false
+	}
+
+	return info, true
+}
+
+// Detail returns a diagnostic detail describing the error, including any remediation hint.
+func (i *AWSErrorInfo) Detail() string {
+	var sb strings.Builder
+
+	if i.Service != "" {
+		fmt.Fprintf(&sb, "Service: %s\n", i.Service)
+	}
+	if i.Operation != "" {
+		fmt.Fprintf(&sb, "Operation: %s\n", i.Operation)
+	}
+	fmt.Fprintf(&sb, "Error code: %s\n", i.Code)
+	if i.StatusCode != 0 {
+		fmt.Fprintf(&sb, "HTTP status code: %d\n", i.StatusCode)
+	}
+	if i.RequestID != "" {
+		fmt.Fprintf(&sb, "Request ID: %s\n", i.RequestID)
+	}
+
+	if hint := i.Hint(); hint != "" {
+		fmt.Fprintf(&sb, "\n%s\n", hint)
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+var (
+	awsErrorActionRegexp    = regexache.MustCompile(`not authorized to perform:? ([\w-]+:[\w*]+)`)
+	awsErrorParameterRegexp = []*regexp.Regexp{
+		regexache.MustCompile(`[Vv]alue .* for parameter '?([A-Za-z][\w.]*)'? is invalid`),
+		regexache.MustCompile(`(?:[Ii]nvalid|[Mm]issing|[Uu]nknown) (?:value for )?(?:the )?parameter:? '?([A-Za-z][\w.]*)'?`),
+		regexache.MustCompile(`at '([A-Za-z][\w.]*)' failed to satisfy constraint`),
+		regexache.MustCompile(`[Pp]arameter '?([A-Za-z][\w.]*)'? (?:is|must|cannot|should)`),
+	}
+	awsErrorQuotaRegexp = []*regexp.Regexp{
+		regexache.MustCompile(`[Tt]he maximum number of (.+?) (?:has been|was|is) (?:reached|exceeded)`),
+		regexache.MustCompile(`(?:[Mm]aximum|[Ll]imit) (?:number )?of (.+?) (?:reached|exceeded|allowed)`),
+		regexache.MustCompile(`([\w ]+?) (?:limit|quota) (?:exceeded|reached)`),
+	}
+)
+
+// Hint returns curated remediation text for common errors, or an empty string.
+func (i *AWSErrorInfo) Hint() string {
+	switch {
+	case i.isAccessDenied():
+		hint := "The provider's credentials aren't authorized to make this request."
+
+		if action := i.Action(); action != "" {
+			hint = fmt.Sprintf("The provider's credentials aren't authorized to perform %s.", action)
+		}
+
+		hint += " Check the IAM policies attached to the caller and any permissions boundaries, session policies, service control policies and resource-based policies that apply to the request."
+
+		if strings.Contains(i.Message, "Encoded authorization failure message") {
+			hint += " Decode the authorization failure message with `aws sts decode-authorization-message` for details."
+		}
+
+		return hint
+	case i.isThrottling():
+		return "The request rate for the API was exceeded. Increase the provider's max_retries or reduce Terraform's -parallelism."
+	case i.isQuotaExceeded():
+		hint := "An AWS service quota was reached."
+
+		if quota := i.Quota(); quota != "" {
+			hint = fmt.Sprintf("An AWS service quota (%s) was reached.", quota)
+		}
+
+		return hint + " Remove unused resources or request a quota increase using Service Quotas (https://console.aws.amazon.com/servicequotas/)."
+	case i.isInvalidParameter():
+		if parameter := i.Parameter(); parameter != "" {
+			return fmt.Sprintf("AWS rejected the value of the %s parameter. Check the corresponding argument (%s) against the service's documented constraints.", parameter, SnakeCase(parameter))
+		}
+
+		return "AWS rejected the request's parameters. Check the resource's arguments against the service's documented constraints."
+	}
+
+	return ""
+}
+
+// Action returns the IAM action that an access denied error's message reports the caller isn't authorized to perform.
+func (i *AWSErrorInfo) Action() string {
+	if m := awsErrorActionRegexp.FindStringSubmatch(i.Message); m != nil {
+		return m[1]
+	}
+
+	return ""
+}
+
+// Parameter returns the API parameter that an invalid parameter error's message reports, e.g. "DBInstanceIdentifier".
+func (i *AWSErrorInfo) Parameter() string {
+	if !i.isInvalidParameter() {
+		return ""
+	}
+
+	for _, re := range awsErrorParameterRegexp {
+		if m := re.FindStringSubmatch(i.Message); m != nil {
+			// Only the top-level parameter of a path, e.g. "tags.1.member.key".
+			parameter, _, _ := strings.Cut(m[1], ".")
+
+			return parameter
+		}
+	}
+
+	return ""
+}
+
+// Quota returns the name of the quota that a limit exceeded error's message or code reports.
+func (i *AWSErrorInfo) Quota() string {
+	for _, re := range awsErrorQuotaRegexp {
+		if m := re.FindStringSubmatch(i.Message); m != nil {
+			return strings.TrimSpace(m[1])
+		}
+	}
+
+	// e.g. "VpcLimitExceeded".
+	if v, ok := strings.CutSuffix(i.Code, "LimitExceeded"); ok && v != "" {
+		return SnakeCase(v)
+	}
+
+	return ""
+}
+
+// AttributeName returns the name of the resource attribute that corresponds exactly to the error's parameter,
+// e.g. "db_instance_identifier" for "DBInstanceIdentifier", or an empty string.
+// The caller must check that the attribute exists.
+func (i *AWSErrorInfo) AttributeName() string {
+	if parameter := i.Parameter(); parameter != "" {
+		return SnakeCase(parameter)
+	}
+
+	return ""
+}
+
+func (i *AWSErrorInfo) isAccessDenied() bool {
+	switch i.Code {
+	case "AccessDenied", "AccessDeniedException", "AuthorizationError", "AuthorizationErrorException", "UnauthorizedAccess", "UnauthorizedOperation":
+		return true
+	}
+
+	return false
+}
+
+func (i *AWSErrorInfo) isInvalidParameter() bool {
+	switch i.Code {
+	case "InvalidInput", "InvalidInputException", "ValidationError", "ValidationException":
+		return true
+	}
+
+	return strings.HasPrefix(i.Code, "InvalidParameter")
+}
+
+func (i *AWSErrorInfo) isQuotaExceeded() bool {
+	switch i.Code {
+	case "QuotaExceeded", "QuotaExceededException", "ServiceQuotaExceededException":
+		return true
+	}
+
+	return strings.HasSuffix(i.Code, "LimitExceeded") || strings.HasSuffix(i.Code, "LimitExceededException")
+}
+
+func (i *AWSErrorInfo) isThrottling() bool {
+	switch i.Code {
+	case "RequestLimitExceeded", "Throttling", "ThrottlingException", "TooManyRequestsException":
+		return true
+	}
+
+	return false
+}
+
+// SnakeCase converts an API parameter name to the naming style of resource attributes,
+// e.g. "DBInstanceIdentifier" to "db_instance_identifier".
+func SnakeCase(s string) string {
+	runes := []rune(s)
+	var sb strings.Builder
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// Start a new word at a lower-to-upper transition, or at the last upper-case letter of an acronym.
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1]))) {
+				sb.WriteRune('_')
+			}
+			sb.WriteRune(unicode.ToLower(r))
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func TestNewAWSErrorInfo(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName string
+		err      error
+		want     *errs.AWSErrorInfo
+	}{
+		{
+			testName: "nil error",
+		},
+		{
+			testName: "other error",
+			err:      fmt.Errorf("test"),
+		},
+		{
+			testName: "v1 request failure",
+			err:      fmt.Errorf("wrapped: %w", awserr.NewRequestFailure(awserr.New("AccessDenied", "Access Denied", nil), http.StatusForbidden, "REQUEST1")),
+			want: &errs.AWSErrorInfo{
+				Code:       "AccessDenied",
+				Message:    "Access Denied",
+				RequestID:  "REQUEST1",
+				StatusCode: http.StatusForbidden,
+			},
+		},
+		{
+			testName: "v2 operation error",
+			err: &smithy.OperationError{
+				ServiceID:     "S3",
+				OperationName: "CreateBucket",
+				Err: &awshttp.ResponseError{
+					ResponseError: &smithyhttp.ResponseError{
+						Response: &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusBadRequest}},
+						Err:      &smithy.GenericAPIError{Code: "InvalidBucketName", Message: "The specified bucket is not valid."},
+					},
+					RequestID: "REQUEST2",
+				},
+			},
+			want: &errs.AWSErrorInfo{
+				Code:       "InvalidBucketName",
+				Message:    "The specified bucket is not valid.",
+				Operation:  "CreateBucket",
+				RequestID:  "REQUEST2",
+				Service:    "S3",
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := errs.NewAWSErrorInfo(testCase.err)
+
+			if got, want := ok, testCase.want != nil; got != want {
+				t.Fatalf("got %t, want %t", got, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAWSErrorInfoHint(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName     string
+		info         errs.AWSErrorInfo
+		wantContains []string
+	}{
+		{
+			testName: "access denied with action",
+			info: errs.AWSErrorInfo{
+				Code:    "AccessDenied",
+				Message: "User: arn:aws:iam::123456789012:user/test is not authorized to perform: iam:CreateRole on resource: test",
+			},
+			wantContains: []string{"iam:CreateRole", "permissions boundaries"},
+		},
+		{
+			testName: "encoded authorization failure",
+			info: errs.AWSErrorInfo{
+				Code:    "UnauthorizedOperation",
+				Message: "You are not authorized to perform this operation. Encoded authorization failure message: abc",
+			},
+			wantContains: []string{"aws sts decode-authorization-message"},
+		},
+		{
+			testName: "limit exceeded with quota name",
+			info: errs.AWSErrorInfo{
+				Code:    "VpcLimitExceeded",
+				Message: "The maximum number of VPCs has been reached.",
+			},
+			wantContains: []string{"(VPCs)", "Service Quotas"},
+		},
+		{
+			testName: "limit exceeded with quota from code",
+			info: errs.AWSErrorInfo{
+				Code: "AddressLimitExceeded",
+			},
+			wantContains: []string{"(address)"},
+		},
+		{
+			testName: "throttling",
+			info: errs.AWSErrorInfo{
+				Code: "RequestLimitExceeded",
+			},
+			wantContains: []string{"max_retries"},
+		},
+		{
+			testName: "invalid parameter",
+			info: errs.AWSErrorInfo{
+				Code:    "InvalidParameterValue",
+				Message: "Value (db..1) for parameter DBInstanceIdentifier is invalid.",
+			},
+			wantContains: []string{"DBInstanceIdentifier", "db_instance_identifier"},
+		},
+		{
+			testName: "validation exception",
+			info: errs.AWSErrorInfo{
+				Code:    "ValidationException",
+				Message: "1 validation error detected: Value 'x' at 'roleArn' failed to satisfy constraint: Member must have length greater than or equal to 20",
+			},
+			wantContains: []string{"roleArn", "role_arn"},
+		},
+		{
+			testName: "other",
+			info: errs.AWSErrorInfo{
+				Code: "ResourceNotFoundException",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.info.Hint()
+
+			if len(testCase.wantContains) == 0 && got != "" {
+				t.Errorf("got %q, want no hint", got)
+			}
+
+			for _, want := range testCase.wantContains {
+				if !strings.Contains(got, want) {
+					t.Errorf("got %q, want it to contain %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAWSErrorInfoAttributeName(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName string
+		message  string
+		want     string
+	}{
+		{
+			testName: "parameter",
+			message:  "Value (db..1) for parameter DBInstanceIdentifier is invalid.",
+			want:     "db_instance_identifier",
+		},
+		{
+			testName: "member path",
+			message:  "1 validation error detected: Value 'x' at 'roleArn' failed to satisfy constraint",
+			want:     "role_arn",
+		},
+		{
+			testName: "no partial match",
+			message:  "Invalid parameter: SourceSecurityGroupName",
+			want:     "source_security_group_name",
+		},
+		{
+			testName: "no parameter",
+			message:  "The request is invalid.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			info := errs.AWSErrorInfo{Code: "InvalidParameterValue", Message: testCase.message}
+
+			if got, want := info.AttributeName(), testCase.want; got != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	t.Parallel()
+
+	for input, want := range map[string]string{
+		"Name":                 "name",
+		"roleArn":              "role_arn",
+		"DBInstanceIdentifier": "db_instance_identifier",
+		"VpcId":                "vpc_id",
+		"Ipv6CidrBlock":        "ipv6_cidr_block",
+		"KMSKeyID":             "kms_key_id",
+	} {
+		if got := errs.SnakeCase(input); got != want {
+			t.Errorf("SnakeCase(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
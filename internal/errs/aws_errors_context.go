@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// maxRecordedAWSErrors is the maximum number of AWS API errors recorded in a Context.
+// Older errors are discarded first.
+const maxRecordedAWSErrors = 32
+
+type awsErrorsKey struct{}
+
+type awsErrors struct {
+	mu     sync.Mutex
+	errors []error
+}
+
+// NewAWSErrorsContext returns a Context that records the errors returned by AWS API calls made with it.
+func NewAWSErrorsContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, awsErrorsKey{}, &awsErrors{})
+}
+
+// RecordAWSError records an error returned by an AWS API call made with the Context.
+// It has no effect if the Context doesn't record errors.
+func RecordAWSError(ctx context.Context, err error) {
+	v, ok := ctx.Value(awsErrorsKey{}).(*awsErrors)
+
+	if !ok || err == nil || err.Error() == "" {
+		return
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if len(v.errors) == maxRecordedAWSErrors {
+		v.errors = v.errors[1:]
+	}
+
+	v.errors = append(v.errors, err)
+}
+
+// FindAWSError returns the most recent AWS API error recorded in the Context whose message is contained in s,
+// e.g. the detail of a diagnostic created from the error's message.
+func FindAWSError(ctx context.Context, s string) (error, bool) {
+	v, ok := ctx.Value(awsErrorsKey{}).(*awsErrors)
+
+	if !ok || s == "" {
+		return nil, false
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	for i := len(v.errors) - 1; i >= 0; i-- {
+		if err := v.errors[i]; strings.Contains(s, err.Error()) {
+			return err, true
+		}
+	}
+
+	return nil, false
+}
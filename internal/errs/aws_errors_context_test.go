@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func TestFindAWSError(t *testing.T) {
+	t.Parallel()
+
+	err1 := errors.New("AccessDenied: Access Denied")
+	err2 := errors.New("ValidationException: Invalid roleArn")
+	ctx := errs.NewAWSErrorsContext(context.Background())
+
+	errs.RecordAWSError(ctx, err1)
+	errs.RecordAWSError(ctx, err2)
+	errs.RecordAWSError(ctx, nil)
+	errs.RecordAWSError(ctx, errors.New(""))
+
+	testCases := []struct {
+		testName string
+		ctx      context.Context
+		s        string
+		want     error
+	}{
+		{
+			testName: "no recorder",
+			ctx:      context.Background(),
+			s:        err1.Error(),
+		},
+		{
+			testName: "empty",
+			ctx:      ctx,
+		},
+		{
+			testName: "not found",
+			ctx:      ctx,
+			s:        "parsing JSON: unexpected end of JSON input",
+		},
+		{
+			testName: "found",
+			ctx:      ctx,
+			s:        fmt.Errorf("creating Example (test): %w", err1).Error(),
+			want:     err1,
+		},
+		{
+			testName: "most recent",
+			ctx:      ctx,
+			s:        fmt.Sprintf("%s, %s", err1, err2),
+			want:     err2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := errs.FindAWSError(testCase.ctx, testCase.s)
+
+			if got, want := ok, testCase.want != nil; got != want {
+				t.Fatalf("got found %t, want %t", got, want)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestRecordAWSErrorLimit(t *testing.T) {
+	t.Parallel()
+
+	ctx := errs.NewAWSErrorsContext(context.Background())
+
+	for i := 0; i < 100; i++ {
+		errs.RecordAWSError(ctx, fmt.Errorf("error %03d", i))
+	}
+
+	if _, ok := errs.FindAWSError(ctx, "error 000"); ok {
+		t.Error("expected oldest error to be discarded")
+	}
+
+	if _, ok := errs.FindAWSError(ctx, "error 099"); !ok {
+		t.Error("expected most recent error to be recorded")
+	}
+}
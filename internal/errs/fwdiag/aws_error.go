@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwdiag
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// WithAWSErrors returns the Diagnostics with any error Diagnostic created from the message of an AWS API error
+// recorded in the Context, e.g. by
+//
+//	response.Diagnostics.AddError(summary, err.Error())
+//
+// described in more detail: the error code, request ID and any remediation hint are added to the detail and
+// the attribute path is set to the top-level attribute whose name exactly matches the error's invalid parameter.
+// exists reports whether a top-level attribute exists in the resource's schema.
+func WithAWSErrors(ctx context.Context, diags diag.Diagnostics, exists func(string) bool) diag.Diagnostics {
+	if !diags.HasError() {
+		return diags
+	}
+
+	output := make(diag.Diagnostics, 0, len(diags))
+
+	for _, d := range diags {
+		if d.Severity() == diag.SeverityError {
+			d = withAWSError(ctx, d, exists)
+		}
+
+		output = append(output, d)
+	}
+
+	return output
+}
+
+func withAWSError(ctx context.Context, d diag.Diagnostic, exists func(string) bool) diag.Diagnostic {
+	err, ok := errs.FindAWSError(ctx, d.Detail())
+
+	if !ok {
+		if err, ok = errs.FindAWSError(ctx, d.Summary()); !ok {
+			return d
+		}
+	}
+
+	info, ok := errs.NewAWSErrorInfo(err)
+
+	if !ok {
+		return d
+	}
+
+	detail := info.Detail()
+	if v := d.Detail(); v != "" {
+		detail = v + "\n\n" + detail
+	}
+
+	if v, ok := d.(diag.DiagnosticWithPath); ok {
+		return diag.NewAttributeErrorDiagnostic(v.Path(), d.Summary(), detail)
+	}
+
+	if name := info.AttributeName(); name != "" && exists(name) {
+		return diag.NewAttributeErrorDiagnostic(path.Root(name), d.Summary(), detail)
+	}
+
+	return diag.NewErrorDiagnostic(d.Summary(), detail)
+}
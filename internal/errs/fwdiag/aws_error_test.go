@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwdiag_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+)
+
+func TestWithAWSErrors(t *testing.T) {
+	t.Parallel()
+
+	exists := func(name string) bool { return name == "role_arn" }
+
+	accessDenied := awserr.NewRequestFailure(awserr.New("AccessDenied", "Access Denied", nil), http.StatusForbidden, "ABC123")
+	validation := awserr.New("ValidationException", "1 validation error detected: Value 'x' at 'roleArn' failed to satisfy constraint", nil)
+	invalidParameter := awserr.New("InvalidParameterValue", "Invalid parameter: SourceSecurityGroupName", nil)
+
+	testCases := []struct {
+		testName   string
+		recorded   []error
+		diag       diag.Diagnostic
+		wantDetail []string
+		wantPath   path.Path
+	}{
+		{
+			testName:   "no recorded error",
+			diag:       diag.NewErrorDiagnostic("creating Example (test)", accessDenied.Error()),
+			wantDetail: []string{accessDenied.Error()},
+		},
+		{
+			testName:   "not an AWS error",
+			recorded:   []error{accessDenied},
+			diag:       diag.NewErrorDiagnostic("creating Example (test)", "parsing JSON: unexpected end of JSON input"),
+			wantDetail: []string{"parsing JSON: unexpected end of JSON input"},
+		},
+		{
+			testName: "AWS error",
+			recorded: []error{accessDenied},
+			diag:     diag.NewErrorDiagnostic("creating Example (test)", fmt.Errorf("waiting: %w", accessDenied).Error()),
+			wantDetail: []string{
+				"AccessDenied: Access Denied",
+				"Error code: AccessDenied",
+				"Request ID: ABC123",
+			},
+		},
+		{
+			testName: "AWS error in summary",
+			recorded: []error{accessDenied},
+			diag:     diag.NewErrorDiagnostic(fmt.Sprintf("creating Example (test): %s", accessDenied), ""),
+			wantDetail: []string{
+				"Error code: AccessDenied",
+			},
+		},
+		{
+			testName: "invalid parameter with attribute",
+			recorded: []error{accessDenied, validation},
+			diag:     diag.NewErrorDiagnostic("creating Example (test)", validation.Error()),
+			wantDetail: []string{
+				"Error code: ValidationException",
+			},
+			wantPath: path.Root("role_arn"),
+		},
+		{
+			testName: "invalid parameter without attribute",
+			recorded: []error{invalidParameter},
+			diag:     diag.NewErrorDiagnostic("creating Example (test)", invalidParameter.Error()),
+			wantDetail: []string{
+				"Error code: InvalidParameterValue",
+			},
+		},
+		{
+			testName: "existing attribute path",
+			recorded: []error{validation},
+			diag:     diag.NewAttributeErrorDiagnostic(path.Root("name"), "creating Example (test)", validation.Error()),
+			wantDetail: []string{
+				"Error code: ValidationException",
+			},
+			wantPath: path.Root("name"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := errs.NewAWSErrorsContext(context.Background())
+			for _, err := range testCase.recorded {
+				errs.RecordAWSError(ctx, err)
+			}
+
+			diags := fwdiag.WithAWSErrors(ctx, diag.Diagnostics{testCase.diag, diag.NewWarningDiagnostic("warning", accessDenied.Error())}, exists)
+
+			if got, want := len(diags), 2; got != want {
+				t.Fatalf("got %d Diagnostics, want %d", got, want)
+			}
+
+			d := diags[0]
+
+			if got, want := d.Summary(), testCase.diag.Summary(); got != want {
+				t.Errorf("got summary %q, want %q", got, want)
+			}
+
+			for _, want := range testCase.wantDetail {
+				if !strings.Contains(d.Detail(), want) {
+					t.Errorf("got detail %q, want it to contain %q", d.Detail(), want)
+				}
+			}
+
+			var gotPath path.Path
+			if v, ok := d.(diag.DiagnosticWithPath); ok {
+				gotPath = v.Path()
+			}
+
+			if !gotPath.Equal(testCase.wantPath) {
+				t.Errorf("got path %s, want %s", gotPath, testCase.wantPath)
+			}
+
+			if got, want := diags[1], diag.NewWarningDiagnostic("warning", accessDenied.Error()); !got.Equal(want) {
+				t.Errorf("got warning %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestWithAWSErrorsNoContext(t *testing.T) {
+	t.Parallel()
+
+	err := errors.New("AccessDenied: Access Denied")
+	ctx := context.Background()
+	errs.RecordAWSError(ctx, err)
+
+	want := diag.Diagnostics{diag.NewErrorDiagnostic("creating Example (test)", err.Error())}
+
+	if got := fwdiag.WithAWSErrors(ctx, want, func(string) bool { return true }); !got.Equal(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -17,15 +17,23 @@ func AppendWarningf(diags diag.Diagnostics, format string, a ...any) diag.Diagno
 	})
 }
 
+// AppendErrorf appends an error Diagnostic. If any of a is an AWS API error, the Diagnostic's detail describes it.
 func AppendErrorf(diags diag.Diagnostics, format string, a ...any) diag.Diagnostics {
-	return append(diags, diag.Errorf(format, a...)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-diag_Errorf
+	return append(diags, withAWSError(diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf(format, a...),
+	}, a...))
 }
 
+// AppendFromErr appends an error Diagnostic for err. If err is an AWS API error, the Diagnostic's detail describes it.
 func AppendFromErr(diags diag.Diagnostics, err error) diag.Diagnostics {
 	if err == nil {
 		return diags
 	}
-	return append(diags, diag.FromErr(err)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-diag_FromErr
+	return append(diags, withAWSError(diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  err.Error(),
+	}, err))
 }
 
 func WrapDiagsf(orig diag.Diagnostics, format string, a ...any) diag.Diagnostics {
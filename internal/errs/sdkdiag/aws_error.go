@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkdiag
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// withAWSError returns the Diagnostic with a detail describing the first AWS API error in a, if any.
+// The attribute path is set to the attribute whose name exactly matches the error's invalid parameter.
+func withAWSError(d diag.Diagnostic, a ...any) diag.Diagnostic {
+	for _, v := range a {
+		err, ok := v.(error)
+
+		if !ok {
+			continue
+		}
+
+		info, ok := errs.NewAWSErrorInfo(err)
+
+		if !ok {
+			continue
+		}
+
+		d.Detail = info.Detail()
+
+		if name := info.AttributeName(); name != "" {
+			d.AttributePath = cty.GetAttrPath(name)
+		}
+
+		break
+	}
+
+	return d
+}
+
+// RemoveUnknownAttributePaths returns the Diagnostics with any attribute path whose top-level attribute doesn't exist
+// in the resource's schema removed.
+// Such paths are set from AWS API errors' invalid parameters that have no corresponding attribute.
+func RemoveUnknownAttributePaths(diags diag.Diagnostics, exists func(string) bool) diag.Diagnostics {
+	return tfslices.ApplyToAll(diags, func(d diag.Diagnostic) diag.Diagnostic {
+		if len(d.AttributePath) == 0 {
+			return d
+		}
+
+		if step, ok := d.AttributePath[0].(cty.GetAttrStep); ok && !exists(step.Name) {
+			d.AttributePath = nil
+		}
+
+		return d
+	})
+}
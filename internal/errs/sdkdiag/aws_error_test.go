@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkdiag_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func TestAppendErrorfAWSError(t *testing.T) {
+	t.Parallel()
+
+	invalidParameterErr := awserr.NewRequestFailure(awserr.New("InvalidParameterValue", "Value (db..1) for parameter DBInstanceIdentifier is invalid.", nil), http.StatusBadRequest, "ABC123")
+
+	testCases := []struct {
+		testName          string
+		err               error
+		wantSummary       string
+		wantDetail        []string
+		wantAttributePath cty.Path
+	}{
+		{
+			testName:    "no AWS error",
+			err:         errors.New("timeout"),
+			wantSummary: "creating Example (test): timeout",
+		},
+		{
+			testName:    "AWS error",
+			err:         awserr.NewRequestFailure(awserr.New("AccessDenied", "Access Denied", nil), http.StatusForbidden, "ABC123"),
+			wantSummary: "creating Example (test): AccessDenied: Access Denied\n\tstatus code: 403, request id: ABC123",
+			wantDetail: []string{
+				"Error code: AccessDenied",
+				"HTTP status code: 403",
+				"Request ID: ABC123",
+			},
+		},
+		{
+			testName:    "wrapped AWS invalid parameter error",
+			err:         fmt.Errorf("waiting: %w", invalidParameterErr),
+			wantSummary: "creating Example (test): waiting: " + invalidParameterErr.Error(),
+			wantDetail: []string{
+				"Error code: InvalidParameterValue",
+				"db_instance_identifier",
+			},
+			wantAttributePath: cty.GetAttrPath("db_instance_identifier"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			diags := sdkdiag.AppendErrorf(nil, "creating Example (%s): %s", "test", testCase.err)
+
+			if got, want := len(diags), 1; got != want {
+				t.Fatalf("got %d Diagnostics, want %d", got, want)
+			}
+
+			d := diags[0]
+
+			if got, want := d.Summary, testCase.wantSummary; got != want {
+				t.Errorf("got summary %q, want %q", got, want)
+			}
+
+			if len(testCase.wantDetail) == 0 && d.Detail != "" {
+				t.Errorf("got detail %q, want none", d.Detail)
+			}
+
+			for _, want := range testCase.wantDetail {
+				if !strings.Contains(d.Detail, want) {
+					t.Errorf("got detail %q, want it to contain %q", d.Detail, want)
+				}
+			}
+
+			if got, want := d.AttributePath, testCase.wantAttributePath; !got.Equals(want) {
+				t.Errorf("got attribute path %#v, want %#v", got, want)
+			}
+		})
+	}
+}
+
+func TestRemoveUnknownAttributePaths(t *testing.T) {
+	t.Parallel()
+
+	exists := func(name string) bool { return name == "identifier" }
+
+	diags := sdkdiag.RemoveUnknownAttributePaths(diag.Diagnostics{
+		{Severity: diag.Error, Summary: "no path"},
+		{Severity: diag.Error, Summary: "known", AttributePath: cty.GetAttrPath("identifier")},
+		{Severity: diag.Error, Summary: "unknown", AttributePath: cty.GetAttrPath("db_instance_identifier")},
+	}, exists)
+
+	for i, want := range []cty.Path{nil, cty.GetAttrPath("identifier"), nil} {
+		if got := diags[i].AttributePath; !got.Equals(want) {
+			t.Errorf("%s: got attribute path %#v, want %#v", diags[i].Summary, got, want)
+		}
+	}
+}
@@ -30,6 +30,26 @@ func Int64ToFramework(ctx context.Context, v *int64) types.Int64 {
 	return output
 }
 
+// Int32FromFramework converts a Framework Int64 value to an int32 pointer.
+// A null Int64 is converted to a nil int32 pointer.
+func Int32FromFramework(ctx context.Context, v types.Int64) *int32 {
+	var output *int32
+
+	panicOnError(Expand(ctx, v, &output))
+
+	return output
+}
+
+// Int32ToFramework converts an int32 pointer to a Framework Int64 value.
+// A nil int32 pointer is converted to a null Int64.
+func Int32ToFramework(ctx context.Context, v *int32) types.Int64 {
+	var output types.Int64
+
+	panicOnError(Flatten(ctx, v, &output))
+
+	return output
+}
+
 // Int64ToFrameworkLegacy converts an int64 pointer to a Framework Int64 value.
 // A nil int64 pointer is converted to a zero Int64.
 func Int64ToFrameworkLegacy(_ context.Context, v *int64) types.Int64 {
@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/hcl/v2/hclsimple"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	defaultFilename = "find_gen.go"
+	defaultSpec     = "finders.hcl"
+)
+
+var (
+	spec = flag.String("Spec", defaultSpec, "name of the finder specification file")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] [<generated-finder-file>]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+type finderSpecs struct {
+	Finders []finderSpec `hcl:"finder,block"`
+}
+
+type finderSpec struct {
+	Name               string      `hcl:",label"`
+	SDKVersion         int         `hcl:"sdk_version,optional"`
+	Operation          string      `hcl:"operation"`
+	Paginated          bool        `hcl:"paginated,optional"`
+	OutputField        string      `hcl:"output_field"`
+	ItemType           string      `hcl:"item_type"`
+	Plural             string      `hcl:"plural,optional"`
+	NotFoundErrorCodes []string    `hcl:"not_found_error_codes,optional"`
+	RequiredFields     []string    `hcl:"required_fields,optional"`
+	Export             *bool       `hcl:"export,optional"`
+	ByID               *lookupSpec `hcl:"by_id,block"`
+	ByName             *lookupSpec `hcl:"by_name,block"`
+}
+
+// lookupSpec describes a finder by a unique key.
+// The key is set on the input and, as an eventual consistency check, compared against the returned object.
+type lookupSpec struct {
+	InputField  string `hcl:"input_field"`
+	OutputField string `hcl:"output_field"`
+	List        *bool  `hcl:"list,optional"`
+}
+
+type TemplateData struct {
+	Parameters     string
+	ServicePackage string
+
+	HasV1     bool
+	HasV2     bool
+	V1Package string
+	V1Client  string
+	V2Package string
+	V2Alias   string
+
+	// Imports of the AWS SDK for Go "aws" packages are only needed by lookups.
+	ImportV1AWS bool
+	ImportV2AWS bool
+	V2AWSAlias  string
+
+	Finders []FinderDatum
+}
+
+type FinderDatum struct {
+	SDKVersion int
+
+	FindSingle  string
+	FindPlural  string
+	FinderFunc  string
+	Operation   string
+	Paginated   bool
+	OutputField string
+	ItemType    string
+
+	NotFoundErrorCodes string
+	RequiredFields     []string
+
+	// Qualified Go types, set once the AWS SDK for Go packages are known.
+	ConnType   string
+	InputType  string
+	OutputType string
+	ItemRef    string
+
+	Lookups []LookupDatum
+}
+
+type LookupDatum struct {
+	FuncName    string
+	Param       string
+	InputField  string
+	OutputField string
+	List        bool
+}
+
+func main() {
+	g := common.NewGenerator()
+
+	flag.Usage = usage
+	flag.Parse()
+
+	filename := defaultFilename
+	if args := flag.Args(); len(args) > 0 {
+		filename = args[0]
+	}
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	g.Infof("Generating internal/service/%s/%s", servicePackage, filename)
+
+	var specs finderSpecs
+
+	if err := hclsimple.DecodeFile(*spec, nil, &specs); err != nil {
+		g.Fatalf("error reading %s: %s", *spec, err)
+	}
+
+	td, err := newTemplateData(servicePackage, strings.Join(os.Args[1:], " "), specs)
+
+	if err != nil {
+		g.Fatalf("encountered: %s", err)
+	}
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.WriteTemplate("finders", tmpl, td); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+func newTemplateData(servicePackage, parameters string, specs finderSpecs) (TemplateData, error) {
+	td := TemplateData{
+		Parameters:     parameters,
+		ServicePackage: servicePackage,
+	}
+
+	for _, v := range specs.Finders {
+		d, err := finderDatum(v)
+
+		if err != nil {
+			return td, fmt.Errorf("finder %q: %w", v.Name, err)
+		}
+
+		switch d.SDKVersion {
+		case 1:
+			td.HasV1 = true
+			td.ImportV1AWS = td.ImportV1AWS || len(d.Lookups) > 0
+		case 2:
+			td.HasV2 = true
+			td.ImportV2AWS = td.ImportV2AWS || len(d.Lookups) > 0
+		}
+
+		td.Finders = append(td.Finders, d)
+	}
+
+	if td.HasV1 {
+		v, err := names.AWSGoV1Package(servicePackage)
+
+		if err != nil {
+			return td, err
+		}
+
+		td.V1Package = v
+
+		v, err = names.AWSGoV1ClientTypeName(servicePackage)
+
+		if err != nil {
+			return td, err
+		}
+
+		td.V1Client = v
+	}
+
+	if td.HasV2 {
+		v, err := names.AWSGoV2Package(servicePackage)
+
+		if err != nil {
+			return td, err
+		}
+
+		td.V2Package = v
+		td.V2Alias = v
+
+		if td.HasV1 {
+			td.V2Alias = v + "_sdkv2"
+		}
+	}
+
+	// Alias the AWS SDK for Go v2 "aws" package only if it would clash with the v1 package.
+	td.V2AWSAlias = "aws"
+	if td.ImportV1AWS && td.ImportV2AWS {
+		td.V2AWSAlias = "aws_sdkv2"
+	}
+
+	for i, v := range td.Finders {
+		switch v.SDKVersion {
+		case 1:
+			v.ConnType = fmt.Sprintf("*%s.%s", td.V1Package, td.V1Client)
+			v.InputType = fmt.Sprintf("*%s.%sInput", td.V1Package, v.Operation)
+			v.OutputType = fmt.Sprintf("*%s.%sOutput", td.V1Package, v.Operation)
+			v.ItemRef = fmt.Sprintf("*%s.%s", td.V1Package, v.ItemType)
+		case 2:
+			v.ConnType = fmt.Sprintf("*%s.Client", td.V2Alias)
+			v.InputType = fmt.Sprintf("*%s.%sInput", td.V2Alias, v.Operation)
+			v.OutputType = fmt.Sprintf("*%s.%sOutput", td.V2Alias, v.Operation)
+			v.ItemRef = fmt.Sprintf("awstypes.%s", v.ItemType)
+		}
+		td.Finders[i] = v
+	}
+
+	sort.SliceStable(td.Finders, func(i, j int) bool {
+		return td.Finders[i].FindSingle < td.Finders[j].FindSingle
+	})
+
+	return td, nil
+}
+
+func finderDatum(v finderSpec) (FinderDatum, error) {
+	d := FinderDatum{
+		SDKVersion:     v.SDKVersion,
+		Operation:      v.Operation,
+		Paginated:      v.Paginated,
+		OutputField:    v.OutputField,
+		ItemType:       v.ItemType,
+		RequiredFields: v.RequiredFields,
+	}
+
+	if d.SDKVersion == 0 {
+		d.SDKVersion = 1
+	}
+
+	if d.SDKVersion != 1 && d.SDKVersion != 2 {
+		return d, fmt.Errorf("unsupported AWS SDK Go version: %d", d.SDKVersion)
+	}
+
+	plural := v.Plural
+	if plural == "" {
+		plural = v.Name + "s"
+	}
+
+	export := v.Export == nil || *v.Export
+
+	d.FindSingle = funcName(export, "Find"+v.Name)
+	d.FindPlural = funcName(export, "Find"+plural)
+	d.FinderFunc = funcName(false, plural) + "Finder"
+
+	if len(v.NotFoundErrorCodes) > 0 {
+		d.NotFoundErrorCodes = strings.Join(v.NotFoundErrorCodes, ", ")
+	}
+
+	if v.ByID != nil {
+		d.Lookups = append(d.Lookups, lookupDatum(v.ByID, funcName(export, "Find"+v.Name+"ByID"), "id"))
+	}
+
+	if v.ByName != nil {
+		d.Lookups = append(d.Lookups, lookupDatum(v.ByName, funcName(export, "Find"+v.Name+"ByName"), "name"))
+	}
+
+	return d, nil
+}
+
+func lookupDatum(v *lookupSpec, funcName, param string) LookupDatum {
+	return LookupDatum{
+		FuncName:    funcName,
+		Param:       param,
+		InputField:  v.InputField,
+		OutputField: v.OutputField,
+		List:        v.List == nil || *v.List,
+	}
+}
+
+// funcName returns the name of a function, unexported by lower-casing any leading initialism,
+// e.g. "VPCEndpoints" to "vpcEndpoints" and "VPCs" to "vpcs".
+func funcName(export bool, name string) string {
+	if export {
+		return name
+	}
+
+	n := strings.IndexFunc(name, func(r rune) bool { return !unicode.IsUpper(r) })
+
+	switch {
+	case n == -1:
+		n = len(name)
+	case n > 1 && name[n:] != "s":
+		// The last upper-case letter starts the next word.
+		n--
+	case n == 0:
+		return name
+	}
+
+	return strings.ToLower(name[:n]) + name[n:]
+}
+
+//go:embed file.tmpl
+var tmpl string
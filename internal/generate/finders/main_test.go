@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/format"
+	"os"
+	"path/filepath"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/hcl/v2/hclsimple"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// Run with `go test -tags generate ./internal/generate/finders/`.
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		servicePackage string
+		spec           string
+		golden         string
+	}{
+		// The generated finders checked in to internal/service/ec2.
+		"ec2": {
+			servicePackage: "ec2",
+			spec:           filepath.Join("..", "..", "service", "ec2", defaultSpec),
+			golden:         filepath.Join("..", "..", "service", "ec2", defaultFilename),
+		},
+		// AWS SDK for Go v1 and v2 lookups in one file, list and non-list input fields.
+		"lookups": {
+			servicePackage: "ec2",
+			spec:           filepath.Join("testdata", "lookups.hcl"),
+			golden:         filepath.Join("testdata", "lookups.golden"),
+		},
+		// AWS SDK for Go v2 only, list input fields.
+		"v2_list_lookups": {
+			servicePackage: "ec2",
+			spec:           filepath.Join("testdata", "v2_list_lookups.hcl"),
+			golden:         filepath.Join("testdata", "v2_list_lookups.golden"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var specs finderSpecs
+
+			if err := hclsimple.DecodeFile(testCase.spec, nil, &specs); err != nil {
+				t.Fatal(err)
+			}
+
+			td, err := newTemplateData(testCase.servicePackage, "", specs)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var buffer bytes.Buffer
+
+			if err := template.Must(template.New("finders").Parse(tmpl)).Execute(&buffer, td); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := format.Source(buffer.Bytes())
+
+			if err != nil {
+				t.Fatalf("formatting generated code: %s\n%s", err, buffer.String())
+			}
+
+			golden := testCase.golden
+
+			if *update {
+				if err := os.WriteFile(golden, got, 0644); err != nil { //nolint:gomnd
+					t.Fatal(err)
+				}
+			}
+
+			want, err := os.ReadFile(golden)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(got, want) {
+				t.Errorf("generated code does not match %s, got:\n%s", golden, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+finder "Vpc" {
+  operation    = "DescribeVpcs"
+  paginated    = true
+  output_field = "Vpcs"
+  item_type    = "Vpc"
+
+  by_id {
+    input_field  = "VpcIds"
+    output_field = "VpcId"
+  }
+}
+
+finder "LaunchTemplateVersion" {
+  operation    = "DescribeLaunchTemplateVersions"
+  paginated    = true
+  output_field = "LaunchTemplateVersions"
+  item_type    = "LaunchTemplateVersion"
+  export       = false
+
+  by_id {
+    input_field  = "LaunchTemplateId"
+    output_field = "LaunchTemplateId"
+    list         = false
+  }
+}
+
+finder "InstanceConnectEndpoint" {
+  sdk_version  = 2
+  operation    = "DescribeInstanceConnectEndpoints"
+  paginated    = true
+  output_field = "InstanceConnectEndpoints"
+  item_type    = "Ec2InstanceConnectEndpoint"
+
+  by_id {
+    input_field  = "InstanceConnectEndpointIds"
+    output_field = "InstanceConnectEndpointId"
+  }
+}
+
+finder "LaunchTemplateVersionV2" {
+  sdk_version  = 2
+  operation    = "DescribeLaunchTemplateVersions"
+  output_field = "LaunchTemplateVersions"
+  item_type    = "LaunchTemplateVersion"
+  plural       = "LaunchTemplateVersionsV2"
+  export       = false
+
+  by_id {
+    input_field  = "LaunchTemplateId"
+    output_field = "LaunchTemplateId"
+    list         = false
+  }
+}
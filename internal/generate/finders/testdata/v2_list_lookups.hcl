@@ -0,0 +1,12 @@
+finder "InstanceConnectEndpoint" {
+  sdk_version  = 2
+  operation    = "DescribeInstanceConnectEndpoints"
+  paginated    = true
+  output_field = "InstanceConnectEndpoints"
+  item_type    = "Ec2InstanceConnectEndpoint"
+
+  by_id {
+    input_field  = "InstanceConnectEndpointIds"
+    output_field = "InstanceConnectEndpointId"
+  }
+}
@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	defaultEndpoint = "https://servicereference.us-east-1.amazonaws.com/"
+	defaultFilename = "policy_lint_catalog.json"
+)
+
+var (
+	endpoint = flag.String("Endpoint", defaultEndpoint, "URL of the AWS Service Authorization Reference index")
+)
+
+// globalConditionKeys are the AWS global condition context keys.
+// They aren't listed by service so are maintained here.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_condition-keys.html.
+var globalConditionKeys = []string{
+	"aws:CalledVia",
+	"aws:CalledViaFirst",
+	"aws:CalledViaLast",
+	"aws:CurrentTime",
+	"aws:Ec2InstanceSourcePrivateIPv4",
+	"aws:Ec2InstanceSourceVpc",
+	"aws:EpochTime",
+	"aws:FederatedProvider",
+	"aws:MultiFactorAuthAge",
+	"aws:MultiFactorAuthPresent",
+	"aws:PrincipalAccount",
+	"aws:PrincipalArn",
+	"aws:PrincipalIsAWSService",
+	"aws:PrincipalOrgID",
+	"aws:PrincipalOrgPaths",
+	"aws:PrincipalServiceName",
+	"aws:PrincipalServiceNamesList",
+	"aws:PrincipalTag/${TagKey}",
+	"aws:PrincipalType",
+	"aws:Referer",
+	"aws:RequestTag/${TagKey}",
+	"aws:RequestedRegion",
+	"aws:ResourceAccount",
+	"aws:ResourceOrgID",
+	"aws:ResourceOrgPaths",
+	"aws:ResourceTag/${TagKey}",
+	"aws:SecureTransport",
+	"aws:SourceAccount",
+	"aws:SourceArn",
+	"aws:SourceIdentity",
+	"aws:SourceIp",
+	"aws:SourceOrgID",
+	"aws:SourceOrgPaths",
+	"aws:SourceVpc",
+	"aws:SourceVpce",
+	"aws:TagKeys",
+	"aws:TokenIssueTime",
+	"aws:UserAgent",
+	"aws:ViaAWSService",
+	"aws:VpcSourceIp",
+	"aws:userid",
+	"aws:username",
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] [<generated-catalog-file>]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+// serviceReferenceIndexEntry is an entry in the Service Authorization Reference index.
+type serviceReferenceIndexEntry struct {
+	Service string `json:"service"`
+	URL     string `json:"url"`
+}
+
+// serviceReference is the Service Authorization Reference for a single service.
+type serviceReference struct {
+	Name    string `json:"Name"`
+	Actions []struct {
+		Name string `json:"Name"`
+	} `json:"Actions"`
+	ConditionKeys []struct {
+		Name string `json:"Name"`
+	} `json:"ConditionKeys"`
+}
+
+// The catalog types must match those in internal/service/iam/policy_lint.go.
+type catalog struct {
+	GlobalConditionKeys []string                  `json:"global_condition_keys"`
+	Services            map[string]catalogService `json:"services"`
+}
+
+type catalogService struct {
+	Actions       []string `json:"actions"`
+	ConditionKeys []string `json:"condition_keys"`
+}
+
+func main() {
+	log.SetPrefix("generate/iampolicylintcatalog: ")
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	filename := defaultFilename
+	if args := flag.Args(); len(args) > 0 {
+		filename = args[0]
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute) //nolint:gomnd
+	defer cancel()
+
+	var index []serviceReferenceIndexEntry
+
+	if err := getJSON(ctx, *endpoint, &index); err != nil {
+		log.Fatalf("reading Service Authorization Reference index: %s", err)
+	}
+
+	if len(index) == 0 {
+		log.Fatalf("Service Authorization Reference index (%s) is empty", *endpoint)
+	}
+
+	global := make(map[string]struct{})
+	for _, v := range globalConditionKeys {
+		global[v] = struct{}{}
+	}
+
+	actions := make(map[string]map[string]struct{})
+	conditionKeys := make(map[string]map[string]struct{})
+
+	for _, entry := range index {
+		var ref serviceReference
+
+		if err := getJSON(ctx, entry.URL, &ref); err != nil {
+			log.Fatalf("reading Service Authorization Reference (%s): %s", entry.Service, err)
+		}
+
+		service := strings.ToLower(ref.Name)
+		if service == "" {
+			service = strings.ToLower(entry.Service)
+		}
+
+		for _, v := range ref.Actions {
+			add(actions, service, v.Name)
+		}
+
+		for _, v := range ref.ConditionKeys {
+			prefix, _, ok := strings.Cut(v.Name, ":")
+
+			if !ok {
+				continue
+			}
+
+			if prefix = strings.ToLower(prefix); prefix == "aws" {
+				global[v.Name] = struct{}{}
+				continue
+			}
+
+			add(conditionKeys, prefix, v.Name)
+		}
+	}
+
+	c := catalog{
+		GlobalConditionKeys: sortedKeys(global),
+		Services:            make(map[string]catalogService, len(actions)),
+	}
+
+	for service, v := range actions {
+		c.Services[service] = catalogService{
+			Actions:       sortedKeys(v),
+			ConditionKeys: sortedKeys(conditionKeys[service]),
+		}
+	}
+
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(c); err != nil {
+		log.Fatalf("encoding catalog: %s", err)
+	}
+
+	if err := os.WriteFile(filename, buffer.Bytes(), 0644); err != nil { //nolint:gomnd
+		log.Fatalf("writing catalog (%s): %s", filename, err)
+	}
+
+	log.Printf("wrote %d services to %s", len(c.Services), filename)
+}
+
+func getJSON(ctx context.Context, url string, v any) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+
+	if err != nil {
+		return err
+	}
+
+	response, err := http.DefaultClient.Do(request)
+
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, response.Status)
+	}
+
+	return json.NewDecoder(response.Body).Decode(v)
+}
+
+func add(m map[string]map[string]struct{}, service, v string) {
+	if v == "" {
+		return
+	}
+
+	if _, ok := m[service]; !ok {
+		m[service] = make(map[string]struct{})
+	}
+
+	m[service][v] = struct{}{}
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -61,7 +61,7 @@ func main() {
 			p = l[names.ColProviderPackageActual]
 		}
 
-		spdFile := fmt.Sprintf("../service/%s/service_package_gen.go", p)
+		spdFile := fmt.Sprintf("../../internal/service/%s/service_package_gen.go", p)
 
 		if _, err := os.Stat(spdFile); err != nil {
 			continue
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// awsErrorsInterceptor removes attribute paths set from AWS API errors' invalid parameters
+// that don't correspond to an attribute in the resource's schema.
+type awsErrorsInterceptor struct {
+	schema map[string]*schema.Schema
+}
+
+func (r awsErrorsInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when != Finally || !diags.HasError() {
+		return ctx, diags
+	}
+
+	return ctx, sdkdiag.RemoveUnknownAttributePaths(diags, func(name string) bool {
+		_, ok := r.schema[name]
+		return ok
+	})
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+)
+
+// awsErrorsResourceInterceptor records the AWS API errors returned during a CRUD call and describes
+// the error diagnostics created from them in more detail.
+type awsErrorsResourceInterceptor struct{}
+
+func (r awsErrorsResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return withAWSErrors(ctx, request.Plan.Schema, when, diags)
+}
+
+func (r awsErrorsResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return withAWSErrors(ctx, request.State.Schema, when, diags)
+}
+
+func (r awsErrorsResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return withAWSErrors(ctx, request.Plan.Schema, when, diags)
+}
+
+func (r awsErrorsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return withAWSErrors(ctx, request.State.Schema, when, diags)
+}
+
+// schemaTypeAtPath is implemented by resource and data source schemas.
+type schemaTypeAtPath interface {
+	TypeAtPath(context.Context, path.Path) (attr.Type, diag.Diagnostics)
+}
+
+func withAWSErrors(ctx context.Context, schema schemaTypeAtPath, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return errs.NewAWSErrorsContext(ctx), diags
+	case Finally:
+		return ctx, describeAWSErrors(ctx, schema, diags)
+	}
+
+	return ctx, diags
+}
+
+// describeAWSErrors describes the error diagnostics created from AWS API errors recorded in the Context.
+func describeAWSErrors(ctx context.Context, schema schemaTypeAtPath, diags diag.Diagnostics) diag.Diagnostics {
+	return fwdiag.WithAWSErrors(ctx, diags, func(name string) bool {
+		if schema == nil {
+			return false
+		}
+
+		_, d := schema.TypeAtPath(ctx, path.Root(name))
+		return !d.HasError()
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
@@ -169,7 +170,9 @@ func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.Schem
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	// TODO Run interceptors.
+	ctx = errs.NewAWSErrorsContext(ctx)
 	w.inner.Read(ctx, request, response)
+	response.Diagnostics = describeAWSErrors(ctx, request.Config.Schema, response.Diagnostics)
 }
 
 func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
@@ -346,6 +349,8 @@ func (r tagsResourceInterceptor) create(ctx context.Context, request resource.Cr
 		tags = tags.IgnoreSystem(inContext.ServicePackageName)
 
 		tagsInContext.TagsIn = types.Some(tags)
+		// Make the tags available to any provider naming template.
+		create.SetNamingTags(ctx, tags.IgnoreAWS().Map())
 	case After:
 		// Set values for unknowns.
 		// Remove any provider configured ignore_tags and system tags from those passed to the service API.
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -271,6 +272,46 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"naming": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to generate resource names across all resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"purpose_tag": schema.StringAttribute{
+							Optional:    true,
+							Description: "Key of the resource tag whose value is available to naming templates as `purpose`.",
+						},
+						"template": schema.StringAttribute{
+							Optional:    true,
+							Description: "Template used to generate a resource's name when neither its name nor name prefix is configured.",
+						},
+						"variables": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Values available to naming templates.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"override": schema.ListNestedBlock{
+							Description: "Naming templates for specific resource types.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"resource_type": schema.StringAttribute{
+										Required:    true,
+										Description: "Resource type, e.g. `aws_s3_bucket`.",
+									},
+									"template": schema.StringAttribute{
+										Required:    true,
+										Description: "Template used to generate names for the resource type.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -389,11 +430,12 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = create.NewNamingContext(ctx, meta.NamingConfig, typeName, servicePackageName)
 				}
 
 				return ctx
 			}
-			interceptors := resourceInterceptors{}
+			interceptors := resourceInterceptors{awsErrorsResourceInterceptor{}}
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// namingAttributes returns the names of a resource's name and name prefix attributes, e.g. `name` and `name_prefix`.
+func namingAttributes(s map[string]*schema.Schema) (string, string, bool) {
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v := s[k]
+		prefix := k + "_prefix"
+		p, ok := s[prefix]
+
+		if !ok {
+			continue
+		}
+
+		if v.Type == schema.TypeString && v.Optional && v.Computed && p.Type == schema.TypeString && p.Optional {
+			return k, prefix, true
+		}
+	}
+
+	return "", "", false
+}
+
+// namingCustomizeDiff returns a CustomizeDiffFunc that generates, at plan time, any name from the provider
+// naming template and reports the template if the name isn't valid for the name attribute.
+func namingCustomizeDiff(nameAttr, namePrefixAttr string, nameSchema *schema.Schema, hasTags bool) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		if d.Id() != "" {
+			return nil
+		}
+
+		template, ok := create.NamingTemplateFromContext(ctx)
+
+		if !ok {
+			return nil
+		}
+
+		config := d.GetRawConfig()
+
+		if config.IsNull() || !config.IsKnown() {
+			return nil
+		}
+
+		if !config.GetAttr(nameAttr).IsNull() || !config.GetAttr(namePrefixAttr).IsNull() {
+			return nil
+		}
+
+		if hasTags {
+			// The purpose tag's value may not be known until apply.
+			if !config.GetAttr(names.AttrTags).IsWhollyKnown() {
+				return nil
+			}
+
+			create.SetNamingTags(ctx, namingTags(ctx, d.Get(names.AttrTags).(map[string]any), meta))
+		}
+
+		name, err := create.NameFromTemplate(ctx)
+
+		if err != nil {
+			return fmt.Errorf("naming template (%s): %w", template, err)
+		}
+
+		if err := validateGeneratedName(nameAttr, nameSchema, name); err != nil {
+			return fmt.Errorf("naming template (%s): %w", template, err)
+		}
+
+		return nil
+	}
+}
+
+// validateGeneratedName validates a name generated from a naming template using the name attribute's own validation.
+func validateGeneratedName(nameAttr string, nameSchema *schema.Schema, name string) error {
+	var errs []error
+
+	if f := nameSchema.ValidateDiagFunc; f != nil {
+		if err := sdkdiag.DiagnosticsError(f(name, cty.GetAttrPath(nameAttr))); err != nil {
+			errs = append(errs, err)
+		}
+	} else if f := nameSchema.ValidateFunc; f != nil {
+		_, es := f(name, nameAttr)
+		errs = append(errs, es...)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("generates %s (%s) that is not valid: %w", nameAttr, name, err)
+	}
+
+	return nil
+}
+
+// validateNamingOverrides validates the names generated from the naming template overrides
+// for resource types whose name attribute is known.
+func validateNamingOverrides(config *create.NamingConfig, resources map[string]namingResource) error {
+	if config == nil {
+		return nil
+	}
+
+	for resourceType := range config.Overrides {
+		v, ok := resources[resourceType]
+
+		if !ok {
+			continue
+		}
+
+		name, err := config.ExampleName(resourceType, v.servicePackageName)
+
+		if err != nil {
+			return fmt.Errorf("override (%s): %w", resourceType, err)
+		}
+
+		if err := validateGeneratedName(v.nameAttr, v.nameSchema, name); err != nil {
+			return fmt.Errorf("override (%s): template (%s) %w", resourceType, config.TemplateFor(resourceType), err)
+		}
+	}
+
+	return nil
+}
+
+// namingResource is a resource type whose name can be generated from the provider naming template.
+type namingResource struct {
+	servicePackageName string
+	nameAttr           string
+	nameSchema         *schema.Schema
+}
+
+// namingTags returns a resource's configured tags merged with any provider default tags.
+// The naming template's purpose is taken from these tags.
+func namingTags(ctx context.Context, tags map[string]any, meta any) map[string]string {
+	v := tftags.New(ctx, tags)
+
+	if meta, ok := meta.(*conns.AWSClient); ok {
+		v = meta.DefaultTagsConfig.MergeTags(v)
+	}
+
+	return v.IgnoreAWS().Map()
+}
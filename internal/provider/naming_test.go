@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+)
+
+func TestNamingAttributes(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName                string
+		Schema                  map[string]*schema.Schema
+		ExpectedName            string
+		ExpectedNamePrefix      string
+		ExpectedNamingSupported bool
+	}{
+		{
+			TestName: "name and name_prefix",
+			Schema: map[string]*schema.Schema{
+				"arn":         {Type: schema.TypeString, Computed: true},
+				"name":        {Type: schema.TypeString, Optional: true, Computed: true},
+				"name_prefix": {Type: schema.TypeString, Optional: true, Computed: true},
+			},
+			ExpectedName:            "name",
+			ExpectedNamePrefix:      "name_prefix",
+			ExpectedNamingSupported: true,
+		},
+		{
+			TestName: "bucket and bucket_prefix",
+			Schema: map[string]*schema.Schema{
+				"bucket":        {Type: schema.TypeString, Optional: true, Computed: true},
+				"bucket_prefix": {Type: schema.TypeString, Optional: true, Computed: true},
+			},
+			ExpectedName:            "bucket",
+			ExpectedNamePrefix:      "bucket_prefix",
+			ExpectedNamingSupported: true,
+		},
+		{
+			TestName: "required name",
+			Schema: map[string]*schema.Schema{
+				"name":        {Type: schema.TypeString, Required: true},
+				"name_prefix": {Type: schema.TypeString, Optional: true},
+			},
+		},
+		{
+			TestName: "no name_prefix",
+			Schema: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Optional: true, Computed: true},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			name, namePrefix, ok := namingAttributes(testCase.Schema)
+
+			if got, expected := ok, testCase.ExpectedNamingSupported; got != expected {
+				t.Errorf("got %t, expected %t", got, expected)
+			}
+			if got, expected := name, testCase.ExpectedName; got != expected {
+				t.Errorf("got %s, expected %s", got, expected)
+			}
+			if got, expected := namePrefix, testCase.ExpectedNamePrefix; got != expected {
+				t.Errorf("got %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestValidateNamingOverrides(t *testing.T) {
+	t.Parallel()
+
+	resources := map[string]namingResource{
+		"aws_sqs_queue": {
+			servicePackageName: "sqs",
+			nameAttr:           "name",
+			nameSchema: &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 40),
+			},
+		},
+	}
+
+	testCases := []struct {
+		TestName    string
+		Config      *create.NamingConfig
+		ExpectError bool
+	}{
+		{
+			TestName: "nil",
+		},
+		{
+			TestName: "valid",
+			Config: &create.NamingConfig{
+				Overrides: map[string]string{"aws_sqs_queue": "{{.env}}-{{unique}}"},
+				Variables: map[string]string{"env": "prod"},
+			},
+		},
+		{
+			TestName: "too long",
+			Config: &create.NamingConfig{
+				Overrides: map[string]string{"aws_sqs_queue": "{{.env}}-{{.service}}-billing-{{unique}}"},
+				Variables: map[string]string{"env": "prod"},
+			},
+			ExpectError: true,
+		},
+		{
+			TestName: "unknown resource type",
+			Config: &create.NamingConfig{
+				Overrides: map[string]string{"aws_example_thing": "{{.env}}-{{.service}}-billing-{{unique}}"},
+				Variables: map[string]string{"env": "prod"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateNamingOverrides(testCase.Config, resources)
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
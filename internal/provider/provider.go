@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"log"
 	"os"
 	"time"
 
@@ -18,9 +17,12 @@ import (
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -133,6 +135,51 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"being executed. If the API request still fails, an error is\n" +
 					"thrown.",
 			},
+			"naming": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to generate resource names across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"override": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Naming templates for specific resource types.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_type": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Resource type, e.g. `aws_s3_bucket`.",
+									},
+									"template": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Template used to generate names for the resource type.",
+									},
+								},
+							},
+						},
+						"purpose_tag": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Key of the resource tag whose value is available to naming templates as `purpose`.",
+						},
+						"template": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Template used to generate a resource's name when neither its name nor name prefix is configured.",
+						},
+						"variables": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Values available to naming templates.",
+						},
+					},
+				},
+			},
 			"profile": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -240,8 +287,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		ResourcesMap:   make(map[string]*schema.Resource),
 	}
 
+	// Resource types whose name can be generated from the provider naming template.
+	namingResources := make(map[string]namingResource)
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		return configure(ctx, provider, d)
+		return configure(ctx, provider, d, namingResources)
 	}
 
 	var errs *multierror.Error
@@ -277,7 +327,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				{
+					when:        Finally,
+					why:         Read,
+					interceptor: awsErrorsInterceptor{schema: r.SchemaMap()},
+				},
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -349,11 +405,37 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = create.NewNamingContext(ctx, v.NamingConfig, typeName, servicePackageName)
 				}
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				{
+					when:        Finally,
+					why:         AllOps,
+					interceptor: awsErrorsInterceptor{schema: r.SchemaMap()},
+				},
+			}
+
+			nameAttr, namePrefixAttr, namingSupported := namingAttributes(r.SchemaMap())
+			_, hasTags := r.SchemaMap()[names.AttrTags]
+
+			if namingSupported {
+				namingResources[typeName] = namingResource{
+					servicePackageName: servicePackageName,
+					nameAttr:           nameAttr,
+					nameSchema:         r.SchemaMap()[nameAttr],
+				}
+
+				if hasTags {
+					interceptors = append(interceptors, interceptorItem{
+						when:        Before,
+						why:         Create,
+						interceptor: namingResourceInterceptor{},
+					})
+				}
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -412,6 +494,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					r.Importer.StateContext = rs.State(v)
 				}
 			}
+			// Validate any name generated from the provider naming template at plan time.
+			if namingSupported {
+				f := namingCustomizeDiff(nameAttr, namePrefixAttr, r.SchemaMap()[nameAttr], hasTags)
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(v, f)
+				} else {
+					r.CustomizeDiff = f
+				}
+			}
 			if v := r.CustomizeDiff; v != nil {
 				r.CustomizeDiff = rs.CustomizeDiff(v)
 			}
@@ -445,7 +536,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 }
 
 // configure ensures that the provider is fully configured.
-func configure(ctx context.Context, provider *schema.Provider, d *schema.ResourceData) (*conns.AWSClient, diag.Diagnostics) {
+func configure(ctx context.Context, provider *schema.Provider, d *schema.ResourceData, namingResources map[string]namingResource) (*conns.AWSClient, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	terraformVersion := provider.TerraformVersion
@@ -520,16 +611,6 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
-	if v, ok := d.GetOk("endpoints"); ok && v.(*schema.Set).Len() > 0 {
-		endpoints, err := expandEndpoints(ctx, v.(*schema.Set).List())
-
-		if err != nil {
-			return nil, sdkdiag.AppendFromErr(diags, err)
-		}
-
-		config.Endpoints = endpoints
-	}
-
 	if v, ok := d.GetOk("forbidden_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
@@ -542,6 +623,20 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("naming"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		namingConfig := expandNaming(v.([]interface{})[0].(map[string]interface{}))
+
+		if err := namingConfig.Validate(); err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "naming: %s", err)
+		}
+
+		if err := validateNamingOverrides(namingConfig, namingResources); err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "naming: %s", err)
+		}
+
+		config.NamingConfig = namingConfig
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -550,6 +645,29 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.SharedConfigFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
 
+	sharedConfig, err := loadSharedConfigEndpoints(config.SharedConfigFiles, config.Profile)
+
+	if err != nil {
+		diags = append(diags, errs.NewWarningDiagnostic(
+			"Unable to read endpoints from AWS shared config files",
+			fmt.Sprintf("Service endpoints configured in AWS shared config files are ignored: %s", err),
+		))
+	}
+
+	var tfList []interface{}
+	if v, ok := d.GetOk("endpoints"); ok {
+		tfList = v.(*schema.Set).List()
+	}
+
+	serviceEndpoints, ds := expandEndpoints(ctx, tfList, sharedConfig)
+	diags = append(diags, ds...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	config.Endpoints = serviceEndpoints
+
 	if v, null, _ := nullable.Bool(d.Get("skip_metadata_api_check").(string)).Value(); !null {
 		if v {
 			config.EC2MetadataServiceEnableState = imds.ClientDisabled
@@ -823,6 +941,42 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	return defaultConfig
 }
 
+func expandNaming(tfMap map[string]interface{}) *create.NamingConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	namingConfig := &create.NamingConfig{}
+
+	if v, ok := tfMap["purpose_tag"].(string); ok && v != "" {
+		namingConfig.PurposeTag = v
+	}
+
+	if v, ok := tfMap["template"].(string); ok && v != "" {
+		namingConfig.Template = v
+	}
+
+	if v, ok := tfMap["variables"].(map[string]interface{}); ok && len(v) > 0 {
+		namingConfig.Variables = flex.ExpandStringValueMap(v)
+	}
+
+	if v, ok := tfMap["override"].([]interface{}); ok && len(v) > 0 {
+		namingConfig.Overrides = make(map[string]string)
+
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			namingConfig.Overrides[tfMap["resource_type"].(string)] = tfMap["template"].(string)
+		}
+	}
+
+	return namingConfig
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	if tfMap == nil {
 		return nil
@@ -841,10 +995,19 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
-func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, error) {
-	if len(tfList) == 0 {
-		return nil, nil
-	}
+// expandEndpoints returns the custom endpoint of each service. In order of precedence, an endpoint is configured by:
+//
+//   - the provider's `endpoints` block
+//   - the service's TF_AWS_<SERVICE>_ENDPOINT environment variable, or its deprecated equivalent
+//   - the AWS-standard AWS_ENDPOINT_URL_<SERVICE> environment variable
+//   - the AWS-standard AWS_ENDPOINT_URL environment variable
+//   - the service's `endpoint_url` in the `services` section of the profile in the AWS shared config files
+//   - the profile's `endpoint_url` in the AWS shared config files
+//
+// The AWS-standard sources are ignored if AWS_IGNORE_CONFIGURED_ENDPOINT_URLS or the profile's
+// `ignore_configured_endpoint_urls` is true.
+func expandEndpoints(_ context.Context, tfList []interface{}, sharedConfig *sharedConfigEndpoints) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
 
 	endpoints := make(map[string]string)
 
@@ -859,7 +1022,7 @@ func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string
 			pkg, err := names.ProviderPackageForAlias(alias)
 
 			if err != nil {
-				return nil, fmt.Errorf("failed to assign endpoint (%s): %w", alias, err)
+				return nil, sdkdiag.AppendErrorf(diags, "failed to assign endpoint (%s): %s", alias, err)
 			}
 
 			if endpoints[pkg] == "" {
@@ -870,6 +1033,17 @@ func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string
 		}
 	}
 
+	if sharedConfig == nil {
+		sharedConfig = &sharedConfigEndpoints{}
+	}
+
+	ignoreConfiguredEndpointURLs := sharedConfig.IgnoreConfiguredEndpointURLs
+	if v, null, err := nullable.Bool(os.Getenv(awsIgnoreConfiguredEndpointURLsEnvVar)).Value(); err != nil {
+		return nil, sdkdiag.AppendErrorf(diags, "environment variable %s: %s", awsIgnoreConfiguredEndpointURLsEnvVar, err)
+	} else if !null {
+		ignoreConfiguredEndpointURLs = v
+	}
+
 	for _, pkg := range names.ProviderPackages() {
 		if endpoints[pkg] != "" {
 			continue
@@ -885,12 +1059,42 @@ func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string
 
 		if deprecatedEnvVar := names.DeprecatedEnvVar(pkg); deprecatedEnvVar != "" {
 			if v := os.Getenv(deprecatedEnvVar); v != "" {
-				// TODO: Make this a Warning Diagnostic
-				log.Printf("[WARN] The environment variable %q is deprecated. Use %q instead.", deprecatedEnvVar, envVar)
+				diags = append(diags, errs.NewWarningDiagnostic(
+					"Deprecated environment variable",
+					fmt.Sprintf("The environment variable %q is deprecated. Use %q instead.", deprecatedEnvVar, envVar),
+				))
+				endpoints[pkg] = v
+				continue
+			}
+		}
+
+		if ignoreConfiguredEndpointURLs {
+			continue
+		}
+
+		if envVar := names.AWSServiceEnvVar(pkg); envVar != "" {
+			if v := os.Getenv(envVar); v != "" {
 				endpoints[pkg] = v
+				continue
 			}
 		}
+
+		if v := os.Getenv(awsEndpointURLEnvVar); v != "" {
+			endpoints[pkg] = v
+			continue
+		}
+
+		if key := names.AWSConfigFileServiceKey(pkg); key != "" {
+			if v := sharedConfig.Services[key]; v != "" {
+				endpoints[pkg] = v
+				continue
+			}
+		}
+
+		if v := sharedConfig.EndpointURL; v != "" {
+			endpoints[pkg] = v
+		}
 	}
 
-	return endpoints, nil
+	return endpoints, diags
 }
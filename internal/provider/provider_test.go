@@ -39,9 +39,9 @@ func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	}
 	endpoints["sts"] = "https://sts.fake.test"
 
-	results, err := expandEndpoints(ctx, []interface{}{endpoints})
-	if err != nil {
-		t.Fatalf("Unexpected error: %s", err)
+	results, diags := expandEndpoints(ctx, []interface{}{endpoints}, nil)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
 	}
 
 	if len(results) != 1 {
@@ -96,9 +96,9 @@ func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 			endpoints[k] = v
 		}
 
-		results, err := expandEndpoints(ctx, []interface{}{endpoints})
-		if err != nil {
-			t.Fatalf("Unexpected error: %s", err)
+		results, diags := expandEndpoints(ctx, []interface{}{endpoints}, nil)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
 		}
 
 		if a, e := len(results), 1; a != e {
@@ -116,8 +116,10 @@ func TestEndpointEnvVarPrecedence(t *testing.T) { //nolint:paralleltest
 	testcases := []struct {
 		endpoints        map[string]string
 		envvars          map[string]string
+		sharedConfig     *sharedConfigEndpoints
 		expectedService  string
 		expectedEndpoint string
+		expectedWarning  bool
 	}{
 		{
 			endpoints: map[string]string{},
@@ -134,6 +136,7 @@ func TestEndpointEnvVarPrecedence(t *testing.T) { //nolint:paralleltest
 			},
 			expectedService:  names.STS,
 			expectedEndpoint: "https://sts-deprecated.fake.test",
+			expectedWarning:  true,
 		},
 		{
 			endpoints: map[string]string{},
@@ -154,6 +157,55 @@ func TestEndpointEnvVarPrecedence(t *testing.T) { //nolint:paralleltest
 			expectedService:  names.STS,
 			expectedEndpoint: "https://sts-config.fake.test",
 		},
+		{
+			endpoints: map[string]string{},
+			envvars: map[string]string{
+				"AWS_ENDPOINT_URL_STS": "https://sts-aws-env.fake.test",
+			},
+			expectedService:  names.STS,
+			expectedEndpoint: "https://sts-aws-env.fake.test",
+		},
+		{
+			endpoints: map[string]string{},
+			envvars: map[string]string{
+				"TF_AWS_STS_ENDPOINT":  "https://sts-env.fake.test",
+				"AWS_ENDPOINT_URL_STS": "https://sts-aws-env.fake.test",
+			},
+			expectedService:  names.STS,
+			expectedEndpoint: "https://sts-env.fake.test",
+		},
+		{
+			endpoints: map[string]string{},
+			sharedConfig: &sharedConfigEndpoints{
+				Services: map[string]string{"sts": "https://sts-shared-config.fake.test"},
+			},
+			expectedService:  names.STS,
+			expectedEndpoint: "https://sts-shared-config.fake.test",
+		},
+		{
+			endpoints: map[string]string{},
+			envvars: map[string]string{
+				"AWS_ENDPOINT_URL_STS": "https://sts-aws-env.fake.test",
+			},
+			sharedConfig: &sharedConfigEndpoints{
+				Services: map[string]string{"sts": "https://sts-shared-config.fake.test"},
+			},
+			expectedService:  names.STS,
+			expectedEndpoint: "https://sts-aws-env.fake.test",
+		},
+		{
+			endpoints: map[string]string{
+				"sts": "https://sts-config.fake.test",
+			},
+			envvars: map[string]string{
+				"AWS_IGNORE_CONFIGURED_ENDPOINT_URLS": "true",
+			},
+			sharedConfig: &sharedConfigEndpoints{
+				Services: map[string]string{"sts": "https://sts-shared-config.fake.test"},
+			},
+			expectedService:  names.STS,
+			expectedEndpoint: "https://sts-config.fake.test",
+		},
 	}
 
 	for _, testcase := range testcases {
@@ -172,9 +224,9 @@ func TestEndpointEnvVarPrecedence(t *testing.T) { //nolint:paralleltest
 			endpoints[k] = v
 		}
 
-		results, err := expandEndpoints(ctx, []interface{}{endpoints})
-		if err != nil {
-			t.Fatalf("Unexpected error: %s", err)
+		results, diags := expandEndpoints(ctx, []interface{}{endpoints}, testcase.sharedConfig)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
 		}
 
 		if a, e := len(results), 1; a != e {
@@ -184,6 +236,80 @@ func TestEndpointEnvVarPrecedence(t *testing.T) { //nolint:paralleltest
 		if v := results[testcase.expectedService]; v != testcase.expectedEndpoint {
 			t.Errorf("Expected endpoint[%s] to be %q, got %v", testcase.expectedService, testcase.expectedEndpoint, results)
 		}
+
+		if a, e := len(diags) > 0, testcase.expectedWarning; a != e {
+			t.Errorf("Expected warning %t, got %v", e, diags)
+		}
+	}
+}
+
+func TestEndpointGlobalPrecedence(t *testing.T) { //nolint:paralleltest
+	ctx := context.Background()
+	testcases := []struct {
+		envvars          map[string]string
+		sharedConfig     *sharedConfigEndpoints
+		expectedService  string
+		expectedEndpoint string
+	}{
+		{
+			envvars: map[string]string{
+				"AWS_ENDPOINT_URL": "https://global-env.fake.test",
+			},
+			sharedConfig: &sharedConfigEndpoints{
+				Services: map[string]string{"sts": "https://sts-shared-config.fake.test"},
+			},
+			expectedService:  names.STS,
+			expectedEndpoint: "https://global-env.fake.test",
+		},
+		{
+			envvars: map[string]string{
+				"AWS_ENDPOINT_URL_STS": "https://sts-aws-env.fake.test",
+				"AWS_ENDPOINT_URL":     "https://global-env.fake.test",
+			},
+			expectedService:  names.STS,
+			expectedEndpoint: "https://sts-aws-env.fake.test",
+		},
+		{
+			sharedConfig: &sharedConfigEndpoints{
+				EndpointURL: "https://global-shared-config.fake.test",
+				Services:    map[string]string{"sts": "https://sts-shared-config.fake.test"},
+			},
+			expectedService:  names.STS,
+			expectedEndpoint: "https://sts-shared-config.fake.test",
+		},
+		{
+			sharedConfig: &sharedConfigEndpoints{
+				EndpointURL: "https://global-shared-config.fake.test",
+			},
+			expectedService:  names.SQS,
+			expectedEndpoint: "https://global-shared-config.fake.test",
+		},
+		{
+			sharedConfig: &sharedConfigEndpoints{
+				EndpointURL:                  "https://global-shared-config.fake.test",
+				IgnoreConfiguredEndpointURLs: true,
+			},
+			expectedService:  names.SQS,
+			expectedEndpoint: "",
+		},
+	}
+
+	for _, testcase := range testcases {
+		oldEnv := stashEnv()
+		defer popEnv(oldEnv)
+
+		for k, v := range testcase.envvars {
+			os.Setenv(k, v)
+		}
+
+		results, diags := expandEndpoints(ctx, nil, testcase.sharedConfig)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		if v := results[testcase.expectedService]; v != testcase.expectedEndpoint {
+			t.Errorf("Expected endpoint[%s] to be %q, got %q", testcase.expectedService, testcase.expectedEndpoint, v)
+		}
 	}
 }
 
@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// AWS-standard environment variables that aren't provided as constants in the AWS SDKs.
+const (
+	awsConfigFileEnvVar                   = "AWS_CONFIG_FILE"
+	awsEndpointURLEnvVar                  = "AWS_ENDPOINT_URL"
+	awsIgnoreConfiguredEndpointURLsEnvVar = "AWS_IGNORE_CONFIGURED_ENDPOINT_URLS"
+	awsProfileEnvVar                      = "AWS_PROFILE"
+)
+
+const defaultSharedConfigFile = "~/.aws/config"
+
+// sharedConfigEndpoints holds the endpoint configuration of a profile in the AWS shared config files.
+// See https://docs.aws.amazon.com/sdkref/latest/guide/feature-ss-endpoints.html.
+type sharedConfigEndpoints struct {
+	// EndpointURL is the profile's `endpoint_url`, used for all services.
+	EndpointURL string
+	// IgnoreConfiguredEndpointURLs is the profile's `ignore_configured_endpoint_urls`.
+	IgnoreConfiguredEndpointURLs bool
+	// Services maps service keys, e.g. "elastic_beanstalk", to the `endpoint_url` in the profile's `services` section.
+	Services map[string]string
+}
+
+// loadSharedConfigEndpoints reads a profile's endpoint configuration from AWS shared config files.
+// Files that don't exist are ignored. Settings in later files take precedence.
+func loadSharedConfigEndpoints(files []string, profile string) (*sharedConfigEndpoints, error) {
+	if len(files) == 0 {
+		files = []string{defaultSharedConfigFile}
+
+		if v := os.Getenv(awsConfigFileEnvVar); v != "" {
+			files = []string{v}
+		}
+	}
+
+	if profile == "" {
+		profile = os.Getenv(awsProfileEnvVar)
+	}
+	if profile == "" {
+		profile = "default"
+	}
+
+	sections := make(map[string]map[string]string)
+
+	for _, file := range files {
+		path, err := homedir.Expand(file)
+
+		if err != nil {
+			return nil, fmt.Errorf("expanding shared config file (%s) path: %w", file, err)
+		}
+
+		f, err := os.Open(path)
+
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("opening shared config file (%s): %w", file, err)
+		}
+
+		err = parseSharedConfig(f, sections)
+		f.Close()
+
+		if err != nil {
+			return nil, fmt.Errorf("reading shared config file (%s): %w", file, err)
+		}
+	}
+
+	return newSharedConfigEndpoints(sections, profile)
+}
+
+func newSharedConfigEndpoints(sections map[string]map[string]string, profile string) (*sharedConfigEndpoints, error) {
+	config := &sharedConfigEndpoints{
+		Services: make(map[string]string),
+	}
+
+	properties, ok := sections["profile "+profile]
+	if !ok && profile == "default" {
+		properties, ok = sections["default"]
+	}
+
+	if !ok {
+		return config, nil
+	}
+
+	config.EndpointURL = properties["endpoint_url"]
+
+	if v, null, err := nullable.Bool(properties["ignore_configured_endpoint_urls"]).Value(); err != nil {
+		return nil, fmt.Errorf("profile (%s) ignore_configured_endpoint_urls: %w", profile, err)
+	} else if !null {
+		config.IgnoreConfiguredEndpointURLs = v
+	}
+
+	if v := properties["services"]; v != "" {
+		services, ok := sections["services "+v]
+
+		if !ok {
+			return nil, fmt.Errorf("profile (%s) services section (%s) not found", profile, v)
+		}
+
+		for k, v := range services {
+			if service, ok := strings.CutSuffix(k, ".endpoint_url"); ok {
+				config.Services[service] = v
+			}
+		}
+	}
+
+	return config, nil
+}
+
+// parseSharedConfig parses an AWS shared config file, adding its properties to sections.
+// Sub-properties, e.g.
+//
+//	[services example]
+//	dynamodb =
+//	  endpoint_url = http://localhost:8000
+//
+// are added with their parent's name as a prefix, e.g. "dynamodb.endpoint_url".
+// As the AWS SDKs do, lines that can't be parsed are skipped rather than treated as errors.
+func parseSharedConfig(r io.Reader, sections map[string]map[string]string) error {
+	var (
+		section  string
+		property string
+		parent   string
+	)
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, "[") {
+			// Anything after the closing bracket, e.g. a comment, is ignored.
+			name, _, ok := strings.Cut(strings.TrimPrefix(trimmed, "["), "]")
+
+			// Properties following an invalid section header are skipped.
+			section, property, parent = "", "", ""
+
+			if !ok {
+				continue
+			}
+
+			section = strings.Join(strings.Fields(name), " ")
+
+			if sections[section] == nil {
+				sections[section] = make(map[string]string)
+			}
+
+			continue
+		}
+
+		if section == "" {
+			continue
+		}
+
+		indented := line[0] == ' ' || line[0] == '\t'
+
+		k, v, ok := strings.Cut(trimmed, "=")
+
+		if !ok {
+			continue
+		}
+
+		k, v = strings.ToLower(strings.TrimSpace(k)), stripSharedConfigComment(strings.TrimSpace(v))
+
+		if indented && property != "" {
+			// Indented lines following a property are either sub-properties or continuations of a multi-line value.
+			if parent != "" {
+				sections[section][parent+"."+k] = v
+			}
+
+			continue
+		}
+
+		sections[section][k] = v
+		property = k
+
+		if v == "" {
+			parent = k
+		} else {
+			parent = ""
+		}
+	}
+
+	return scanner.Err()
+}
+
+// stripSharedConfigComment removes any inline comment, a '#' or ';' preceded by whitespace, from a property value.
+func stripSharedConfigComment(v string) string {
+	for i := 1; i < len(v); i++ {
+		if (v[i] == '#' || v[i] == ';') && (v[i-1] == ' ' || v[i-1] == '\t') {
+			return strings.TrimSpace(v[:i])
+		}
+	}
+
+	return v
+}
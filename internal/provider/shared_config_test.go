@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseSharedConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected map[string]map[string]string
+	}{
+		{
+			TestName: "empty",
+			Expected: map[string]map[string]string{},
+		},
+		{
+			TestName: "profiles",
+			Input: `# Comment
+[default]
+region = us-west-2
+
+[profile  dev]
+; Comment
+endpoint_url = http://localhost:4566
+services = local
+`,
+			Expected: map[string]map[string]string{
+				"default": {
+					"region": "us-west-2",
+				},
+				"profile dev": {
+					"endpoint_url": "http://localhost:4566",
+					"services":     "local",
+				},
+			},
+		},
+		{
+			TestName: "sub-properties",
+			Input: `[services local]
+dynamodb =
+  endpoint_url = http://localhost:8000
+Elastic_Beanstalk =
+	endpoint_url = http://localhost:8001
+sqs = ignored
+  endpoint_url = http://localhost:8002
+`,
+			Expected: map[string]map[string]string{
+				"services local": {
+					"dynamodb":                       "",
+					"dynamodb.endpoint_url":          "http://localhost:8000",
+					"elastic_beanstalk":              "",
+					"elastic_beanstalk.endpoint_url": "http://localhost:8001",
+					"sqs":                            "ignored",
+				},
+			},
+		},
+		{
+			TestName: "comments",
+			Input: `[default] # Comment
+region = us-west-2 ; Comment
+endpoint_url = http://localhost:4566/#fragment
+[profile dev]; Comment
+region = us-east-1
+`,
+			Expected: map[string]map[string]string{
+				"default": {
+					"region":       "us-west-2",
+					"endpoint_url": "http://localhost:4566/#fragment",
+				},
+				"profile dev": {
+					"region": "us-east-1",
+				},
+			},
+		},
+		{
+			TestName: "unparseable lines",
+			Input: `region = us-west-2
+[default
+endpoint_url = http://localhost:4566
+[profile dev]
+  region = us-east-1
+invalid
+ca_bundle =
+  -----BEGIN CERTIFICATE-----
+`,
+			Expected: map[string]map[string]string{
+				"profile dev": {
+					"region":    "us-east-1",
+					"ca_bundle": "",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got := make(map[string]map[string]string)
+
+			if err := parseSharedConfig(strings.NewReader(testCase.Input), got); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.Expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestLoadSharedConfigEndpoints(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	file1 := filepath.Join(dir, "config1")
+	file2 := filepath.Join(dir, "config2")
+
+	if err := os.WriteFile(file1, []byte(`[profile dev]
+endpoint_url = http://localhost:4566
+services = local
+
+[services local]
+sts =
+  endpoint_url = http://localhost:4567
+`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(file2, []byte(`[services local]
+elastic_beanstalk =
+  endpoint_url = http://localhost:4568
+
+[default]
+ignore_configured_endpoint_urls = true
+
+[profile broken]
+services = missing
+`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		TestName    string
+		Files       []string
+		Profile     string
+		Expected    *sharedConfigEndpoints
+		ExpectError bool
+	}{
+		{
+			TestName: "profile",
+			Files:    []string{file1, file2, filepath.Join(dir, "missing")},
+			Profile:  "dev",
+			Expected: &sharedConfigEndpoints{
+				EndpointURL: "http://localhost:4566",
+				Services: map[string]string{
+					"elastic_beanstalk": "http://localhost:4568",
+					"sts":               "http://localhost:4567",
+				},
+			},
+		},
+		{
+			TestName: "default profile",
+			Files:    []string{file1, file2},
+			Profile:  "default",
+			Expected: &sharedConfigEndpoints{
+				IgnoreConfiguredEndpointURLs: true,
+				Services:                     map[string]string{},
+			},
+		},
+		{
+			TestName: "missing profile",
+			Files:    []string{file1, file2},
+			Profile:  "prod",
+			Expected: &sharedConfigEndpoints{
+				Services: map[string]string{},
+			},
+		},
+		{
+			TestName:    "missing services section",
+			Files:       []string{file1, file2},
+			Profile:     "broken",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := loadSharedConfigEndpoints(testCase.Files, testCase.Profile)
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error")
+			}
+
+			if testCase.Expected == nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.Expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -7,14 +7,17 @@
 package accessanalyzer
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -22,6 +25,7 @@ func init() {
 		Name: "aws_accessanalyzer_analyzer",
 		F:    sweepAnalyzers,
 	})
+	discovery.Register("aws_accessanalyzer_analyzer", listAnalyzers)
 }
 
 func sweepAnalyzers(region string) error {
@@ -30,6 +34,28 @@ func sweepAnalyzers(region string) error {
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
+
+	sweepResources, err := listAnalyzers(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IAM Access Analyzer Analyzer sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing IAM Access Analyzer Analyzers (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping IAM Access Analyzer Analyzers (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listAnalyzers(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.AccessAnalyzerClient(ctx)
 	input := &accessanalyzer.ListAnalyzersInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
@@ -38,13 +64,8 @@ func sweepAnalyzers(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping IAM Access Analyzer Analyzer sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("listing IAM Access Analyzer Analyzers (%s): %w", region, err)
+			return nil, err
 		}
 
 		for _, v := range page.Analyzers {
@@ -56,11 +77,5 @@ func sweepAnalyzers(region string) error {
 		}
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("sweeping IAM Access Analyzer Analyzers (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
@@ -7,14 +7,17 @@
 package acm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/acm"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -39,6 +42,7 @@ func init() {
 			"aws_lb_listener",
 		},
 	})
+	discovery.Register("aws_acm_certificate", listCertificates)
 }
 
 func sweepCertificates(region string) error {
@@ -47,6 +51,28 @@ func sweepCertificates(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listCertificates(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping ACM Certificate sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing ACM Certificates (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping ACM Certificates (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listCertificates(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.ACMClient(ctx)
 	input := &acm.ListCertificatesInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
@@ -55,13 +81,8 @@ func sweepCertificates(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping ACM Certificate sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error listing ACM Certificates (%s): %w", region, err)
+			return nil, err
 		}
 
 		for _, v := range page.CertificateSummaryList {
@@ -100,11 +121,5 @@ func sweepCertificates(region string) error {
 		}
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping ACM Certificates (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
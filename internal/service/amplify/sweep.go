@@ -7,13 +7,16 @@
 package amplify
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/amplify"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -21,6 +24,7 @@ func init() {
 		Name: "aws_amplify_app",
 		F:    sweepApps,
 	})
+	discovery.Register("aws_amplify_app", listApps)
 }
 
 func sweepApps(region string) error {
@@ -29,11 +33,32 @@ func sweepApps(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listApps(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Amplify App sweep for %s: %s", region, err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error listing Amplify Apps: %w", err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Amplify Apps (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listApps(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.AmplifyConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &amplify.ListAppsInput{}
-	err = listAppsPages(ctx, conn, input, func(page *amplify.ListAppsOutput, lastPage bool) bool {
+	err := listAppsPages(ctx, conn, input, func(page *amplify.ListAppsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -49,19 +74,9 @@ func sweepApps(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Amplify App sweep for %s: %s", region, err)
-		return nil
-	}
 	if err != nil {
-		return fmt.Errorf("error listing Amplify Apps: %w", err)
+		return nil, err
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping Amplify Apps (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
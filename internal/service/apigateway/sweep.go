@@ -7,6 +7,7 @@
 package apigateway
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -17,7 +18,9 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -30,16 +33,19 @@ func init() {
 		Name: "aws_api_gateway_vpc_link",
 		F:    sweepVPCLinks,
 	})
+	discovery.Register("aws_api_gateway_vpc_link", listVPCLinks)
 
 	resource.AddTestSweepers("aws_api_gateway_client_certificate", &resource.Sweeper{
 		Name: "aws_api_gateway_client_certificate",
 		F:    sweepClientCertificates,
 	})
+	discovery.Register("aws_api_gateway_client_certificate", listClientCertificates)
 
 	resource.AddTestSweepers("aws_api_gateway_usage_plan", &resource.Sweeper{
 		Name: "aws_api_gateway_usage_plan",
 		F:    sweepUsagePlans,
 	})
+	discovery.Register("aws_api_gateway_usage_plan", listUsagePlans)
 
 	resource.AddTestSweepers("aws_api_gateway_api_key", &resource.Sweeper{
 		Name: "aws_api_gateway_api_key",
@@ -48,11 +54,13 @@ func init() {
 			"aws_api_gateway_usage_plan",
 		},
 	})
+	discovery.Register("aws_api_gateway_api_key", listAPIKeys)
 
 	resource.AddTestSweepers("aws_api_gateway_domain_name", &resource.Sweeper{
 		Name: "aws_api_gateway_domain_name",
 		F:    sweepDomainNames,
 	})
+	discovery.Register("aws_api_gateway_domain_name", listDomainNames)
 }
 
 func sweepRestAPIs(region string) error {
@@ -104,12 +112,31 @@ func sweepVPCLinks(region string) error {
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
+
+	var sweeperErrs *multierror.Error
+
+	sweepResources, err := listVPCLinks(ctx, client)
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping API Gateway VPC Link sweep for %s: %s", region, err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("retrieving API Gateway VPC Links: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("sweeping API Gateway VPC Links: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func listVPCLinks(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.APIGatewayConn(ctx)
 
 	sweepResources := make([]sweep.Sweepable, 0)
-	var sweeperErrs *multierror.Error
 
-	err = conn.GetVpcLinksPagesWithContext(ctx, &apigateway.GetVpcLinksInput{}, func(page *apigateway.GetVpcLinksOutput, lastPage bool) bool {
+	err := conn.GetVpcLinksPagesWithContext(ctx, &apigateway.GetVpcLinksInput{}, func(page *apigateway.GetVpcLinksOutput, lastPage bool) bool {
 		for _, item := range page.Items {
 			id := aws.StringValue(item.Id)
 
@@ -121,19 +148,12 @@ func sweepVPCLinks(region string) error {
 		}
 		return !lastPage
 	})
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping API Gateway VPC Link sweep for %s: %s", region, err)
-		return nil
-	}
-	if err != nil {
-		return fmt.Errorf("retrieving API Gateway VPC Links: %w", err)
-	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("sweeping API Gateway VPC Links: %w", err))
+	if err != nil {
+		return nil, err
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepResources, nil
 }
 
 func sweepClientCertificates(region string) error {
@@ -143,11 +163,31 @@ func sweepClientCertificates(region string) error {
 		return fmt.Errorf("getting client: %s", err)
 	}
 
+	var errs *multierror.Error
+
+	sweepResources, err := listClientCertificates(ctx, client)
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("describing API Gateway Client Certificates for %s: %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping API Gateway Client Certificates for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping API Gateway Client Certificate sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func listClientCertificates(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.APIGatewayConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
-	var errs *multierror.Error
 
-	err = conn.GetClientCertificatesPagesWithContext(ctx, &apigateway.GetClientCertificatesInput{}, func(page *apigateway.GetClientCertificatesOutput, lastPage bool) bool {
+	err := conn.GetClientCertificatesPagesWithContext(ctx, &apigateway.GetClientCertificatesInput{}, func(page *apigateway.GetClientCertificatesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -164,35 +204,46 @@ func sweepClientCertificates(region string) error {
 	})
 
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("describing API Gateway Client Certificates for %s: %w", region, err))
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepUsagePlans(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+
+	var errs *multierror.Error
+
+	sweepResources, err := listUsagePlans(ctx, client)
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("describing API Gateway Usage Plans for %s: %w", region, err))
 	}
 
 	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("sweeping API Gateway Client Certificates for %s: %w", region, err))
+		errs = multierror.Append(errs, fmt.Errorf("sweeping API Gateway Usage Plans for %s: %w", region, err))
 	}
 
 	if sweep.SkipSweepError(errs.ErrorOrNil()) {
-		log.Printf("[WARN] Skipping API Gateway Client Certificate sweep for %s: %s", region, errs)
+		log.Printf("[WARN] Skipping API Gateway Usage Plan sweep for %s: %s", region, errs)
 		return nil
 	}
 
 	return errs.ErrorOrNil()
 }
 
-func sweepUsagePlans(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("getting client: %s", err)
-	}
-
-	log.Printf("[INFO] Sweeping API Gateway Usage Plans for %s", region)
+func listUsagePlans(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	log.Printf("[INFO] Sweeping API Gateway Usage Plans for %s", client.Region)
 
 	conn := client.APIGatewayConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
-	var errs *multierror.Error
 
-	err = conn.GetUsagePlansPagesWithContext(ctx, &apigateway.GetUsagePlansInput{}, func(page *apigateway.GetUsagePlansOutput, lastPage bool) bool {
+	err := conn.GetUsagePlansPagesWithContext(ctx, &apigateway.GetUsagePlansInput{}, func(page *apigateway.GetUsagePlansOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -212,35 +263,46 @@ func sweepUsagePlans(region string) error {
 	})
 
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("describing API Gateway Usage Plans for %s: %w", region, err))
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepAPIKeys(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+
+	var errs *multierror.Error
+
+	sweepResources, err := listAPIKeys(ctx, client)
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("describing API Gateway API Keys for %s: %w", region, err))
 	}
 
 	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("sweeping API Gateway Usage Plans for %s: %w", region, err))
+		errs = multierror.Append(errs, fmt.Errorf("sweeping API Gateway API Keys for %s: %w", region, err))
 	}
 
 	if sweep.SkipSweepError(errs.ErrorOrNil()) {
-		log.Printf("[WARN] Skipping API Gateway Usage Plan sweep for %s: %s", region, errs)
+		log.Printf("[WARN] Skipping API Gateway API Key sweep for %s: %s", region, errs)
 		return nil
 	}
 
 	return errs.ErrorOrNil()
 }
 
-func sweepAPIKeys(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("getting client: %s", err)
-	}
-
-	log.Printf("[INFO] Sweeping API Gateway API Keys for %s", region)
+func listAPIKeys(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	log.Printf("[INFO] Sweeping API Gateway API Keys for %s", client.Region)
 
 	conn := client.APIGatewayConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
-	var errs *multierror.Error
 
-	err = conn.GetApiKeysPagesWithContext(ctx, &apigateway.GetApiKeysInput{}, func(page *apigateway.GetApiKeysOutput, lastPage bool) bool {
+	err := conn.GetApiKeysPagesWithContext(ctx, &apigateway.GetApiKeysInput{}, func(page *apigateway.GetApiKeysOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -259,35 +321,46 @@ func sweepAPIKeys(region string) error {
 	})
 
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("describing API Gateway API Keys for %s: %w", region, err))
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepDomainNames(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+
+	var errs *multierror.Error
+
+	sweepResources, err := listDomainNames(ctx, client)
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("describing API Gateway Domain Names for %s: %w", region, err))
 	}
 
 	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("sweeping API Gateway API Keys for %s: %w", region, err))
+		errs = multierror.Append(errs, fmt.Errorf("sweeping API Gateway Domain Names for %s: %w", region, err))
 	}
 
 	if sweep.SkipSweepError(errs.ErrorOrNil()) {
-		log.Printf("[WARN] Skipping API Gateway API Key sweep for %s: %s", region, errs)
+		log.Printf("[WARN] Skipping API Gateway Domain Name sweep for %s: %s", region, errs)
 		return nil
 	}
 
 	return errs.ErrorOrNil()
 }
 
-func sweepDomainNames(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("getting client: %s", err)
-	}
-
-	log.Printf("[INFO] Sweeping API Gateway Domain Names for %s", region)
+func listDomainNames(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	log.Printf("[INFO] Sweeping API Gateway Domain Names for %s", client.Region)
 
 	conn := client.APIGatewayConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
-	var errs *multierror.Error
 
-	err = conn.GetDomainNamesPagesWithContext(ctx, &apigateway.GetDomainNamesInput{}, func(page *apigateway.GetDomainNamesOutput, lastPage bool) bool {
+	err := conn.GetDomainNamesPagesWithContext(ctx, &apigateway.GetDomainNamesInput{}, func(page *apigateway.GetDomainNamesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -306,17 +379,8 @@ func sweepDomainNames(region string) error {
 	})
 
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("describing API Gateway Domain Names for %s: %w", region, err))
-	}
-
-	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("sweeping API Gateway Domain Names for %s: %w", region, err))
+		return nil, err
 	}
 
-	if sweep.SkipSweepError(errs.ErrorOrNil()) {
-		log.Printf("[WARN] Skipping API Gateway Domain Name sweep for %s: %s", region, errs)
-		return nil
-	}
-
-	return errs.ErrorOrNil()
+	return sweepResources, nil
 }
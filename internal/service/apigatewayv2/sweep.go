@@ -7,6 +7,7 @@
 package apigatewayv2
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,7 +15,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -25,11 +28,13 @@ func init() {
 			"aws_apigatewayv2_domain_name",
 		},
 	})
+	discovery.Register("aws_apigatewayv2_api", listAPIs)
 
 	resource.AddTestSweepers("aws_apigatewayv2_api_mapping", &resource.Sweeper{
 		Name: "aws_apigatewayv2_api_mapping",
 		F:    sweepAPIMappings,
 	})
+	discovery.Register("aws_apigatewayv2_api_mapping", listAPIMappings)
 
 	resource.AddTestSweepers("aws_apigatewayv2_domain_name", &resource.Sweeper{
 		Name: "aws_apigatewayv2_domain_name",
@@ -38,11 +43,13 @@ func init() {
 			"aws_apigatewayv2_api_mapping",
 		},
 	})
+	discovery.Register("aws_apigatewayv2_domain_name", listDomainNames)
 
 	resource.AddTestSweepers("aws_apigatewayv2_vpc_link", &resource.Sweeper{
 		Name: "aws_apigatewayv2_vpc_link",
 		F:    sweepVPCLinks,
 	})
+	discovery.Register("aws_apigatewayv2_vpc_link", listVPCLinks)
 }
 
 func sweepAPIs(region string) error {
@@ -51,11 +58,33 @@ func sweepAPIs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listAPIs(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping API Gateway v2 API sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing API Gateway v2 APIs (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping API Gateway v2 APIs (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listAPIs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.APIGatewayV2Conn(ctx)
 	input := &apigatewayv2.GetApisInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = getAPIsPages(ctx, conn, input, func(page *apigatewayv2.GetApisOutput, lastPage bool) bool {
+	err := getAPIsPages(ctx, conn, input, func(page *apigatewayv2.GetApisOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -71,36 +100,49 @@ func sweepAPIs(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepAPIMappings(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	var sweeperErrs *multierror.Error
+
+	sweepResources, err := listAPIMappings(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping API Gateway v2 API sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping API Gateway v2 API Mapping sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing API Gateway v2 APIs (%s): %w", region, err)
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing API Gateway v2 Domain Names (%s): %w", region, err))
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping API Gateway v2 APIs (%s): %w", region, err)
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping API Gateway v2 API Mappings (%s): %w", region, err))
 	}
 
-	return nil
+	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepAPIMappings(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
-	}
+func listAPIMappings(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.APIGatewayV2Conn(ctx)
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &apigatewayv2.GetDomainNamesInput{}
-	err = getDomainNamesPages(ctx, conn, input, func(page *apigatewayv2.GetDomainNamesOutput, lastPage bool) bool {
+	err := getDomainNamesPages(ctx, conn, input, func(page *apigatewayv2.GetDomainNamesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -133,42 +175,53 @@ func sweepAPIMappings(region string) error {
 			}
 
 			if err != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing API Gateway v2 API Mappings (%s): %w", region, err))
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing API Gateway v2 API Mappings (%s): %w", client.Region, err))
 			}
 		}
 
 		return !lastPage
 	})
 
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, err)
+	}
+
+	return sweepResources, sweeperErrs.ErrorOrNil()
+}
+
+func sweepDomainNames(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	sweepResources, err := listDomainNames(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping API Gateway v2 API Mapping sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping API Gateway v2 Domain Name sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing API Gateway v2 Domain Names (%s): %w", region, err))
+		return fmt.Errorf("error listing API Gateway v2 Domain Names (%s): %w", region, err)
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping API Gateway v2 API Mappings (%s): %w", region, err))
+		return fmt.Errorf("error sweeping API Gateway v2 Domain Names (%s): %w", region, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return nil
 }
 
-func sweepDomainNames(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
-	}
+func listDomainNames(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.APIGatewayV2Conn(ctx)
 	input := &apigatewayv2.GetDomainNamesInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = getDomainNamesPages(ctx, conn, input, func(page *apigatewayv2.GetDomainNamesOutput, lastPage bool) bool {
+	err := getDomainNamesPages(ctx, conn, input, func(page *apigatewayv2.GetDomainNamesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -184,35 +237,46 @@ func sweepDomainNames(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepVPCLinks(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	sweepResources, err := listVPCLinks(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping API Gateway v2 Domain Name sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping API Gateway v2 VPC Link sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing API Gateway v2 Domain Names (%s): %w", region, err)
+		return fmt.Errorf("error listing API Gateway v2 VPC Links (%s): %w", region, err)
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping API Gateway v2 Domain Names (%s): %w", region, err)
+		return fmt.Errorf("error sweeping API Gateway v2 VPC Links (%s): %w", region, err)
 	}
 
 	return nil
 }
 
-func sweepVPCLinks(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func listVPCLinks(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.APIGatewayV2Conn(ctx)
 	input := &apigatewayv2.GetVpcLinksInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = getVPCLinksPages(ctx, conn, input, func(page *apigatewayv2.GetVpcLinksOutput, lastPage bool) bool {
+	err := getVPCLinksPages(ctx, conn, input, func(page *apigatewayv2.GetVpcLinksOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -228,20 +292,9 @@ func sweepVPCLinks(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping API Gateway v2 VPC Link sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("error listing API Gateway v2 VPC Links (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
 	if err != nil {
-		return fmt.Errorf("error sweeping API Gateway v2 VPC Links (%s): %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
@@ -7,6 +7,7 @@
 package applicationinsights
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,7 +15,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/applicationinsights"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -22,6 +25,7 @@ func init() {
 		Name: "aws_applicationinsights_application",
 		F:    sweepApplications,
 	})
+	discovery.Register("aws_applicationinsights_application", listApplications)
 }
 
 func sweepApplications(region string) error {
@@ -32,11 +36,32 @@ func sweepApplications(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
+	var errs *multierror.Error
+
+	sweepResources, err := listApplications(ctx, client)
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error describing ApplicationInsights Applications: %w", err))
+		// in case work can be done, don't jump out yet
+	}
+
+	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping ApplicationInsights Applications for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping ApplicationInsights Application sweep for %s: %s", region, err)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func listApplications(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.ApplicationInsightsConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
-	var errs *multierror.Error
 
-	err = conn.ListApplicationsPagesWithContext(ctx, &applicationinsights.ListApplicationsInput{}, func(resp *applicationinsights.ListApplicationsOutput, lastPage bool) bool {
+	err := conn.ListApplicationsPagesWithContext(ctx, &applicationinsights.ListApplicationsInput{}, func(resp *applicationinsights.ListApplicationsOutput, lastPage bool) bool {
 		if len(resp.ApplicationInfoList) == 0 {
 			log.Print("[DEBUG] No ApplicationInsights Applications to sweep")
 			return !lastPage
@@ -54,18 +79,8 @@ func sweepApplications(region string) error {
 	})
 
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("error describing ApplicationInsights Applications: %w", err))
-		// in case work can be done, don't jump out yet
-	}
-
-	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("error sweeping ApplicationInsights Applications for %s: %w", region, err))
-	}
-
-	if sweep.SkipSweepError(errs.ErrorOrNil()) {
-		log.Printf("[WARN] Skipping ApplicationInsights Application sweep for %s: %s", region, err)
-		return nil
+		return nil, err
 	}
 
-	return errs.ErrorOrNil()
+	return sweepResources, nil
 }
@@ -7,6 +7,7 @@
 package appmesh
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,7 +15,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/appmesh"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -22,6 +25,7 @@ func init() {
 		Name: "aws_appmesh_gateway_route",
 		F:    sweepGatewayRoutes,
 	})
+	discovery.Register("aws_appmesh_gateway_route", listGatewayRoutes)
 
 	resource.AddTestSweepers("aws_appmesh_mesh", &resource.Sweeper{
 		Name: "aws_appmesh_mesh",
@@ -33,11 +37,13 @@ func init() {
 			"aws_appmesh_virtual_gateway",
 		},
 	})
+	discovery.Register("aws_appmesh_mesh", listMeshes)
 
 	resource.AddTestSweepers("aws_appmesh_route", &resource.Sweeper{
 		Name: "aws_appmesh_route",
 		F:    sweepRoutes,
 	})
+	discovery.Register("aws_appmesh_route", listRoutes)
 
 	resource.AddTestSweepers("aws_appmesh_virtual_gateway", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_gateway",
@@ -46,11 +52,13 @@ func init() {
 			"aws_appmesh_gateway_route",
 		},
 	})
+	discovery.Register("aws_appmesh_virtual_gateway", listVirtualGateways)
 
 	resource.AddTestSweepers("aws_appmesh_virtual_node", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_node",
 		F:    sweepVirtualNodes,
 	})
+	discovery.Register("aws_appmesh_virtual_node", listVirtualNodes)
 
 	resource.AddTestSweepers("aws_appmesh_virtual_router", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_router",
@@ -59,11 +67,13 @@ func init() {
 			"aws_appmesh_route",
 		},
 	})
+	discovery.Register("aws_appmesh_virtual_router", listVirtualRouters)
 
 	resource.AddTestSweepers("aws_appmesh_virtual_service", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_service",
 		F:    sweepVirtualServices,
 	})
+	discovery.Register("aws_appmesh_virtual_service", listVirtualServices)
 }
 
 func sweepMeshes(region string) error {
@@ -72,11 +82,33 @@ func sweepMeshes(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listMeshes(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping App Mesh Service Mesh sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing App Mesh Service Meshes (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping App Mesh Service Meshes (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listMeshes(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.AppMeshConn(ctx)
 	input := &appmesh.ListMeshesInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListMeshesPagesWithContext(ctx, input, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
+	err := conn.ListMeshesPagesWithContext(ctx, input, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -92,36 +124,49 @@ func sweepMeshes(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepVirtualGateways(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	var sweeperErrs *multierror.Error
+
+	sweepResources, err := listVirtualGateways(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping App Mesh Service Mesh sweep for %s: %s", region, err)
-		return nil
+		log.Printf("[WARN] Skipping App Mesh Virtual Gateway sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing App Mesh Service Meshes (%s): %w", region, err)
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing App Mesh Service Meshes (%s): %w", region, err))
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping App Mesh Service Meshes (%s): %w", region, err)
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping App Mesh Virtual Gateways (%s): %w", region, err))
 	}
 
-	return nil
+	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepVirtualGateways(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
-	}
+func listVirtualGateways(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.AppMeshConn(ctx)
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &appmesh.ListMeshesInput{}
-	err = conn.ListMeshesPagesWithContext(ctx, input, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
+	err := conn.ListMeshesPagesWithContext(ctx, input, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -156,15 +201,33 @@ func sweepVirtualGateways(region string) error {
 			}
 
 			if err != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing App Mesh Virtual Gateways (%s): %w", region, err))
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing App Mesh Virtual Gateways (%s): %w", client.Region, err))
 			}
 		}
 
 		return !lastPage
 	})
 
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, err)
+	}
+
+	return sweepResources, sweeperErrs.ErrorOrNil()
+}
+
+func sweepVirtualNodes(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	var sweeperErrs *multierror.Error
+
+	sweepResources, err := listVirtualNodes(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping App Mesh Virtual Gateway sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping App Mesh Virtual Node sweep for %s: %s", region, err)
 		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 	}
 
@@ -175,24 +238,19 @@ func sweepVirtualGateways(region string) error {
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping App Mesh Virtual Gateways (%s): %w", region, err))
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping App Mesh Virtual Nodes (%s): %w", region, err))
 	}
 
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepVirtualNodes(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
-	}
+func listVirtualNodes(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.AppMeshConn(ctx)
 	input := &appmesh.ListMeshesInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListMeshesPagesWithContext(ctx, input, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
+	err := conn.ListMeshesPagesWithContext(ctx, input, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -227,15 +285,33 @@ func sweepVirtualNodes(region string) error {
 			}
 
 			if err != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing App Mesh Virtual Nodes (%s): %w", region, err))
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing App Mesh Virtual Nodes (%s): %w", client.Region, err))
 			}
 		}
 
 		return !lastPage
 	})
 
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, err)
+	}
+
+	return sweepResources, sweeperErrs.ErrorOrNil()
+}
+
+func sweepVirtualRouters(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	var sweeperErrs *multierror.Error
+
+	sweepResources, err := listVirtualRouters(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping App Mesh Virtual Node sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping App Mesh Virtual Router sweep for %s: %s", region, err)
 		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 	}
 
@@ -246,24 +322,19 @@ func sweepVirtualNodes(region string) error {
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping App Mesh Virtual Nodes (%s): %w", region, err))
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping App Mesh Virtual Routers (%s): %w", region, err))
 	}
 
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepVirtualRouters(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func listVirtualRouters(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.AppMeshConn(ctx)
 	input := &appmesh.ListMeshesInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListMeshesPagesWithContext(ctx, input, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
+	err := conn.ListMeshesPagesWithContext(ctx, input, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -298,15 +369,33 @@ func sweepVirtualRouters(region string) error {
 			}
 
 			if err != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing App Mesh Virtual Routers (%s): %w", region, err))
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing App Mesh Virtual Routers (%s): %w", client.Region, err))
 			}
 		}
 
 		return !lastPage
 	})
 
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, err)
+	}
+
+	return sweepResources, sweeperErrs.ErrorOrNil()
+}
+
+func sweepVirtualServices(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	var sweeperErrs *multierror.Error
+
+	sweepResources, err := listVirtualServices(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping App Mesh Virtual Router sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping App Mesh Virtual Service sweep for %s: %s", region, err)
 		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 	}
 
@@ -317,24 +406,19 @@ func sweepVirtualRouters(region string) error {
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping App Mesh Virtual Routers (%s): %w", region, err))
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping App Mesh Virtual Services (%s): %w", region, err))
 	}
 
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepVirtualServices(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func listVirtualServices(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.AppMeshConn(ctx)
 	input := &appmesh.ListMeshesInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListMeshesPagesWithContext(ctx, input, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
+	err := conn.ListMeshesPagesWithContext(ctx, input, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -369,15 +453,33 @@ func sweepVirtualServices(region string) error {
 			}
 
 			if err != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing App Mesh Virtual Services (%s): %w", region, err))
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing App Mesh Virtual Services (%s): %w", client.Region, err))
 			}
 		}
 
 		return !lastPage
 	})
 
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, err)
+	}
+
+	return sweepResources, sweeperErrs.ErrorOrNil()
+}
+
+func sweepGatewayRoutes(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	var sweeperErrs *multierror.Error
+
+	sweepResources, err := listGatewayRoutes(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping App Mesh Virtual Service sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping App Mesh Gateway Route sweep for %s: %s", region, err)
 		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 	}
 
@@ -388,24 +490,19 @@ func sweepVirtualServices(region string) error {
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping App Mesh Virtual Services (%s): %w", region, err))
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping App Mesh Gateway Routes (%s): %w", region, err))
 	}
 
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepGatewayRoutes(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
-	}
+func listGatewayRoutes(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.AppMeshConn(ctx)
 	input := &appmesh.ListMeshesInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListMeshesPagesWithContext(ctx, input, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
+	err := conn.ListMeshesPagesWithContext(ctx, input, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -453,7 +550,7 @@ func sweepGatewayRoutes(region string) error {
 					}
 
 					if err != nil {
-						sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing App Mesh Gateway Routes (%s): %w", region, err))
+						sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing App Mesh Gateway Routes (%s): %w", client.Region, err))
 					}
 				}
 
@@ -465,15 +562,33 @@ func sweepGatewayRoutes(region string) error {
 			}
 
 			if err != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing App Mesh Virtual Gateways (%s): %w", region, err))
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing App Mesh Virtual Gateways (%s): %w", client.Region, err))
 			}
 		}
 
 		return !lastPage
 	})
 
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, err)
+	}
+
+	return sweepResources, sweeperErrs.ErrorOrNil()
+}
+
+func sweepRoutes(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	var sweeperErrs *multierror.Error
+
+	sweepResources, err := listRoutes(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping App Mesh Gateway Route sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping App Mesh Route sweep for %s: %s", region, err)
 		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 	}
 
@@ -484,24 +599,19 @@ func sweepGatewayRoutes(region string) error {
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping App Mesh Gateway Routes (%s): %w", region, err))
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping App Mesh Routes (%s): %w", region, err))
 	}
 
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepRoutes(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func listRoutes(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.AppMeshConn(ctx)
 	input := &appmesh.ListMeshesInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListMeshesPagesWithContext(ctx, input, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
+	err := conn.ListMeshesPagesWithContext(ctx, input, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -549,7 +659,7 @@ func sweepRoutes(region string) error {
 					}
 
 					if err != nil {
-						sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing App Mesh Routes (%s): %w", region, err))
+						sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing App Mesh Routes (%s): %w", client.Region, err))
 					}
 				}
 
@@ -561,27 +671,16 @@ func sweepRoutes(region string) error {
 			}
 
 			if err != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing App Mesh Virtual Routers (%s): %w", region, err))
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing App Mesh Virtual Routers (%s): %w", client.Region, err))
 			}
 		}
 
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping App Mesh Route sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-	}
-
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing App Mesh Service Meshes (%s): %w", region, err))
+		sweeperErrs = multierror.Append(sweeperErrs, err)
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping App Mesh Routes (%s): %w", region, err))
-	}
-
-	return sweeperErrs.ErrorOrNil()
+	return sweepResources, sweeperErrs.ErrorOrNil()
 }
@@ -7,6 +7,7 @@
 package apprunner
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,7 +15,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/apprunner"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -23,17 +26,20 @@ func init() {
 		F:            sweepAutoScalingConfigurationVersions,
 		Dependencies: []string{"aws_apprunner_service"},
 	})
+	discovery.Register("aws_apprunner_auto_scaling_configuration_version", listAutoScalingConfigurationVersions)
 
 	resource.AddTestSweepers("aws_apprunner_connection", &resource.Sweeper{
 		Name:         "aws_apprunner_connection",
 		F:            sweepConnections,
 		Dependencies: []string{"aws_apprunner_service"},
 	})
+	discovery.Register("aws_apprunner_connection", listConnections)
 
 	resource.AddTestSweepers("aws_apprunner_service", &resource.Sweeper{
 		Name: "aws_apprunner_service",
 		F:    sweepServices,
 	})
+	discovery.Register("aws_apprunner_service", listServices)
 }
 
 func sweepAutoScalingConfigurationVersions(region string) error {
@@ -44,14 +50,38 @@ func sweepAutoScalingConfigurationVersions(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
+	var errs *multierror.Error
+
+	sweepResources, err := listAutoScalingConfigurationVersions(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping App Runner AutoScaling Configuration Versions sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing App Runner AutoScaling Configuration Versions: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping App Runner AutoScaling Configuration Version for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping App Runner AutoScaling Configuration Versions sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func listAutoScalingConfigurationVersions(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.AppRunnerConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	var errs *multierror.Error
-
 	input := &apprunner.ListAutoScalingConfigurationsInput{}
 
-	err = conn.ListAutoScalingConfigurationsPagesWithContext(ctx, input, func(page *apprunner.ListAutoScalingConfigurationsOutput, lastPage bool) bool {
+	err := conn.ListAutoScalingConfigurationsPagesWithContext(ctx, input, func(page *apprunner.ListAutoScalingConfigurationsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -81,43 +111,53 @@ func sweepAutoScalingConfigurationVersions(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepConnections(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	var errs *multierror.Error
+
+	sweepResources, err := listConnections(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping App Runner AutoScaling Configuration Versions sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping App Runner Connections sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("error listing App Runner AutoScaling Configuration Versions: %w", err))
+		errs = multierror.Append(errs, fmt.Errorf("error listing App Runner Connections: %w", err))
 	}
 
 	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("error sweeping App Runner AutoScaling Configuration Version for %s: %w", region, err))
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping App Runner Connections for %s: %w", region, err))
 	}
 
-	if sweep.SkipSweepError(errs.ErrorOrNil()) {
-		log.Printf("[WARN] Skipping App Runner AutoScaling Configuration Versions sweep for %s: %s", region, errs)
-		return nil
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping App Runner Connections sweep for %s: %s", region, err)
+		return nil // In case we have completed some pages, but had errors
 	}
 
 	return errs.ErrorOrNil()
 }
 
-func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
-
+func listConnections(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.AppRunnerConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	var errs *multierror.Error
-
 	input := &apprunner.ListConnectionsInput{}
 
-	err = conn.ListConnectionsPagesWithContext(ctx, input, func(page *apprunner.ListConnectionsOutput, lastPage bool) bool {
+	err := conn.ListConnectionsPagesWithContext(ctx, input, func(page *apprunner.ListConnectionsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -142,43 +182,53 @@ func sweepConnections(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepServices(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	var errs *multierror.Error
+
+	sweepResources, err := listServices(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping App Runner Connections sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping App Runner Services sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("error listing App Runner Connections: %w", err))
+		errs = multierror.Append(errs, fmt.Errorf("error listing App Runner Services: %w", err))
 	}
 
 	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("error sweeping App Runner Connections for %s: %w", region, err))
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping App Runner Services for %s: %w", region, err))
 	}
 
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping App Runner Connections sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping App Runner Services sweep for %s: %s", region, err)
 		return nil // In case we have completed some pages, but had errors
 	}
 
 	return errs.ErrorOrNil()
 }
 
-func sweepServices(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
-
+func listServices(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.AppRunnerConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	var errs *multierror.Error
-
 	input := &apprunner.ListServicesInput{}
 
-	err = conn.ListServicesPagesWithContext(ctx, input, func(page *apprunner.ListServicesOutput, lastPage bool) bool {
+	err := conn.ListServicesPagesWithContext(ctx, input, func(page *apprunner.ListServicesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -202,23 +252,9 @@ func sweepServices(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping App Runner Services sweep for %s: %s", region, err)
-		return nil
-	}
-
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("error listing App Runner Services: %w", err))
-	}
-
-	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("error sweeping App Runner Services for %s: %w", region, err))
+		return nil, err
 	}
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping App Runner Services sweep for %s: %s", region, err)
-		return nil // In case we have completed some pages, but had errors
-	}
-
-	return errs.ErrorOrNil()
+	return sweepResources, nil
 }
@@ -7,13 +7,16 @@
 package appstream
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appstream"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -21,21 +24,25 @@ func init() {
 		Name: "aws_appstream_directory_config",
 		F:    sweepDirectoryConfigs,
 	})
+	discovery.Register("aws_appstream_directory_config", listDirectoryConfigs)
 
 	resource.AddTestSweepers("aws_appstream_fleet", &resource.Sweeper{
 		Name: "aws_appstream_fleet",
 		F:    sweepFleets,
 	})
+	discovery.Register("aws_appstream_fleet", listFleets)
 
 	resource.AddTestSweepers("aws_appstream_image_builder", &resource.Sweeper{
 		Name: "aws_appstream_image_builder",
 		F:    sweepImageBuilders,
 	})
+	discovery.Register("aws_appstream_image_builder", listImageBuilders)
 
 	resource.AddTestSweepers("aws_appstream_stack", &resource.Sweeper{
 		Name: "aws_appstream_stack",
 		F:    sweepStacks,
 	})
+	discovery.Register("aws_appstream_stack", listStacks)
 }
 
 func sweepDirectoryConfigs(region string) error {
@@ -44,11 +51,33 @@ func sweepDirectoryConfigs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
+
+	sweepResources, err := listDirectoryConfigs(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping AppStream Directory Config sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing AppStream Directory Configs (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping AppStream Directory Configs (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listDirectoryConfigs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.AppStreamConn(ctx)
 	input := &appstream.DescribeDirectoryConfigsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = describeDirectoryConfigsPages(ctx, conn, input, func(page *appstream.DescribeDirectoryConfigsOutput, lastPage bool) bool {
+	err := describeDirectoryConfigsPages(ctx, conn, input, func(page *appstream.DescribeDirectoryConfigsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -64,35 +93,46 @@ func sweepDirectoryConfigs(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepFleets(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	sweepResources, err := listFleets(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping AppStream Directory Config sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping AppStream Fleet sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing AppStream Directory Configs (%s): %w", region, err)
+		return fmt.Errorf("error listing AppStream Fleets (%s): %w", region, err)
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping AppStream Directory Configs (%s): %w", region, err)
+		return fmt.Errorf("error sweeping AppStream Fleets (%s): %w", region, err)
 	}
 
 	return nil
 }
 
-func sweepFleets(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
-	}
+func listFleets(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.AppStreamConn(ctx)
 	input := &appstream.DescribeFleetsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = describeFleetsPages(ctx, conn, input, func(page *appstream.DescribeFleetsOutput, lastPage bool) bool {
+	err := describeFleetsPages(ctx, conn, input, func(page *appstream.DescribeFleetsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -108,35 +148,46 @@ func sweepFleets(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepImageBuilders(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	sweepResources, err := listImageBuilders(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping AppStream Fleet sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping AppStream Image Builder sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing AppStream Fleets (%s): %w", region, err)
+		return fmt.Errorf("error listing AppStream Image Builders (%s): %w", region, err)
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping AppStream Fleets (%s): %w", region, err)
+		return fmt.Errorf("error sweeping AppStream Image Builders (%s): %w", region, err)
 	}
 
 	return nil
 }
 
-func sweepImageBuilders(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
-	}
+func listImageBuilders(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.AppStreamConn(ctx)
 	input := &appstream.DescribeImageBuildersInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = describeImageBuildersPages(ctx, conn, input, func(page *appstream.DescribeImageBuildersOutput, lastPage bool) bool {
+	err := describeImageBuildersPages(ctx, conn, input, func(page *appstream.DescribeImageBuildersOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -152,35 +203,46 @@ func sweepImageBuilders(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepStacks(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	sweepResources, err := listStacks(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping AppStream Image Builder sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping AppStream Stack sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing AppStream Image Builders (%s): %w", region, err)
+		return fmt.Errorf("error listing AppStream Stacks (%s): %w", region, err)
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping AppStream Image Builders (%s): %w", region, err)
+		return fmt.Errorf("error sweeping AppStream Stacks (%s): %w", region, err)
 	}
 
 	return nil
 }
 
-func sweepStacks(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
-	}
+func listStacks(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.AppStreamConn(ctx)
 	input := &appstream.DescribeStacksInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = describeStacksPages(ctx, conn, input, func(page *appstream.DescribeStacksOutput, lastPage bool) bool {
+	err := describeStacksPages(ctx, conn, input, func(page *appstream.DescribeStacksOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -196,20 +258,9 @@ func sweepStacks(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping AppStream Stack sweep for %s: %s", region, err)
-		return nil
-	}
-
 	if err != nil {
-		return fmt.Errorf("error listing AppStream Stacks (%s): %w", region, err)
+		return nil, err
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping AppStream Stacks (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
@@ -7,6 +7,7 @@
 package auditmanager
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -15,8 +16,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
 )
 
@@ -31,26 +34,32 @@ func init() {
 			"aws_s3_bucket",
 		},
 	})
+	discovery.Register("aws_auditmanager_assessment", listAssessments)
 	resource.AddTestSweepers("aws_auditmanager_assessment_delegation", &resource.Sweeper{
 		Name: "aws_auditmanager_assessment_delegation",
 		F:    sweepAssessmentDelegations,
 	})
+	discovery.Register("aws_auditmanager_assessment_delegation", listAssessmentDelegations)
 	resource.AddTestSweepers("aws_auditmanager_assessment_report", &resource.Sweeper{
 		Name: "aws_auditmanager_assessment_report",
 		F:    sweepAssessmentReports,
 	})
+	discovery.Register("aws_auditmanager_assessment_report", listAssessmentReports)
 	resource.AddTestSweepers("aws_auditmanager_control", &resource.Sweeper{
 		Name: "aws_auditmanager_control",
 		F:    sweepControls,
 	})
+	discovery.Register("aws_auditmanager_control", listControls)
 	resource.AddTestSweepers("aws_auditmanager_framework", &resource.Sweeper{
 		Name: "aws_auditmanager_framework",
 		F:    sweepFrameworks,
 	})
+	discovery.Register("aws_auditmanager_framework", listFrameworks)
 	resource.AddTestSweepers("aws_auditmanager_framework_share", &resource.Sweeper{
 		Name: "aws_auditmanager_framework_share",
 		F:    sweepFrameworkShares,
 	})
+	discovery.Register("aws_auditmanager_framework_share", listFrameworkShares)
 }
 
 // isCompleteSetupError checks whether the returned error message indicates
@@ -73,6 +82,25 @@ func sweepAssessments(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
+	sweepResources, err := listAssessments(ctx, client)
+
+	if awsv2.SkipSweepError(err) || isCompleteSetupError(err) {
+		log.Printf("[WARN] Skipping AuditManager Assessments sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error retrieving AuditManager Assessments: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping AuditManager Assessments for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listAssessments(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.AuditManagerClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 	in := &auditmanager.ListAssessmentsInput{}
@@ -81,12 +109,8 @@ func sweepAssessments(region string) error {
 
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
-		if awsv2.SkipSweepError(err) || isCompleteSetupError(err) {
-			log.Printf("[WARN] Skipping AuditManager Assessments sweep for %s: %s", region, err)
-			return nil
-		}
 		if err != nil {
-			return fmt.Errorf("error retrieving AuditManager Assessments: %w", err)
+			return nil, err
 		}
 
 		for _, assessment := range page.AssessmentMetadata {
@@ -99,11 +123,7 @@ func sweepAssessments(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("error sweeping AuditManager Assessments for %s: %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepAssessmentDelegations(region string) error {
@@ -113,6 +133,25 @@ func sweepAssessmentDelegations(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
+	sweepResources, err := listAssessmentDelegations(ctx, client)
+
+	if awsv2.SkipSweepError(err) || isCompleteSetupError(err) {
+		log.Printf("[WARN] Skipping AuditManager Assesment Delegations sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error retrieving AuditManager Assessment Delegations: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping AuditManager Assessment Delegations for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listAssessmentDelegations(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.AuditManagerClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 	in := &auditmanager.GetDelegationsInput{}
@@ -121,12 +160,8 @@ func sweepAssessmentDelegations(region string) error {
 
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
-		if awsv2.SkipSweepError(err) || isCompleteSetupError(err) {
-			log.Printf("[WARN] Skipping AuditManager Assesment Delegations sweep for %s: %s", region, err)
-			return nil
-		}
 		if err != nil {
-			return fmt.Errorf("error retrieving AuditManager Assessment Delegations: %w", err)
+			return nil, err
 		}
 
 		for _, d := range page.Delegations {
@@ -138,11 +173,7 @@ func sweepAssessmentDelegations(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("error sweeping AuditManager Assessment Delegations for %s: %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepAssessmentReports(region string) error {
@@ -152,6 +183,25 @@ func sweepAssessmentReports(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
+	sweepResources, err := listAssessmentReports(ctx, client)
+
+	if awsv2.SkipSweepError(err) || isCompleteSetupError(err) {
+		log.Printf("[WARN] Skipping AuditManager Assesment Reports sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error retrieving AuditManager Assessment Reports: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping AuditManager Assessment Reports for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listAssessmentReports(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.AuditManagerClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 	in := &auditmanager.ListAssessmentReportsInput{}
@@ -160,12 +210,8 @@ func sweepAssessmentReports(region string) error {
 
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
-		if awsv2.SkipSweepError(err) || isCompleteSetupError(err) {
-			log.Printf("[WARN] Skipping AuditManager Assesment Reports sweep for %s: %s", region, err)
-			return nil
-		}
 		if err != nil {
-			return fmt.Errorf("error retrieving AuditManager Assessment Reports: %w", err)
+			return nil, err
 		}
 
 		for _, report := range page.AssessmentReports {
@@ -179,11 +225,7 @@ func sweepAssessmentReports(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("error sweeping AuditManager Assessment Reports for %s: %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepControls(region string) error {
@@ -193,6 +235,25 @@ func sweepControls(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
+	sweepResources, err := listControls(ctx, client)
+
+	if awsv2.SkipSweepError(err) || isCompleteSetupError(err) {
+		log.Printf("[WARN] Skipping AuditManager Controls sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error retrieving AuditManager Controls: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping AuditManager Controls for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listControls(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.AuditManagerClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 	in := &auditmanager.ListControlsInput{ControlType: types.ControlTypeCustom}
@@ -201,12 +262,8 @@ func sweepControls(region string) error {
 
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
-		if awsv2.SkipSweepError(err) || isCompleteSetupError(err) {
-			log.Printf("[WARN] Skipping AuditManager Controls sweep for %s: %s", region, err)
-			return nil
-		}
 		if err != nil {
-			return fmt.Errorf("error retrieving AuditManager Controls: %w", err)
+			return nil, err
 		}
 
 		for _, control := range page.ControlMetadataList {
@@ -219,11 +276,7 @@ func sweepControls(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("error sweeping AuditManager Controls for %s: %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepFrameworks(region string) error {
@@ -233,6 +286,25 @@ func sweepFrameworks(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
+	sweepResources, err := listFrameworks(ctx, client)
+
+	if awsv2.SkipSweepError(err) || isCompleteSetupError(err) {
+		log.Printf("[WARN] Skipping AuditManager Frameworks sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error retrieving AuditManager Frameworks: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping AuditManager Frameworks for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listFrameworks(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.AuditManagerClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 	in := &auditmanager.ListAssessmentFrameworksInput{FrameworkType: types.FrameworkTypeCustom}
@@ -241,12 +313,8 @@ func sweepFrameworks(region string) error {
 
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
-		if awsv2.SkipSweepError(err) || isCompleteSetupError(err) {
-			log.Printf("[WARN] Skipping AuditManager Frameworks sweep for %s: %s", region, err)
-			return nil
-		}
 		if err != nil {
-			return fmt.Errorf("error retrieving AuditManager Frameworks: %w", err)
+			return nil, err
 		}
 
 		for _, f := range page.FrameworkMetadataList {
@@ -259,11 +327,7 @@ func sweepFrameworks(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("error sweeping AuditManager Frameworks for %s: %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepFrameworkShares(region string) error {
@@ -273,6 +337,25 @@ func sweepFrameworkShares(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
+	sweepResources, err := listFrameworkShares(ctx, client)
+
+	if awsv2.SkipSweepError(err) || isCompleteSetupError(err) {
+		log.Printf("[WARN] Skipping AuditManager Framework Shares sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error retrieving AuditManager Framework Shares: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping AuditManager Framework Shares for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listFrameworkShares(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.AuditManagerClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 	in := &auditmanager.ListAssessmentFrameworkShareRequestsInput{RequestType: types.ShareRequestTypeSent}
@@ -281,12 +364,8 @@ func sweepFrameworkShares(region string) error {
 
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
-		if awsv2.SkipSweepError(err) || isCompleteSetupError(err) {
-			log.Printf("[WARN] Skipping AuditManager Framework Shares sweep for %s: %s", region, err)
-			return nil
-		}
 		if err != nil {
-			return fmt.Errorf("error retrieving AuditManager Framework Shares: %w", err)
+			return nil, err
 		}
 
 		for _, share := range page.AssessmentFrameworkShareRequests {
@@ -299,9 +378,5 @@ func sweepFrameworkShares(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("error sweeping AuditManager Framework Shares for %s: %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
@@ -7,6 +7,7 @@
 package batch
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -17,7 +18,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/iam"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
 )
@@ -38,11 +41,13 @@ func init() {
 			"aws_batch_job_queue",
 		},
 	})
+	discovery.Register("aws_batch_job_definition", listJobDefinitions)
 
 	resource.AddTestSweepers("aws_batch_job_queue", &resource.Sweeper{
 		Name: "aws_batch_job_queue",
 		F:    sweepJobQueues,
 	})
+	discovery.Register("aws_batch_job_queue", listJobQueues)
 
 	resource.AddTestSweepers("aws_batch_scheduling_policy", &resource.Sweeper{
 		Name: "aws_batch_scheduling_policy",
@@ -51,6 +56,7 @@ func init() {
 			"aws_batch_job_queue",
 		},
 	})
+	discovery.Register("aws_batch_scheduling_policy", listSchedulingPolicies)
 }
 
 func sweepComputeEnvironments(region string) error {
@@ -173,13 +179,35 @@ func sweepJobDefinitions(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
+
+	sweepResources, err := listJobDefinitions(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Batch Job Definition sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Batch Job Definitions (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Batch Job Definitions (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listJobDefinitions(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &batch.DescribeJobDefinitionsInput{
 		Status: aws.String("ACTIVE"),
 	}
 	conn := client.BatchConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.DescribeJobDefinitionsPagesWithContext(ctx, input, func(page *batch.DescribeJobDefinitionsOutput, lastPage bool) bool {
+	err := conn.DescribeJobDefinitionsPagesWithContext(ctx, input, func(page *batch.DescribeJobDefinitionsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -195,35 +223,46 @@ func sweepJobDefinitions(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepJobQueues(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	sweepResources, err := listJobQueues(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Batch Job Definition sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping Batch Job Queue sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing Batch Job Definitions (%s): %w", region, err)
+		return fmt.Errorf("error listing Batch Job Queues (%s): %w", region, err)
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping Batch Job Definitions (%s): %w", region, err)
+		return fmt.Errorf("error sweeping Batch Job Queues (%s): %w", region, err)
 	}
 
 	return nil
 }
 
-func sweepJobQueues(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func listJobQueues(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &batch.DescribeJobQueuesInput{}
 	conn := client.BatchConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.DescribeJobQueuesPagesWithContext(ctx, input, func(page *batch.DescribeJobQueuesOutput, lastPage bool) bool {
+	err := conn.DescribeJobQueuesPagesWithContext(ctx, input, func(page *batch.DescribeJobQueuesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -239,35 +278,46 @@ func sweepJobQueues(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepSchedulingPolicies(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	sweepResources, err := listSchedulingPolicies(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Batch Job Queue sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping Batch Scheduling Policy sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing Batch Job Queues (%s): %w", region, err)
+		return fmt.Errorf("error listing Batch Scheduling Policies (%s): %w", region, err)
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping Batch Job Queues (%s): %w", region, err)
+		return fmt.Errorf("error sweeping Batch Scheduling Policies (%s): %w", region, err)
 	}
 
 	return nil
 }
 
-func sweepSchedulingPolicies(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func listSchedulingPolicies(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &batch.ListSchedulingPoliciesInput{}
 	conn := client.BatchConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListSchedulingPoliciesPagesWithContext(ctx, input, func(page *batch.ListSchedulingPoliciesOutput, lastPage bool) bool {
+	err := conn.ListSchedulingPoliciesPagesWithContext(ctx, input, func(page *batch.ListSchedulingPoliciesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -283,20 +333,9 @@ func sweepSchedulingPolicies(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Batch Scheduling Policy sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("error listing Batch Scheduling Policies (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
 	if err != nil {
-		return fmt.Errorf("error sweeping Batch Scheduling Policies (%s): %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
@@ -297,7 +297,7 @@ func resourceBudgetCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.Errorf("expandBudgetUnmarshal: %s", err)
 	}
 
-	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	name := create.Name(ctx, d.Get("name").(string), d.Get("name_prefix").(string))
 	budget.BudgetName = aws.String(name)
 
 	accountID := d.Get("account_id").(string)
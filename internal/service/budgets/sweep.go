@@ -7,6 +7,7 @@
 package budgets
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -14,7 +15,9 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/budgets"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -22,6 +25,7 @@ func init() {
 		Name: "aws_budgets_budget_action",
 		F:    sweepBudgetActions,
 	})
+	discovery.Register("aws_budgets_budget_action", listBudgetActions)
 
 	resource.AddTestSweepers("aws_budgets_budget", &resource.Sweeper{
 		Name: "aws_budgets_budget",
@@ -30,6 +34,7 @@ func init() {
 			"aws_budgets_budget_action",
 		},
 	})
+	discovery.Register("aws_budgets_budget", listBudgets)
 }
 
 func sweepBudgetActions(region string) error {
@@ -38,6 +43,28 @@ func sweepBudgetActions(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
+
+	sweepResources, err := listBudgetActions(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Budget Action sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Budget Actions (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Budget Actions (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listBudgetActions(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.BudgetsConn(ctx)
 	accountID := client.AccountID
 	input := &budgets.DescribeBudgetActionsForAccountInput{
@@ -45,7 +72,7 @@ func sweepBudgetActions(region string) error {
 	}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.DescribeBudgetActionsForAccountPagesWithContext(ctx, input, func(page *budgets.DescribeBudgetActionsForAccountOutput, lastPage bool) bool {
+	err := conn.DescribeBudgetActionsForAccountPagesWithContext(ctx, input, func(page *budgets.DescribeBudgetActionsForAccountOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -61,30 +88,41 @@ func sweepBudgetActions(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepBudgets(region string) error { // nosemgrep:ci.budgets-in-func-name
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	sweepResources, err := listBudgets(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Budget Action sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping Budget sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing Budget Actions (%s): %w", region, err)
+		return fmt.Errorf("error listing Budgets (%s): %w", region, err)
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping Budget Actions (%s): %w", region, err)
+		return fmt.Errorf("error sweeping Budgets (%s): %w", region, err)
 	}
 
 	return nil
 }
 
-func sweepBudgets(region string) error { // nosemgrep:ci.budgets-in-func-name
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
-	}
+func listBudgets(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.BudgetsConn(ctx)
 	accountID := client.AccountID
 	input := &budgets.DescribeBudgetsInput{
@@ -92,7 +130,7 @@ func sweepBudgets(region string) error { // nosemgrep:ci.budgets-in-func-name
 	}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.DescribeBudgetsPagesWithContext(ctx, input, func(page *budgets.DescribeBudgetsOutput, lastPage bool) bool {
+	err := conn.DescribeBudgetsPagesWithContext(ctx, input, func(page *budgets.DescribeBudgetsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -114,20 +152,9 @@ func sweepBudgets(region string) error { // nosemgrep:ci.budgets-in-func-name
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Budget sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("error listing Budgets (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
 	if err != nil {
-		return fmt.Errorf("error sweeping Budgets (%s): %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
@@ -244,3 +244,94 @@ func FindTypeRegistrationByToken(ctx context.Context, conn *cloudformation.Cloud
 
 	return output, nil
 }
+
+func FindStackDriftDetectionStatusByID(ctx context.Context, conn *cloudformation.CloudFormation, detectionID string) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	input := &cloudformation.DescribeStackDriftDetectionStatusInput{
+		StackDriftDetectionId: aws.String(detectionID),
+	}
+
+	output, err := conn.DescribeStackDriftDetectionStatusWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findDriftedStackResources(ctx context.Context, conn *cloudformation.CloudFormation, stackName string) ([]*cloudformation.StackResourceDrift, error) {
+	input := &cloudformation.DescribeStackResourceDriftsInput{
+		StackName: aws.String(stackName),
+		StackResourceDriftStatusFilters: aws.StringSlice([]string{
+			cloudformation.StackResourceDriftStatusDeleted,
+			cloudformation.StackResourceDriftStatusModified,
+		}),
+	}
+	var output []*cloudformation.StackResourceDrift
+
+	err := conn.DescribeStackResourceDriftsPagesWithContext(ctx, input, func(page *cloudformation.DescribeStackResourceDriftsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.StackResourceDrifts {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findChangeSetChangesByStackIDAndChangeSetName(ctx context.Context, conn *cloudformation.CloudFormation, stackID, changeSetName string) ([]*cloudformation.Change, error) {
+	input := &cloudformation.DescribeChangeSetInput{
+		ChangeSetName: aws.String(changeSetName),
+		StackName:     aws.String(stackID),
+	}
+	var output []*cloudformation.Change
+
+	// DescribeChangeSet has no paginator.
+	for {
+		page, err := conn.DescribeChangeSetWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeChangeSetNotFoundException) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			break
+		}
+
+		for _, v := range page.Changes {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if aws.StringValue(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
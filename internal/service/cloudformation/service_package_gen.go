@@ -33,6 +33,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceStack,
 			TypeName: "aws_cloudformation_stack",
 		},
+		{
+			Factory:  DataSourceStackChangeSet,
+			TypeName: "aws_cloudformation_stack_change_set",
+		},
 		{
 			Factory:  DataSourceType,
 			TypeName: "aws_cloudformation_type",
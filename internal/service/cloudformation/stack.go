@@ -40,7 +40,10 @@ func ResourceStack() *schema.Resource {
 		DeleteWithoutTimeout: resourceStackDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("detect_drift", false)
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -59,11 +62,20 @@ func ResourceStack() *schema.Resource {
 				},
 				Set: schema.HashString,
 			},
+			"detect_drift": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"disable_rollback": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				ForceNew: true,
 			},
+			"drift_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"iam_role_arn": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -271,6 +283,27 @@ func resourceStackRead(ctx context.Context, d *schema.ResourceData, meta interfa
 
 	setTagsOut(ctx, stack.Tags)
 
+	driftStatus := cloudformation.StackDriftStatusNotChecked
+	if stack.DriftInformation != nil {
+		driftStatus = aws.StringValue(stack.DriftInformation.StackDriftStatus)
+	}
+
+	if d.Get("detect_drift").(bool) && !d.IsNewResource() {
+		v, err := detectStackDrift(ctx, conn, d.Id())
+
+		if err != nil {
+			diags = sdkdiag.AppendWarningf(diags, "detecting CloudFormation Stack (%s) drift: %s", d.Id(), err)
+		} else {
+			driftStatus = v
+		}
+
+		if driftStatus == cloudformation.StackDriftStatusDrifted {
+			diags = append(diags, stackDriftWarning(ctx, conn, d.Id())...)
+		}
+	}
+
+	d.Set("drift_status", driftStatus)
+
 	return diags
 }
 
@@ -278,6 +311,11 @@ func resourceStackUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFormationConn(ctx)
 
+	// Drift detection doesn't change the stack.
+	if !d.HasChangesExcept("detect_drift") {
+		return append(diags, resourceStackRead(ctx, d, meta)...)
+	}
+
 	requestToken := id.UniqueId()
 	input := &cloudformation.UpdateStackInput{
 		ClientRequestToken: aws.String(requestToken),
@@ -695,6 +733,46 @@ func reasonFromEvent(event *cloudformation.StackEvent) string {
 	return aws.StringValue(event.ResourceStatusReason)
 }
 
+// detectStackDrift detects drift of a stack's resources from their template configuration and returns the stack's drift status.
+func detectStackDrift(ctx context.Context, conn *cloudformation.CloudFormation, name string) (string, error) {
+	output, err := conn.DetectStackDriftWithContext(ctx, &cloudformation.DetectStackDriftInput{
+		StackName: aws.String(name),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	detection, err := WaitStackDriftDetected(ctx, conn, aws.StringValue(output.StackDriftDetectionId))
+
+	if err != nil {
+		return "", fmt.Errorf("waiting for drift detection (%s): %w", aws.StringValue(output.StackDriftDetectionId), err)
+	}
+
+	if status := aws.StringValue(detection.DetectionStatus); status == cloudformation.StackDriftDetectionStatusDetectionFailed {
+		log.Printf("[WARN] CloudFormation Stack (%s) drift detection (%s) failed for some resources: %s", name, aws.StringValue(output.StackDriftDetectionId), aws.StringValue(detection.DetectionStatusReason))
+	}
+
+	return aws.StringValue(detection.StackDriftStatus), nil
+}
+
+// stackDriftWarning returns a warning diagnostic listing a drifted stack's modified and deleted resources.
+func stackDriftWarning(ctx context.Context, conn *cloudformation.CloudFormation, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	drifts, err := findDriftedStackResources(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendWarningf(diags, "CloudFormation Stack (%s) has drifted from its template. Listing drifted resources: %s", name, err)
+	}
+
+	resources := slices.ApplyToAll(drifts, func(v *cloudformation.StackResourceDrift) string {
+		return fmt.Sprintf("%s (%s): %s", aws.StringValue(v.LogicalResourceId), aws.StringValue(v.ResourceType), aws.StringValue(v.StackResourceDriftStatus))
+	})
+
+	return sdkdiag.AppendWarningf(diags, "CloudFormation Stack (%s) has drifted from its template: %s", name, strings.Join(resources, ", "))
+}
+
 func stackHasActualChanges(ctx context.Context, d *schema.ResourceDiff, meta any) bool {
 	if d.Id() == "" {
 		return false
@@ -704,6 +782,10 @@ func stackHasActualChanges(ctx context.Context, d *schema.ResourceDiff, meta any
 		if attr.ForceNew {
 			continue
 		}
+		// Drift detection doesn't change the stack.
+		if k == "detect_drift" {
+			continue
+		}
 		if attr.Computed && !attr.Optional {
 			continue
 		}
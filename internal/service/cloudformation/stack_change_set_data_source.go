@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_cloudformation_stack_change_set")
+func DataSourceStackChangeSet() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceStackChangeSetRead,
+
+		Schema: map[string]*schema.Schema{
+			"capabilities": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(cloudformation.Capability_Values(), false),
+				},
+				Set: schema.HashString,
+			},
+			"change_set_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"logical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replacement": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scope": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"iam_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"include_nested_stacks": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"stack_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"template_body": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidStringIsJSONOrYAML,
+				ExactlyOneOf: []string{"template_body", "template_url"},
+			},
+			"template_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"template_body", "template_url"},
+			},
+		},
+	}
+}
+
+func dataSourceStackChangeSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFormationConn(ctx)
+
+	stackName := d.Get("stack_name").(string)
+	changeSetType := cloudformation.ChangeSetTypeUpdate
+	// A stack created by a CREATE change set remains in REVIEW_IN_PROGRESS status until it's deleted.
+	createdStack := false
+
+	stack, err := FindStackByName(ctx, conn, stackName)
+
+	switch {
+	case tfresource.NotFound(err):
+		changeSetType = cloudformation.ChangeSetTypeCreate
+		createdStack = true
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading CloudFormation Stack (%s): %s", stackName, err)
+	case aws.StringValue(stack.StackStatus) == cloudformation.StackStatusReviewInProgress:
+		changeSetType = cloudformation.ChangeSetTypeCreate
+	}
+
+	changeSetName := id.PrefixedUniqueId("terraform-preview-")
+	input := &cloudformation.CreateChangeSetInput{
+		ChangeSetName: aws.String(changeSetName),
+		ChangeSetType: aws.String(changeSetType),
+		Description:   aws.String("Created by Terraform to preview stack changes"),
+		StackName:     aws.String(stackName),
+	}
+
+	if v, ok := d.GetOk("capabilities"); ok && v.(*schema.Set).Len() > 0 {
+		input.Capabilities = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("iam_role_arn"); ok {
+		input.RoleARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("include_nested_stacks"); ok {
+		input.IncludeNestedStacks = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		input.Parameters = expandParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("template_body"); ok {
+		template, err := verify.NormalizeJSONOrYAMLString(v)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "template body contains an invalid JSON or YAML: %s", err)
+		}
+		input.TemplateBody = aws.String(template)
+	}
+
+	if v, ok := d.GetOk("template_url"); ok {
+		input.TemplateURL = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateChangeSetWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudFormation Stack (%s) Change Set: %s", stackName, err)
+	}
+
+	stackID, changeSetID := aws.StringValue(output.StackId), aws.StringValue(output.Id)
+
+	defer func() {
+		if err := deleteStackChangeSetPreview(ctx, conn, stackID, changeSetID, createdStack); err != nil {
+			log.Printf("[WARN] Deleting CloudFormation Stack (%s) Change Set (%s): %s", stackName, changeSetID, err)
+		}
+	}()
+
+	changeSet, err := WaitChangeSetCreated(ctx, conn, stackID, changeSetID)
+
+	if err != nil && (changeSet == nil || !stackChangeSetHasNoChanges(changeSet)) {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudFormation Stack (%s) Change Set (%s) create: %s", stackName, changeSetID, err)
+	}
+
+	var changes []*cloudformation.Change
+
+	if aws.StringValue(changeSet.Status) == cloudformation.ChangeSetStatusCreateComplete {
+		changes, err = findChangeSetChangesByStackIDAndChangeSetName(ctx, conn, stackID, changeSetID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading CloudFormation Stack (%s) Change Set (%s) changes: %s", stackName, changeSetID, err)
+		}
+	}
+
+	d.SetId(stackName)
+	d.Set("change_set_type", changeSetType)
+	if err := d.Set("changes", flattenChanges(changes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting changes: %s", err)
+	}
+	d.Set("status", changeSet.Status)
+	d.Set("status_reason", changeSet.StatusReason)
+
+	return diags
+}
+
+// stackChangeSetHasNoChanges returns whether a change set failed because the template and parameters match the stack.
+func stackChangeSetHasNoChanges(changeSet *cloudformation.DescribeChangeSetOutput) bool {
+	if aws.StringValue(changeSet.Status) != cloudformation.ChangeSetStatusFailed {
+		return false
+	}
+
+	reason := aws.StringValue(changeSet.StatusReason)
+
+	return strings.Contains(reason, "didn't contain changes") || strings.Contains(reason, "No updates are to be performed")
+}
+
+// deleteStackChangeSetPreview deletes a change set and, if the change set created it, the stack.
+func deleteStackChangeSetPreview(ctx context.Context, conn *cloudformation.CloudFormation, stackID, changeSetID string, deleteStack bool) error {
+	log.Printf("[DEBUG] Deleting CloudFormation Change Set: %s", changeSetID)
+	_, err := conn.DeleteChangeSetWithContext(ctx, &cloudformation.DeleteChangeSetInput{
+		ChangeSetName: aws.String(changeSetID),
+		StackName:     aws.String(stackID),
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeChangeSetNotFoundException) {
+		return err
+	}
+
+	if !deleteStack {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Deleting CloudFormation Stack: %s", stackID)
+	requestToken := id.UniqueId()
+	_, err = conn.DeleteStackWithContext(ctx, &cloudformation.DeleteStackInput{
+		ClientRequestToken: aws.String(requestToken),
+		StackName:          aws.String(stackID),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	_, err = WaitStackDeleted(ctx, conn, stackID, requestToken, ChangeSetCreatedTimeout)
+
+	return err
+}
+
+func flattenChanges(apiObjects []*cloudformation.Change) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.ResourceChange == nil {
+			continue
+		}
+
+		v := apiObject.ResourceChange
+
+		tfList = append(tfList, map[string]interface{}{
+			"action":               aws.StringValue(v.Action),
+			"logical_resource_id":  aws.StringValue(v.LogicalResourceId),
+			"physical_resource_id": aws.StringValue(v.PhysicalResourceId),
+			"replacement":          aws.StringValue(v.Replacement),
+			"resource_type":        aws.StringValue(v.ResourceType),
+			"scope":                aws.StringValueSlice(v.Scope),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudFormationStackChangeSetDataSource_create(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudformation_stack_change_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackChangeSetDataSourceConfig_create(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "change_set_type", cloudformation.ChangeSetTypeCreate),
+					resource.TestCheckResourceAttr(dataSourceName, "changes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "changes.0.action", cloudformation.ChangeActionAdd),
+					resource.TestCheckResourceAttr(dataSourceName, "changes.0.logical_resource_id", "MyVPC"),
+					resource.TestCheckResourceAttr(dataSourceName, "changes.0.resource_type", "AWS::EC2::VPC"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", cloudformation.ChangeSetStatusCreateComplete),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFormationStackChangeSetDataSource_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudformation_stack_change_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackConfig_params(rName, "10.0.0.0/16"),
+			},
+			{
+				Config: testAccStackChangeSetDataSourceConfig_update(rName, "10.0.0.0/16", "10.1.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "change_set_type", cloudformation.ChangeSetTypeUpdate),
+					resource.TestCheckResourceAttr(dataSourceName, "changes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "changes.0.action", cloudformation.ChangeActionModify),
+					resource.TestCheckResourceAttr(dataSourceName, "changes.0.logical_resource_id", "MyVPC"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "changes.0.physical_resource_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "changes.0.replacement", cloudformation.ReplacementTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "changes.0.resource_type", "AWS::EC2::VPC"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", cloudformation.ChangeSetStatusCreateComplete),
+				),
+			},
+			{
+				Config: testAccStackChangeSetDataSourceConfig_update(rName, "10.0.0.0/16", "10.0.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "change_set_type", cloudformation.ChangeSetTypeUpdate),
+					resource.TestCheckResourceAttr(dataSourceName, "changes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccStackChangeSetDataSourceConfig_templateBody = `
+{
+  "Parameters" : {
+    "VpcCIDR" : {
+      "Description" : "CIDR to be used for the VPC",
+      "Type" : "String"
+    }
+  },
+  "Resources" : {
+    "MyVPC": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : {"Ref": "VpcCIDR"},
+        "Tags" : [
+          {"Key": "Name", "Value": "Primary_CF_VPC"}
+        ]
+      }
+    }
+  }
+}
+`
+
+func testAccStackChangeSetDataSourceConfig_create(rName string) string {
+	return fmt.Sprintf(`
+data "aws_cloudformation_stack_change_set" "test" {
+  stack_name = %[1]q
+
+  parameters = {
+    VpcCIDR = "10.0.0.0/16"
+  }
+
+  template_body = <<STACK
+%[2]s
+STACK
+}
+`, rName, testAccStackChangeSetDataSourceConfig_templateBody)
+}
+
+func testAccStackChangeSetDataSourceConfig_update(rName, cidr, newCIDR string) string {
+	return acctest.ConfigCompose(testAccStackConfig_params(rName, cidr), fmt.Sprintf(`
+data "aws_cloudformation_stack_change_set" "test" {
+  stack_name = aws_cloudformation_stack.test.name
+
+  parameters = {
+    VpcCIDR = %[1]q
+  }
+
+  template_body = <<STACK
+%[2]s
+STACK
+}
+`, newCIDR, testAccStackChangeSetDataSourceConfig_templateBody))
+}
@@ -571,6 +571,48 @@ func TestAccCloudFormationStack_templateUpdate(t *testing.T) {
 	})
 }
 
+func TestAccCloudFormationStack_detectDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stack cloudformation.Stack
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackConfig_detectDrift(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "detect_drift", "false"),
+					resource.TestCheckResourceAttr(resourceName, "drift_status", cloudformation.StackDriftStatusNotChecked),
+				),
+			},
+			{
+				Config: testAccStackConfig_detectDrift(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "detect_drift", "true"),
+					resource.TestCheckResourceAttr(resourceName, "drift_status", cloudformation.StackDriftStatusInSync),
+				),
+			},
+			{
+				Config:   testAccStackConfig_detectDrift(rName, true),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"detect_drift"},
+			},
+		},
+	})
+}
+
 func testAccCheckStackExists(ctx context.Context, n string, v *cloudformation.Stack) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1232,3 +1274,28 @@ output "stack_output" {
 }
 `, rName, name, value)
 }
+
+func testAccStackConfig_detectDrift(rName string, detectDrift bool) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name         = %[1]q
+  detect_drift = %[2]t
+
+  template_body = <<STACK
+{
+  "Resources" : {
+    "MyVPC": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : "10.0.0.0/16",
+        "Tags" : [
+          {"Key": "Name", "Value": "Primary_CF_VPC"}
+        ]
+      }
+    }
+  }
+}
+STACK
+}
+`, rName, detectDrift)
+}
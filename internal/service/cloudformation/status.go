@@ -59,3 +59,15 @@ func StatusTypeRegistrationProgress(ctx context.Context, conn *cloudformation.Cl
 		return output, aws.StringValue(output.ProgressStatus), nil
 	}
 }
+
+func StatusStackDriftDetection(ctx context.Context, conn *cloudformation.CloudFormation, detectionID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindStackDriftDetectionStatusByID(ctx, conn, detectionID)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DetectionStatus), nil
+	}
+}
@@ -116,3 +116,27 @@ func WaitTypeRegistrationProgressStatusComplete(ctx context.Context, conn *cloud
 
 	return nil, err
 }
+
+const (
+	StackDriftDetectedTimeout = 5 * time.Minute
+)
+
+// WaitStackDriftDetected waits for stack drift detection to finish.
+// Drift detection can fail for individual resources, e.g. resources that don't support drift detection,
+// in which case the stack's drift status is still returned.
+func WaitStackDriftDetected(ctx context.Context, conn *cloudformation.CloudFormation, detectionID string) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{cloudformation.StackDriftDetectionStatusDetectionInProgress},
+		Target:  []string{cloudformation.StackDriftDetectionStatusDetectionComplete, cloudformation.StackDriftDetectionStatusDetectionFailed},
+		Refresh: StatusStackDriftDetection(ctx, conn, detectionID),
+		Timeout: StackDriftDetectedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudformation.DescribeStackDriftDetectionStatusOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
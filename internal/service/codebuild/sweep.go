@@ -7,6 +7,7 @@
 package codebuild
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,7 +15,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/codebuild"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -22,11 +25,13 @@ func init() {
 		Name: "aws_codebuild_report_group",
 		F:    sweepReportGroups,
 	})
+	discovery.Register("aws_codebuild_report_group", listReportGroups)
 
 	resource.AddTestSweepers("aws_codebuild_project", &resource.Sweeper{
 		Name: "aws_codebuild_project",
 		F:    sweepProjects,
 	})
+	discovery.Register("aws_codebuild_project", listProjects)
 
 	resource.AddTestSweepers("aws_codebuild_source_credential", &resource.Sweeper{
 		Name: "aws_codebuild_source_credential",
@@ -41,11 +46,30 @@ func sweepReportGroups(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
+	sweepResources, err := listReportGroups(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping CodeBuild Report Group sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error retrieving CodeBuild ReportGroups: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping CodeBuild ReportGroups: %w", err)
+	}
+
+	return nil
+}
+
+func listReportGroups(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.CodeBuildConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &codebuild.ListReportGroupsInput{}
-	err = conn.ListReportGroupsPagesWithContext(ctx, input, func(page *codebuild.ListReportGroupsOutput, lastPage bool) bool {
+	err := conn.ListReportGroupsPagesWithContext(ctx, input, func(page *codebuild.ListReportGroupsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -63,20 +87,11 @@ func sweepReportGroups(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping CodeBuild Report Group sweep for %s: %s", region, err)
-		return nil
-	}
-
 	if err != nil {
-		return fmt.Errorf("error retrieving CodeBuild ReportGroups: %w", err)
-	}
-
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("error sweeping CodeBuild ReportGroups: %w", err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
 
 func sweepProjects(region string) error {
@@ -86,11 +101,29 @@ func sweepProjects(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
+	sweepResources, err := listProjects(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping CodeBuild Project sweep for %s: %s", region, err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error retrieving CodeBuild Projects: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping CodeBuild Projects: %w", err)
+	}
+
+	return nil
+}
+
+func listProjects(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.CodeBuildConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &codebuild.ListProjectsInput{}
-	err = conn.ListProjectsPagesWithContext(ctx, input, func(page *codebuild.ListProjectsOutput, lastPage bool) bool {
+	err := conn.ListProjectsPagesWithContext(ctx, input, func(page *codebuild.ListProjectsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -107,19 +140,11 @@ func sweepProjects(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping CodeBuild Project sweep for %s: %s", region, err)
-		return nil
-	}
 	if err != nil {
-		return fmt.Errorf("error retrieving CodeBuild Projects: %w", err)
-	}
-
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("error sweeping CodeBuild Projects: %w", err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
 
 func sweepSourceCredentials(region string) error {
@@ -7,13 +7,16 @@
 package codegurureviewer
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codegurureviewer"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -21,6 +24,7 @@ func init() {
 		Name: "aws_codegurureviewer",
 		F:    sweepAssociations,
 	})
+	discovery.Register("aws_codegurureviewer_repository_association", listAssociations)
 }
 
 func sweepAssociations(region string) error {
@@ -29,12 +33,34 @@ func sweepAssociations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
+
+	sweepResources, err := listAssociations(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping CodeGuruReviewer Association sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing CodeGuruReviewer Associations (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CodeGuruReviewer Associations (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listAssociations(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &codegurureviewer.ListRepositoryAssociationsInput{}
 	conn := client.CodeGuruReviewerConn(ctx)
 
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListRepositoryAssociationsPagesWithContext(ctx, input, func(page *codegurureviewer.ListRepositoryAssociationsOutput, lastPage bool) bool {
+	err := conn.ListRepositoryAssociationsPagesWithContext(ctx, input, func(page *codegurureviewer.ListRepositoryAssociationsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -51,20 +77,9 @@ func sweepAssociations(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping CodeGuruReviewer Association sweep for %s: %s", region, err)
-		return nil
-	}
-
 	if err != nil {
-		return fmt.Errorf("error listing CodeGuruReviewer Associations (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping CodeGuruReviewer Associations (%s): %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
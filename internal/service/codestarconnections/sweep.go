@@ -7,13 +7,16 @@
 package codestarconnections
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/codestarconnections"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -21,6 +24,7 @@ func init() {
 		Name: "aws_codestarconnections_connection",
 		F:    sweepConnections,
 	})
+	discovery.Register("aws_codestarconnections_connection", listConnections)
 
 	resource.AddTestSweepers("aws_codestarconnections_host", &resource.Sweeper{
 		Name: "aws_codestarconnections_host",
@@ -29,6 +33,7 @@ func init() {
 			"aws_codestarconnections_connection",
 		},
 	})
+	discovery.Register("aws_codestarconnections_host", listHosts)
 }
 
 func sweepConnections(region string) error {
@@ -37,6 +42,28 @@ func sweepConnections(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
+
+	sweepResources, err := listConnections(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping CodeStar Connections Connection sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing CodeStar Connections Connections (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CodeStar Connections Connections (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listConnections(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.CodeStarConnectionsClient(ctx)
 	input := &codestarconnections.ListConnectionsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
@@ -45,13 +72,8 @@ func sweepConnections(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping CodeStar Connections Connection sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error listing CodeStar Connections Connections (%s): %w", region, err)
+			return nil, err
 		}
 
 		for _, v := range page.Connections {
@@ -63,13 +85,7 @@ func sweepConnections(region string) error {
 		}
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping CodeStar Connections Connections (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepHosts(region string) error {
@@ -78,6 +94,28 @@ func sweepHosts(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
+
+	sweepResources, err := listHosts(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping CodeStar Connections Host sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing CodeStar Connections Hosts (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CodeStar Connections Hosts (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listHosts(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.CodeStarConnectionsClient(ctx)
 	input := &codestarconnections.ListHostsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
@@ -86,13 +124,8 @@ func sweepHosts(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping CodeStar Connections Host sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error listing CodeStar Connections Hosts (%s): %w", region, err)
+			return nil, err
 		}
 
 		for _, v := range page.Hosts {
@@ -104,11 +137,5 @@ func sweepHosts(region string) error {
 		}
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping CodeStar Connections Hosts (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
@@ -7,13 +7,16 @@
 package codestarnotifications
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/codestarnotifications"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -21,6 +24,7 @@ func init() {
 		Name: "aws_codestarnotifications_notification_rule",
 		F:    sweepNotificationRules,
 	})
+	discovery.Register("aws_codestarnotifications_notification_rule", listNotificationRules)
 }
 
 func sweepNotificationRules(region string) error {
@@ -29,6 +33,28 @@ func sweepNotificationRules(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listNotificationRules(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping CodeStar Notification Rule sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listingCodeStar Notification Rules (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CodeStar Notification Rules (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listNotificationRules(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.CodeStarNotificationsClient(ctx)
 	input := &codestarnotifications.ListNotificationRulesInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
@@ -37,13 +63,8 @@ func sweepNotificationRules(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping CodeStar Notification Rule sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error listingCodeStar Notification Rules (%s): %w", region, err)
+			return nil, err
 		}
 
 		for _, v := range page.NotificationRules {
@@ -55,11 +76,5 @@ func sweepNotificationRules(region string) error {
 		}
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping CodeStar Notification Rules (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
@@ -7,13 +7,16 @@
 package cur
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	cur "github.com/aws/aws-sdk-go/service/costandusagereportservice"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -21,6 +24,7 @@ func init() {
 		Name: "aws_cur_report_definition",
 		F:    sweepReportDefinitions,
 	})
+	discovery.Register("aws_cur_report_definition", listReportDefinitions)
 }
 
 func sweepReportDefinitions(region string) error {
@@ -29,11 +33,32 @@ func sweepReportDefinitions(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listReportDefinitions(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EC2 Cost And Usage Report Definition sweep for %s: %s", region, err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error listing Cost And Usage Report Definitions (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Cost And Usage Report Definitions (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listReportDefinitions(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.CURConn(ctx)
 	input := &cur.DescribeReportDefinitionsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.DescribeReportDefinitionsPagesWithContext(ctx, input, func(page *cur.DescribeReportDefinitionsOutput, lastPage bool) bool {
+	err := conn.DescribeReportDefinitionsPagesWithContext(ctx, input, func(page *cur.DescribeReportDefinitionsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -49,19 +74,9 @@ func sweepReportDefinitions(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping EC2 Cost And Usage Report Definition sweep for %s: %s", region, err)
-		return nil
-	}
 	if err != nil {
-		return fmt.Errorf("error listing Cost And Usage Report Definitions (%s): %w", region, err)
+		return nil, err
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping Cost And Usage Report Definitions (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
@@ -16,7 +16,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/datasync"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -28,6 +30,7 @@ func init() {
 			"aws_datasync_location",
 		},
 	})
+	discovery.Register("aws_datasync_agent", listAgents)
 
 	// Pseudo-resource for any DataSync location resource type.
 	resource.AddTestSweepers("aws_datasync_location", &resource.Sweeper{
@@ -42,6 +45,7 @@ func init() {
 		Name: "aws_datasync_task",
 		F:    sweepTasks,
 	})
+	discovery.Register("aws_datasync_task", listTasks)
 }
 
 func sweepAgents(region string) error {
@@ -50,11 +54,33 @@ func sweepAgents(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listAgents(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping DataSync Agent sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing DataSync Agents (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping DataSync Agents (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listAgents(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.DataSyncConn(ctx)
 	input := &datasync.ListAgentsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListAgentsPagesWithContext(ctx, input, func(page *datasync.ListAgentsOutput, lastPage bool) bool {
+	err := conn.ListAgentsPagesWithContext(ctx, input, func(page *datasync.ListAgentsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -70,22 +96,11 @@ func sweepAgents(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping DataSync Agent sweep for %s: %s", region, err)
-		return nil
-	}
-
 	if err != nil {
-		return fmt.Errorf("error listing DataSync Agents (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping DataSync Agents (%s): %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
 
 func sweepLocations(region string) error {
@@ -161,11 +176,33 @@ func sweepTasks(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
+
+	sweepResources, err := listTasks(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping DataSync Task sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing DataSync Tasks (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping DataSync Tasks (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listTasks(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.DataSyncConn(ctx)
 	input := &datasync.ListTasksInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListTasksPagesWithContext(ctx, input, func(page *datasync.ListTasksOutput, lastPage bool) bool {
+	err := conn.ListTasksPagesWithContext(ctx, input, func(page *datasync.ListTasksOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -181,20 +218,9 @@ func sweepTasks(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping DataSync Task sweep for %s: %s", region, err)
-		return nil
-	}
-
 	if err != nil {
-		return fmt.Errorf("error listing DataSync Tasks (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping DataSync Tasks (%s): %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
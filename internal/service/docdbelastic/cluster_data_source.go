@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docdbelastic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Cluster")
+func newDataSourceCluster(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceCluster{}, nil
+}
+
+type dataSourceCluster struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceCluster) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_docdbelastic_cluster"
+}
+
+func (d *dataSourceCluster) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"admin_user_name": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"auth_type": schema.StringAttribute{
+				Computed: true,
+			},
+			"endpoint": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"kms_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Computed: true,
+			},
+			"preferred_maintenance_window": schema.StringAttribute{
+				Computed: true,
+			},
+			"shard_capacity": schema.Int64Attribute{
+				Computed: true,
+			},
+			"shard_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			"subnet_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+			"vpc_security_group_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceCluster) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceClusterData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().DocDBElasticClient(ctx)
+
+	arn := flex.ARNStringFromFramework(ctx, data.ID)
+	cluster, err := findClusterByARN(ctx, conn, aws.ToString(arn))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DocDB Elastic Cluster (%s)", aws.ToString(arn)), err.Error())
+
+		return
+	}
+
+	data.AdminUserName = flex.StringToFramework(ctx, cluster.AdminUserName)
+	data.ARN = flex.StringToFramework(ctx, cluster.ClusterArn)
+	data.AuthType = flex.StringValueToFramework(ctx, cluster.AuthType)
+	data.Endpoint = flex.StringToFramework(ctx, cluster.ClusterEndpoint)
+	data.KMSKeyID = flex.StringToFramework(ctx, cluster.KmsKeyId)
+	data.Name = flex.StringToFramework(ctx, cluster.ClusterName)
+	data.PreferredMaintenanceWindow = flex.StringToFramework(ctx, cluster.PreferredMaintenanceWindow)
+	data.ShardCapacity = flex.Int32ToFramework(ctx, cluster.ShardCapacity)
+	data.ShardCount = flex.Int32ToFramework(ctx, cluster.ShardCount)
+	data.Status = flex.StringValueToFramework(ctx, cluster.Status)
+	data.SubnetIDs = flex.FlattenFrameworkStringValueSet(ctx, cluster.SubnetIds)
+	data.VPCSecurityGroupIDs = flex.FlattenFrameworkStringValueSet(ctx, cluster.VpcSecurityGroupIds)
+
+	tags, err := listTags(ctx, conn, aws.ToString(cluster.ClusterArn))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing tags for DocDB Elastic Cluster (%s)", aws.ToString(arn)), err.Error())
+
+		return
+	}
+
+	tags = tags.IgnoreConfig(d.Meta().IgnoreTagsConfig)
+	data.Tags = flex.FlattenFrameworkStringValueMapLegacy(ctx, tags.Map())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceClusterData struct {
+	AdminUserName              types.String `tfsdk:"admin_user_name"`
+	ARN                        types.String `tfsdk:"arn"`
+	AuthType                   types.String `tfsdk:"auth_type"`
+	Endpoint                   types.String `tfsdk:"endpoint"`
+	ID                         fwtypes.ARN  `tfsdk:"id"`
+	KMSKeyID                   types.String `tfsdk:"kms_key_id"`
+	Name                       types.String `tfsdk:"name"`
+	PreferredMaintenanceWindow types.String `tfsdk:"preferred_maintenance_window"`
+	ShardCapacity              types.Int64  `tfsdk:"shard_capacity"`
+	ShardCount                 types.Int64  `tfsdk:"shard_count"`
+	Status                     types.String `tfsdk:"status"`
+	SubnetIDs                  types.Set    `tfsdk:"subnet_ids"`
+	Tags                       types.Map    `tfsdk:"tags"`
+	VPCSecurityGroupIDs        types.Set    `tfsdk:"vpc_security_group_ids"`
+}
@@ -62,6 +62,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceTableItem,
 			TypeName: "aws_dynamodb_table_item",
 		},
+		{
+			Factory:  ResourceTableItems,
+			TypeName: "aws_dynamodb_table_items",
+		},
 		{
 			Factory:  ResourceTableReplica,
 			TypeName: "aws_dynamodb_table_replica",
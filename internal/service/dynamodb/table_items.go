@@ -0,0 +1,551 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	// See https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_BatchWriteItem.html.
+	batchWriteItemMaxItems = 25
+	// See https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_BatchGetItem.html.
+	batchGetItemMaxKeys = 100
+
+	batchGetItemTimeout = 5 * time.Minute
+)
+
+// @SDKResource("aws_dynamodb_table_items")
+func ResourceTableItems() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTableItemsCreate,
+		ReadWithoutTimeout:   resourceTableItemsRead,
+		UpdateWithoutTimeout: resourceTableItemsUpdate,
+		DeleteWithoutTimeout: resourceTableItemsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: resourceTableItemsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"hash_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"items": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateTableItem,
+				},
+				ExactlyOneOf: []string{"items", "items_file"},
+			},
+			"items_by_key": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"items_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"items", "items_file"},
+			},
+			"range_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceTableItemsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	tableName := d.Get("table_name").(string)
+	items, err := expandTableItems(d)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	var requests []*dynamodb.WriteRequest
+
+	for _, v := range items {
+		requests = append(requests, &dynamodb.WriteRequest{
+			PutRequest: &dynamodb.PutRequest{Item: v},
+		})
+	}
+
+	if err := batchWriteTableItems(ctx, conn, tableName, requests, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	itemsByKey, err := flattenTableItems(items)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	d.SetId(tableName)
+	d.Set("items_by_key", itemsByKey)
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	tableName := d.Get("table_name").(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	var keys []map[string]*dynamodb.AttributeValue
+
+	for k, v := range d.Get("items_by_key").(map[string]interface{}) {
+		attributes, err := ExpandTableItemAttributes(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table (%s) Items: item (%s): %s", tableName, k, err)
+		}
+
+		keys = append(keys, BuildTableItemQueryKey(attributes, hashKey, rangeKey))
+	}
+
+	items, err := findTableItems(ctx, conn, tableName, keys)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Table (%s) Items not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	itemsByKey := make(map[string]map[string]*dynamodb.AttributeValue, len(items))
+
+	for _, v := range items {
+		key, err := tableItemsKey(v, hashKey, rangeKey)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table (%s) Items: %s", tableName, err)
+		}
+
+		itemsByKey[key] = v
+	}
+
+	// Items deleted outside of Terraform are dropped so that they are written again.
+	flattened, err := flattenTableItems(itemsByKey)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	d.Set("items_by_key", flattened)
+
+	return diags
+}
+
+func resourceTableItemsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	tableName := d.Get("table_name").(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	items, err := expandTableItems(d)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	itemsByKey, err := flattenTableItems(items)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	o, _ := d.GetChange("items_by_key")
+	old := o.(map[string]interface{})
+
+	var requests []*dynamodb.WriteRequest
+
+	// Write new and modified items.
+	for k, v := range itemsByKey {
+		if old[k] != v {
+			requests = append(requests, &dynamodb.WriteRequest{
+				PutRequest: &dynamodb.PutRequest{Item: items[k]},
+			})
+		}
+	}
+
+	// Delete removed items.
+	for k, v := range old {
+		if _, ok := itemsByKey[k]; ok {
+			continue
+		}
+
+		attributes, err := ExpandTableItemAttributes(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating DynamoDB Table (%s) Items: item (%s): %s", tableName, k, err)
+		}
+
+		requests = append(requests, &dynamodb.WriteRequest{
+			DeleteRequest: &dynamodb.DeleteRequest{Key: BuildTableItemQueryKey(attributes, hashKey, rangeKey)},
+		})
+	}
+
+	if err := batchWriteTableItems(ctx, conn, tableName, requests, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	d.Set("items_by_key", itemsByKey)
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	tableName := d.Get("table_name").(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	var requests []*dynamodb.WriteRequest
+
+	for k, v := range d.Get("items_by_key").(map[string]interface{}) {
+		attributes, err := ExpandTableItemAttributes(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting DynamoDB Table (%s) Items: item (%s): %s", tableName, k, err)
+		}
+
+		requests = append(requests, &dynamodb.WriteRequest{
+			DeleteRequest: &dynamodb.DeleteRequest{Key: BuildTableItemQueryKey(attributes, hashKey, rangeKey)},
+		})
+	}
+
+	log.Printf("[DEBUG] Deleting DynamoDB Table (%s) Items", tableName)
+	err := batchWriteTableItems(ctx, conn, tableName, requests, d.Timeout(schema.TimeoutDelete))
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	return diags
+}
+
+// resourceTableItemsCustomizeDiff plans per-item changes by keying the configured items by primary key.
+func resourceTableItemsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"hash_key", "range_key", "items", "items_file"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("items_by_key")
+		}
+	}
+
+	for i := range d.Get("items").([]interface{}) {
+		if !d.NewValueKnown(fmt.Sprintf("items.%d", i)) {
+			return d.SetNewComputed("items_by_key")
+		}
+	}
+
+	items, err := expandTableItems(d)
+
+	if err != nil {
+		return err
+	}
+
+	new, err := flattenTableItems(items)
+
+	if err != nil {
+		return err
+	}
+
+	old := d.Get("items_by_key").(map[string]interface{})
+
+	if d.Id() != "" && len(old) == len(new) {
+		changed := false
+
+		for k, v := range old {
+			if new[k] != v {
+				changed = true
+				break
+			}
+		}
+
+		if !changed {
+			return nil
+		}
+	}
+
+	return d.SetNew("items_by_key", new)
+}
+
+// tableItemsGetter is implemented by both schema.ResourceData and schema.ResourceDiff.
+type tableItemsGetter interface {
+	Get(string) interface{}
+}
+
+// expandTableItems returns the items configured in items or items_file, keyed by primary key.
+func expandTableItems(d tableItemsGetter) (map[string]map[string]*dynamodb.AttributeValue, error) {
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	var sources []string
+	var descriptions []string
+
+	if v := d.Get("items_file").(string); v != "" {
+		lines, err := readTableItemsFile(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i, line := range lines {
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+
+			sources = append(sources, line)
+			descriptions = append(descriptions, fmt.Sprintf("%s line %d", v, i+1))
+		}
+	} else {
+		for i, v := range d.Get("items").([]interface{}) {
+			sources = append(sources, v.(string))
+			descriptions = append(descriptions, fmt.Sprintf("items[%d]", i))
+		}
+	}
+
+	items := make(map[string]map[string]*dynamodb.AttributeValue, len(sources))
+
+	for i, v := range sources {
+		attributes, err := ExpandTableItemAttributes(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", descriptions[i], err)
+		}
+
+		key, err := tableItemsKey(attributes, hashKey, rangeKey)
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", descriptions[i], err)
+		}
+
+		if _, ok := items[key]; ok {
+			return nil, fmt.Errorf("%s: duplicate item key (%s)", descriptions[i], key)
+		}
+
+		items[key] = attributes
+	}
+
+	return items, nil
+}
+
+// readTableItemsFile returns the lines of a JSON Lines file.
+func readTableItemsFile(v string) ([]string, error) {
+	filename, err := homedir.Expand(v)
+
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(filename)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	// Items can be up to 400 KB.
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", v, err)
+	}
+
+	return lines, nil
+}
+
+func flattenTableItems(items map[string]map[string]*dynamodb.AttributeValue) (map[string]string, error) {
+	flattened := make(map[string]string, len(items))
+
+	for k, v := range items {
+		item, err := flattenTableItemAttributes(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("item (%s): %w", k, err)
+		}
+
+		flattened[k] = strings.TrimSpace(item)
+	}
+
+	return flattened, nil
+}
+
+// tableItemsKey returns the string form of an item's primary key: the hash key value, followed by `|` and
+// the range key value if the table has a range key. `\` and `|` in key values are escaped with `\` so that
+// distinct primary keys never have the same string form.
+func tableItemsKey(attributes map[string]*dynamodb.AttributeValue, hashKey, rangeKey string) (string, error) {
+	hashVal, ok := attributes[hashKey]
+
+	if !ok {
+		return "", fmt.Errorf("item has no hash key attribute (%s)", hashKey)
+	}
+
+	key := tableItemsKeyValue(hashVal)
+
+	if rangeKey == "" {
+		return key, nil
+	}
+
+	rangeVal, ok := attributes[rangeKey]
+
+	if !ok {
+		return "", fmt.Errorf("item has no range key attribute (%s)", rangeKey)
+	}
+
+	return key + "|" + tableItemsKeyValue(rangeVal), nil
+}
+
+var tableItemsKeyValueEscaper = strings.NewReplacer(`\`, `\\`, "|", `\|`)
+
+func tableItemsKeyValue(v *dynamodb.AttributeValue) string {
+	switch {
+	case v.S != nil:
+		return tableItemsKeyValueEscaper.Replace(aws.StringValue(v.S))
+	case v.N != nil:
+		return aws.StringValue(v.N)
+	default:
+		return verify.Base64Encode(v.B)
+	}
+}
+
+// batchWriteTableItems writes items to a table, up to 25 at a time, retrying any unprocessed items.
+func batchWriteTableItems(ctx context.Context, conn *dynamodb.DynamoDB, tableName string, requests []*dynamodb.WriteRequest, timeout time.Duration) error {
+	for _, chunk := range tfslices.Chunks(requests, batchWriteItemMaxItems) {
+		input := &dynamodb.BatchWriteItemInput{
+			RequestItems: map[string][]*dynamodb.WriteRequest{
+				tableName: chunk,
+			},
+		}
+
+		err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
+			output, err := conn.BatchWriteItemWithContext(ctx, input)
+
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			if n := len(output.UnprocessedItems[tableName]); n > 0 {
+				input.RequestItems = output.UnprocessedItems
+
+				return retry.RetryableError(fmt.Errorf("%d unprocessed items", n))
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findTableItems reads items from a table by primary key, up to 100 at a time, retrying any unprocessed keys.
+// Items that don't exist are omitted from the result.
+func findTableItems(ctx context.Context, conn *dynamodb.DynamoDB, tableName string, keys []map[string]*dynamodb.AttributeValue) ([]map[string]*dynamodb.AttributeValue, error) {
+	var items []map[string]*dynamodb.AttributeValue
+
+	for _, chunk := range tfslices.Chunks(keys, batchGetItemMaxKeys) {
+		input := &dynamodb.BatchGetItemInput{
+			RequestItems: map[string]*dynamodb.KeysAndAttributes{
+				tableName: {
+					ConsistentRead: aws.Bool(true),
+					Keys:           chunk,
+				},
+			},
+		}
+
+		err := tfresource.Retry(ctx, batchGetItemTimeout, func() *retry.RetryError {
+			output, err := conn.BatchGetItemWithContext(ctx, input)
+
+			if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+				return retry.NonRetryableError(&retry.NotFoundError{
+					LastError:   err,
+					LastRequest: input,
+				})
+			}
+
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			items = append(items, output.Responses[tableName]...)
+
+			if v, ok := output.UnprocessedKeys[tableName]; ok && len(v.Keys) > 0 {
+				input.RequestItems = output.UnprocessedKeys
+
+				return retry.RetryableError(fmt.Errorf("%d unprocessed keys", len(v.Keys)))
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return items, nil
+}
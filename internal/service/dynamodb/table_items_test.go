@@ -0,0 +1,362 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestTableItemsKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName    string
+		Attributes  map[string]*dynamodb.AttributeValue
+		RangeKey    string
+		Expected    string
+		ExpectError bool
+	}{
+		{
+			TestName: "hash key",
+			Attributes: map[string]*dynamodb.AttributeValue{
+				"hk": {S: aws.String("a")},
+			},
+			Expected: "a",
+		},
+		{
+			TestName: "range key",
+			Attributes: map[string]*dynamodb.AttributeValue{
+				"hk": {S: aws.String("a")},
+				"rk": {N: aws.String("1")},
+			},
+			RangeKey: "rk",
+			Expected: "a|1",
+		},
+		{
+			TestName: "separator in hash key",
+			Attributes: map[string]*dynamodb.AttributeValue{
+				"hk": {S: aws.String("a|b")},
+				"rk": {S: aws.String("c")},
+			},
+			RangeKey: "rk",
+			Expected: `a\|b|c`,
+		},
+		{
+			TestName: "separator in range key",
+			Attributes: map[string]*dynamodb.AttributeValue{
+				"hk": {S: aws.String("a")},
+				"rk": {S: aws.String("b|c")},
+			},
+			RangeKey: "rk",
+			Expected: `a|b\|c`,
+		},
+		{
+			TestName: "escape in hash key",
+			Attributes: map[string]*dynamodb.AttributeValue{
+				"hk": {S: aws.String(`a\`)},
+				"rk": {S: aws.String("|b")},
+			},
+			RangeKey: "rk",
+			Expected: `a\\|\|b`,
+		},
+		{
+			TestName: "binary",
+			Attributes: map[string]*dynamodb.AttributeValue{
+				"hk": {B: []byte("a|b")},
+			},
+			Expected: "YXxi",
+		},
+		{
+			TestName: "missing range key",
+			Attributes: map[string]*dynamodb.AttributeValue{
+				"hk": {S: aws.String("a")},
+			},
+			RangeKey:    "rk",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfdynamodb.TableItemsKey(testCase.Attributes, "hk", testCase.RangeKey)
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error")
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAccDynamoDBTableItems_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dynamodb_table_items.test"
+	tableName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(tableName, `
+    jsonencode({ id = { S = "a" }, value = { N = "1" } }),
+    jsonencode({ id = { S = "b" }, value = { N = "2" } }),
+    jsonencode({ id = { S = "c" }, value = { N = "3" } }),
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(ctx, tableName, 3),
+					resource.TestCheckResourceAttr(resourceName, "hash_key", "id"),
+					resource.TestCheckResourceAttr(resourceName, "table_name", tableName),
+					resource.TestCheckResourceAttr(resourceName, "items_by_key.%", "3"),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "items_by_key.a", `{"id": {"S": "a"}, "value": {"N": "1"}}`),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_basic(tableName, `
+    jsonencode({ id = { S = "a" }, value = { N = "1" } }),
+    jsonencode({ id = { S = "b" }, value = { N = "20" } }),
+    jsonencode({ id = { S = "d" }, value = { N = "4" } }),
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(ctx, tableName, 3),
+					resource.TestCheckResourceAttr(resourceName, "items_by_key.%", "3"),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "items_by_key.b", `{"id": {"S": "b"}, "value": {"N": "20"}}`),
+					resource.TestCheckNoResourceAttr(resourceName, "items_by_key.c"),
+					resource.TestCheckResourceAttrSet(resourceName, "items_by_key.d"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_rangeKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dynamodb_table_items.test"
+	tableName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_rangeKey(tableName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(ctx, tableName, 2),
+					resource.TestCheckResourceAttr(resourceName, "range_key", "sk"),
+					resource.TestCheckResourceAttr(resourceName, "items_by_key.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "items_by_key.a|1"),
+					resource.TestCheckResourceAttrSet(resourceName, "items_by_key.a|2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_itemsFile(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dynamodb_table_items.test"
+	tableName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	var lines []string
+	for i := 0; i < 60; i++ {
+		lines = append(lines, fmt.Sprintf(`{"id": {"S": "item-%02d"}, "value": {"N": "%d"}}`, i, i))
+	}
+
+	itemsFile := filepath.Join(t.TempDir(), "items.jsonl")
+	if err := os.WriteFile(itemsFile, []byte(strings.Join(lines, "\n")+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_itemsFile(tableName, itemsFile),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(ctx, tableName, 60),
+					resource.TestCheckResourceAttr(resourceName, "items_by_key.%", "60"),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "items_by_key.item-42", `{"id": {"S": "item-42"}, "value": {"N": "42"}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_duplicateKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	tableName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(tableName, `
+    jsonencode({ id = { S = "a" }, value = { N = "1" } }),
+    jsonencode({ id = { S = "a" }, value = { N = "2" } }),
+`),
+				ExpectError: regexache.MustCompile(`duplicate item key \(a\)`),
+			},
+		},
+	})
+}
+
+func testAccCheckTableItemsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dynamodb_table_items" {
+				continue
+			}
+
+			attrs := rs.Primary.Attributes
+			var keys []map[string]*dynamodb.AttributeValue
+
+			for k, v := range attrs {
+				if !strings.HasPrefix(k, "items_by_key.") || k == "items_by_key.%" {
+					continue
+				}
+
+				attributes, err := tfdynamodb.ExpandTableItemAttributes(v)
+				if err != nil {
+					return err
+				}
+
+				keys = append(keys, tfdynamodb.BuildTableItemQueryKey(attributes, attrs["hash_key"], attrs["range_key"]))
+			}
+
+			items, err := tfdynamodb.FindTableItems(ctx, conn, attrs["table_name"], keys)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(items) > 0 {
+				return fmt.Errorf("DynamoDB Table (%s) Items still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccTableItemsConfig_basic(tableName, items string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 10
+  write_capacity = 10
+  hash_key       = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  items = [
+%[2]s
+  ]
+}
+`, tableName, items)
+}
+
+func testAccTableItemsConfig_rangeKey(tableName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 10
+  write_capacity = 10
+  hash_key       = "id"
+  range_key      = "sk"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+
+  attribute {
+    name = "sk"
+    type = "N"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+
+  items = [
+    jsonencode({ id = { S = "a" }, sk = { N = "1" }, value = { S = "one" } }),
+    jsonencode({ id = { S = "a" }, sk = { N = "2" }, value = { S = "two" } }),
+  ]
+}
+`, tableName)
+}
+
+func testAccTableItemsConfig_itemsFile(tableName, itemsFile string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 10
+  write_capacity = 10
+  hash_key       = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  items_file = %[2]q
+}
+`, tableName, itemsFile)
+}
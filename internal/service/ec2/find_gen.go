@@ -0,0 +1,1452 @@
+// Code generated by "internal/generate/finders/main.go"; DO NOT EDIT.
+
+package ec2
+
+import (
+	"context"
+
+	ec2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAvailabilityZone(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeAvailabilityZonesInput) (*ec2.AvailabilityZone, error) {
+	return tfresource.FindSinglePtr(ctx, availabilityZonesFinder(conn, input))
+}
+
+func FindAvailabilityZones(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeAvailabilityZonesInput) ([]*ec2.AvailabilityZone, error) {
+	return tfresource.FindPtrs(ctx, availabilityZonesFinder(conn, input))
+}
+
+func availabilityZonesFinder(conn *ec2.EC2, input *ec2.DescribeAvailabilityZonesInput) tfresource.Finder[*ec2.AvailabilityZone] {
+	return tfresource.Finder[*ec2.AvailabilityZone]{
+		List: tfresource.ListOnce(conn.DescribeAvailabilityZonesWithContext, input, func(page *ec2.DescribeAvailabilityZonesOutput) []*ec2.AvailabilityZone {
+			return page.AvailabilityZones
+		}),
+		LastRequest: input,
+	}
+}
+
+func FindCOIPPool(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeCoipPoolsInput) (*ec2.CoipPool, error) {
+	return tfresource.FindSinglePtr(ctx, coipPoolsFinder(conn, input))
+}
+
+func FindCOIPPools(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeCoipPoolsInput) ([]*ec2.CoipPool, error) {
+	return tfresource.FindPtrs(ctx, coipPoolsFinder(conn, input))
+}
+
+func coipPoolsFinder(conn *ec2.EC2, input *ec2.DescribeCoipPoolsInput) tfresource.Finder[*ec2.CoipPool] {
+	return tfresource.Finder[*ec2.CoipPool]{
+		List: tfresource.ListPages(conn.DescribeCoipPoolsPagesWithContext, input, func(page *ec2.DescribeCoipPoolsOutput) []*ec2.CoipPool {
+			return page.CoipPools
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidPoolIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindCapacityReservation(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeCapacityReservationsInput) (*ec2.CapacityReservation, error) {
+	return tfresource.FindSinglePtr(ctx, capacityReservationsFinder(conn, input))
+}
+
+func FindCapacityReservations(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeCapacityReservationsInput) ([]*ec2.CapacityReservation, error) {
+	return tfresource.FindPtrs(ctx, capacityReservationsFinder(conn, input))
+}
+
+func capacityReservationsFinder(conn *ec2.EC2, input *ec2.DescribeCapacityReservationsInput) tfresource.Finder[*ec2.CapacityReservation] {
+	return tfresource.Finder[*ec2.CapacityReservation]{
+		List: tfresource.ListPages(conn.DescribeCapacityReservationsPagesWithContext, input, func(page *ec2.DescribeCapacityReservationsOutput) []*ec2.CapacityReservation {
+			return page.CapacityReservations
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidCapacityReservationIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindCarrierGateway(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeCarrierGatewaysInput) (*ec2.CarrierGateway, error) {
+	return tfresource.FindSinglePtr(ctx, carrierGatewaysFinder(conn, input))
+}
+
+func FindCarrierGateways(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeCarrierGatewaysInput) ([]*ec2.CarrierGateway, error) {
+	return tfresource.FindPtrs(ctx, carrierGatewaysFinder(conn, input))
+}
+
+func carrierGatewaysFinder(conn *ec2.EC2, input *ec2.DescribeCarrierGatewaysInput) tfresource.Finder[*ec2.CarrierGateway] {
+	return tfresource.Finder[*ec2.CarrierGateway]{
+		List: tfresource.ListPages(conn.DescribeCarrierGatewaysPagesWithContext, input, func(page *ec2.DescribeCarrierGatewaysOutput) []*ec2.CarrierGateway {
+			return page.CarrierGateways
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidCarrierGatewayIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindClientVPNAuthorizationRule(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeClientVpnAuthorizationRulesInput) (*ec2.AuthorizationRule, error) {
+	output, err := tfresource.FindSinglePtr(ctx, clientVPNAuthorizationRulesFinder(conn, input))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Status == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindClientVPNAuthorizationRules(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeClientVpnAuthorizationRulesInput) ([]*ec2.AuthorizationRule, error) {
+	return tfresource.FindPtrs(ctx, clientVPNAuthorizationRulesFinder(conn, input))
+}
+
+func clientVPNAuthorizationRulesFinder(conn *ec2.EC2, input *ec2.DescribeClientVpnAuthorizationRulesInput) tfresource.Finder[*ec2.AuthorizationRule] {
+	return tfresource.Finder[*ec2.AuthorizationRule]{
+		List: tfresource.ListPages(conn.DescribeClientVpnAuthorizationRulesPagesWithContext, input, func(page *ec2.DescribeClientVpnAuthorizationRulesOutput) []*ec2.AuthorizationRule {
+			return page.AuthorizationRules
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidClientVPNEndpointIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindClientVPNEndpoint(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeClientVpnEndpointsInput) (*ec2.ClientVpnEndpoint, error) {
+	output, err := tfresource.FindSinglePtr(ctx, clientVPNEndpointsFinder(conn, input))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Status == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindClientVPNEndpoints(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeClientVpnEndpointsInput) ([]*ec2.ClientVpnEndpoint, error) {
+	return tfresource.FindPtrs(ctx, clientVPNEndpointsFinder(conn, input))
+}
+
+func clientVPNEndpointsFinder(conn *ec2.EC2, input *ec2.DescribeClientVpnEndpointsInput) tfresource.Finder[*ec2.ClientVpnEndpoint] {
+	return tfresource.Finder[*ec2.ClientVpnEndpoint]{
+		List: tfresource.ListPages(conn.DescribeClientVpnEndpointsPagesWithContext, input, func(page *ec2.DescribeClientVpnEndpointsOutput) []*ec2.ClientVpnEndpoint {
+			return page.ClientVpnEndpoints
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidClientVPNEndpointIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindClientVPNNetworkAssociation(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeClientVpnTargetNetworksInput) (*ec2.TargetNetwork, error) {
+	output, err := tfresource.FindSinglePtr(ctx, clientVPNNetworkAssociationsFinder(conn, input))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Status == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindClientVPNNetworkAssociations(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeClientVpnTargetNetworksInput) ([]*ec2.TargetNetwork, error) {
+	return tfresource.FindPtrs(ctx, clientVPNNetworkAssociationsFinder(conn, input))
+}
+
+func clientVPNNetworkAssociationsFinder(conn *ec2.EC2, input *ec2.DescribeClientVpnTargetNetworksInput) tfresource.Finder[*ec2.TargetNetwork] {
+	return tfresource.Finder[*ec2.TargetNetwork]{
+		List: tfresource.ListPages(conn.DescribeClientVpnTargetNetworksPagesWithContext, input, func(page *ec2.DescribeClientVpnTargetNetworksOutput) []*ec2.TargetNetwork {
+			return page.ClientVpnTargetNetworks
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidClientVPNEndpointIdNotFound, errCodeInvalidClientVPNAssociationIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindClientVPNRoute(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeClientVpnRoutesInput) (*ec2.ClientVpnRoute, error) {
+	output, err := tfresource.FindSinglePtr(ctx, clientVPNRoutesFinder(conn, input))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Status == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindClientVPNRoutes(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeClientVpnRoutesInput) ([]*ec2.ClientVpnRoute, error) {
+	return tfresource.FindPtrs(ctx, clientVPNRoutesFinder(conn, input))
+}
+
+func clientVPNRoutesFinder(conn *ec2.EC2, input *ec2.DescribeClientVpnRoutesInput) tfresource.Finder[*ec2.ClientVpnRoute] {
+	return tfresource.Finder[*ec2.ClientVpnRoute]{
+		List: tfresource.ListPages(conn.DescribeClientVpnRoutesPagesWithContext, input, func(page *ec2.DescribeClientVpnRoutesOutput) []*ec2.ClientVpnRoute {
+			return page.Routes
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidClientVPNEndpointIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindCustomerGateway(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeCustomerGatewaysInput) (*ec2.CustomerGateway, error) {
+	return tfresource.FindSinglePtr(ctx, customerGatewaysFinder(conn, input))
+}
+
+func FindCustomerGateways(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeCustomerGatewaysInput) ([]*ec2.CustomerGateway, error) {
+	return tfresource.FindPtrs(ctx, customerGatewaysFinder(conn, input))
+}
+
+func customerGatewaysFinder(conn *ec2.EC2, input *ec2.DescribeCustomerGatewaysInput) tfresource.Finder[*ec2.CustomerGateway] {
+	return tfresource.Finder[*ec2.CustomerGateway]{
+		List: tfresource.ListOnce(conn.DescribeCustomerGatewaysWithContext, input, func(page *ec2.DescribeCustomerGatewaysOutput) []*ec2.CustomerGateway {
+			return page.CustomerGateways
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidCustomerGatewayIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindDHCPOptions(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeDhcpOptionsInput) (*ec2.DhcpOptions, error) {
+	return tfresource.FindSinglePtr(ctx, dhcpOptionsesFinder(conn, input))
+}
+
+func FindDHCPOptionses(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeDhcpOptionsInput) ([]*ec2.DhcpOptions, error) {
+	return tfresource.FindPtrs(ctx, dhcpOptionsesFinder(conn, input))
+}
+
+func dhcpOptionsesFinder(conn *ec2.EC2, input *ec2.DescribeDhcpOptionsInput) tfresource.Finder[*ec2.DhcpOptions] {
+	return tfresource.Finder[*ec2.DhcpOptions]{
+		List: tfresource.ListPages(conn.DescribeDhcpOptionsPagesWithContext, input, func(page *ec2.DescribeDhcpOptionsOutput) []*ec2.DhcpOptions {
+			return page.DhcpOptions
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidDHCPOptionIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindEBSVolume(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVolumesInput) (*ec2.Volume, error) {
+	return tfresource.FindSinglePtr(ctx, ebsVolumesFinder(conn, input))
+}
+
+func FindEBSVolumes(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVolumesInput) ([]*ec2.Volume, error) {
+	return tfresource.FindPtrs(ctx, ebsVolumesFinder(conn, input))
+}
+
+func ebsVolumesFinder(conn *ec2.EC2, input *ec2.DescribeVolumesInput) tfresource.Finder[*ec2.Volume] {
+	return tfresource.Finder[*ec2.Volume]{
+		List: tfresource.ListPages(conn.DescribeVolumesPagesWithContext, input, func(page *ec2.DescribeVolumesOutput) []*ec2.Volume {
+			return page.Volumes
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidVolumeNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindEgressOnlyInternetGateway(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeEgressOnlyInternetGatewaysInput) (*ec2.EgressOnlyInternetGateway, error) {
+	return tfresource.FindSinglePtr(ctx, egressOnlyInternetGatewaysFinder(conn, input))
+}
+
+func FindEgressOnlyInternetGateways(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeEgressOnlyInternetGatewaysInput) ([]*ec2.EgressOnlyInternetGateway, error) {
+	return tfresource.FindPtrs(ctx, egressOnlyInternetGatewaysFinder(conn, input))
+}
+
+func egressOnlyInternetGatewaysFinder(conn *ec2.EC2, input *ec2.DescribeEgressOnlyInternetGatewaysInput) tfresource.Finder[*ec2.EgressOnlyInternetGateway] {
+	return tfresource.Finder[*ec2.EgressOnlyInternetGateway]{
+		List: tfresource.ListPages(conn.DescribeEgressOnlyInternetGatewaysPagesWithContext, input, func(page *ec2.DescribeEgressOnlyInternetGatewaysOutput) []*ec2.EgressOnlyInternetGateway {
+			return page.EgressOnlyInternetGateways
+		}),
+		LastRequest: input,
+	}
+}
+
+func FindFleet(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeFleetsInput) (*ec2.FleetData, error) {
+	return tfresource.FindSinglePtr(ctx, fleetsFinder(conn, input))
+}
+
+func FindFleets(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeFleetsInput) ([]*ec2.FleetData, error) {
+	return tfresource.FindPtrs(ctx, fleetsFinder(conn, input))
+}
+
+func fleetsFinder(conn *ec2.EC2, input *ec2.DescribeFleetsInput) tfresource.Finder[*ec2.FleetData] {
+	return tfresource.Finder[*ec2.FleetData]{
+		List: tfresource.ListPages(conn.DescribeFleetsPagesWithContext, input, func(page *ec2.DescribeFleetsOutput) []*ec2.FleetData {
+			return page.Fleets
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidFleetIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindFlowLog(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeFlowLogsInput) (*ec2.FlowLog, error) {
+	return tfresource.FindSinglePtr(ctx, flowLogsFinder(conn, input))
+}
+
+func FindFlowLogs(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeFlowLogsInput) ([]*ec2.FlowLog, error) {
+	return tfresource.FindPtrs(ctx, flowLogsFinder(conn, input))
+}
+
+func flowLogsFinder(conn *ec2.EC2, input *ec2.DescribeFlowLogsInput) tfresource.Finder[*ec2.FlowLog] {
+	return tfresource.Finder[*ec2.FlowLog]{
+		List: tfresource.ListPages(conn.DescribeFlowLogsPagesWithContext, input, func(page *ec2.DescribeFlowLogsOutput) []*ec2.FlowLog {
+			return page.FlowLogs
+		}),
+		LastRequest: input,
+	}
+}
+
+func FindHost(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeHostsInput) (*ec2.Host, error) {
+	output, err := tfresource.FindSinglePtr(ctx, hostsFinder(conn, input))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.HostProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindHosts(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeHostsInput) ([]*ec2.Host, error) {
+	return tfresource.FindPtrs(ctx, hostsFinder(conn, input))
+}
+
+func hostsFinder(conn *ec2.EC2, input *ec2.DescribeHostsInput) tfresource.Finder[*ec2.Host] {
+	return tfresource.Finder[*ec2.Host]{
+		List: tfresource.ListPages(conn.DescribeHostsPagesWithContext, input, func(page *ec2.DescribeHostsOutput) []*ec2.Host {
+			return page.Hosts
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidHostIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindIPAM(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeIpamsInput) (*ec2.Ipam, error) {
+	return tfresource.FindSinglePtr(ctx, ipamsFinder(conn, input))
+}
+
+func FindIPAMs(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeIpamsInput) ([]*ec2.Ipam, error) {
+	return tfresource.FindPtrs(ctx, ipamsFinder(conn, input))
+}
+
+func ipamsFinder(conn *ec2.EC2, input *ec2.DescribeIpamsInput) tfresource.Finder[*ec2.Ipam] {
+	return tfresource.Finder[*ec2.Ipam]{
+		List: tfresource.ListPages(conn.DescribeIpamsPagesWithContext, input, func(page *ec2.DescribeIpamsOutput) []*ec2.Ipam {
+			return page.Ipams
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidIPAMIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindIPAMPool(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeIpamPoolsInput) (*ec2.IpamPool, error) {
+	return tfresource.FindSinglePtr(ctx, ipamPoolsFinder(conn, input))
+}
+
+func FindIPAMPools(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeIpamPoolsInput) ([]*ec2.IpamPool, error) {
+	return tfresource.FindPtrs(ctx, ipamPoolsFinder(conn, input))
+}
+
+func ipamPoolsFinder(conn *ec2.EC2, input *ec2.DescribeIpamPoolsInput) tfresource.Finder[*ec2.IpamPool] {
+	return tfresource.Finder[*ec2.IpamPool]{
+		List: tfresource.ListPages(conn.DescribeIpamPoolsPagesWithContext, input, func(page *ec2.DescribeIpamPoolsOutput) []*ec2.IpamPool {
+			return page.IpamPools
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidIPAMPoolIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindIPAMPoolAllocation(ctx context.Context, conn *ec2.EC2, input *ec2.GetIpamPoolAllocationsInput) (*ec2.IpamPoolAllocation, error) {
+	return tfresource.FindSinglePtr(ctx, ipamPoolAllocationsFinder(conn, input))
+}
+
+func FindIPAMPoolAllocations(ctx context.Context, conn *ec2.EC2, input *ec2.GetIpamPoolAllocationsInput) ([]*ec2.IpamPoolAllocation, error) {
+	return tfresource.FindPtrs(ctx, ipamPoolAllocationsFinder(conn, input))
+}
+
+func ipamPoolAllocationsFinder(conn *ec2.EC2, input *ec2.GetIpamPoolAllocationsInput) tfresource.Finder[*ec2.IpamPoolAllocation] {
+	return tfresource.Finder[*ec2.IpamPoolAllocation]{
+		List: tfresource.ListPages(conn.GetIpamPoolAllocationsPagesWithContext, input, func(page *ec2.GetIpamPoolAllocationsOutput) []*ec2.IpamPoolAllocation {
+			return page.IpamPoolAllocations
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidIPAMPoolAllocationIdNotFound, errCodeInvalidIPAMPoolIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindIPAMPoolCIDR(ctx context.Context, conn *ec2.EC2, input *ec2.GetIpamPoolCidrsInput) (*ec2.IpamPoolCidr, error) {
+	return tfresource.FindSinglePtr(ctx, ipamPoolCIDRsFinder(conn, input))
+}
+
+func FindIPAMPoolCIDRs(ctx context.Context, conn *ec2.EC2, input *ec2.GetIpamPoolCidrsInput) ([]*ec2.IpamPoolCidr, error) {
+	return tfresource.FindPtrs(ctx, ipamPoolCIDRsFinder(conn, input))
+}
+
+func ipamPoolCIDRsFinder(conn *ec2.EC2, input *ec2.GetIpamPoolCidrsInput) tfresource.Finder[*ec2.IpamPoolCidr] {
+	return tfresource.Finder[*ec2.IpamPoolCidr]{
+		List: tfresource.ListPages(conn.GetIpamPoolCidrsPagesWithContext, input, func(page *ec2.GetIpamPoolCidrsOutput) []*ec2.IpamPoolCidr {
+			return page.IpamPoolCidrs
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidIPAMPoolIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindIPAMResourceDiscovery(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeIpamResourceDiscoveriesInput) (*ec2.IpamResourceDiscovery, error) {
+	return tfresource.FindSinglePtr(ctx, ipamResourceDiscoveriesFinder(conn, input))
+}
+
+func FindIPAMResourceDiscoveries(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeIpamResourceDiscoveriesInput) ([]*ec2.IpamResourceDiscovery, error) {
+	return tfresource.FindPtrs(ctx, ipamResourceDiscoveriesFinder(conn, input))
+}
+
+func ipamResourceDiscoveriesFinder(conn *ec2.EC2, input *ec2.DescribeIpamResourceDiscoveriesInput) tfresource.Finder[*ec2.IpamResourceDiscovery] {
+	return tfresource.Finder[*ec2.IpamResourceDiscovery]{
+		List: tfresource.ListPages(conn.DescribeIpamResourceDiscoveriesPagesWithContext, input, func(page *ec2.DescribeIpamResourceDiscoveriesOutput) []*ec2.IpamResourceDiscovery {
+			return page.IpamResourceDiscoveries
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidIPAMResourceDiscoveryIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindIPAMResourceDiscoveryAssociation(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeIpamResourceDiscoveryAssociationsInput) (*ec2.IpamResourceDiscoveryAssociation, error) {
+	return tfresource.FindSinglePtr(ctx, ipamResourceDiscoveryAssociationsFinder(conn, input))
+}
+
+func FindIPAMResourceDiscoveryAssociations(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeIpamResourceDiscoveryAssociationsInput) ([]*ec2.IpamResourceDiscoveryAssociation, error) {
+	return tfresource.FindPtrs(ctx, ipamResourceDiscoveryAssociationsFinder(conn, input))
+}
+
+func ipamResourceDiscoveryAssociationsFinder(conn *ec2.EC2, input *ec2.DescribeIpamResourceDiscoveryAssociationsInput) tfresource.Finder[*ec2.IpamResourceDiscoveryAssociation] {
+	return tfresource.Finder[*ec2.IpamResourceDiscoveryAssociation]{
+		List: tfresource.ListPages(conn.DescribeIpamResourceDiscoveryAssociationsPagesWithContext, input, func(page *ec2.DescribeIpamResourceDiscoveryAssociationsOutput) []*ec2.IpamResourceDiscoveryAssociation {
+			return page.IpamResourceDiscoveryAssociations
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidIPAMResourceDiscoveryAssociationIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindIPAMScope(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeIpamScopesInput) (*ec2.IpamScope, error) {
+	return tfresource.FindSinglePtr(ctx, ipamScopesFinder(conn, input))
+}
+
+func FindIPAMScopes(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeIpamScopesInput) ([]*ec2.IpamScope, error) {
+	return tfresource.FindPtrs(ctx, ipamScopesFinder(conn, input))
+}
+
+func ipamScopesFinder(conn *ec2.EC2, input *ec2.DescribeIpamScopesInput) tfresource.Finder[*ec2.IpamScope] {
+	return tfresource.Finder[*ec2.IpamScope]{
+		List: tfresource.ListPages(conn.DescribeIpamScopesPagesWithContext, input, func(page *ec2.DescribeIpamScopesOutput) []*ec2.IpamScope {
+			return page.IpamScopes
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidIPAMScopeIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindImage(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeImagesInput) (*ec2.Image, error) {
+	return tfresource.FindSinglePtr(ctx, imagesFinder(conn, input))
+}
+
+func FindImages(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeImagesInput) ([]*ec2.Image, error) {
+	return tfresource.FindPtrs(ctx, imagesFinder(conn, input))
+}
+
+func imagesFinder(conn *ec2.EC2, input *ec2.DescribeImagesInput) tfresource.Finder[*ec2.Image] {
+	return tfresource.Finder[*ec2.Image]{
+		List: tfresource.ListPages(conn.DescribeImagesPagesWithContext, input, func(page *ec2.DescribeImagesOutput) []*ec2.Image {
+			return page.Images
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidAMIIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindInstanceConnectEndpoint(ctx context.Context, conn *ec2_sdkv2.Client, input *ec2_sdkv2.DescribeInstanceConnectEndpointsInput) (*awstypes.Ec2InstanceConnectEndpoint, error) {
+	return tfresource.FindSingleValue(ctx, instanceConnectEndpointsFinder(conn, input))
+}
+
+func FindInstanceConnectEndpoints(ctx context.Context, conn *ec2_sdkv2.Client, input *ec2_sdkv2.DescribeInstanceConnectEndpointsInput) ([]awstypes.Ec2InstanceConnectEndpoint, error) {
+	return tfresource.Find(ctx, instanceConnectEndpointsFinder(conn, input))
+}
+
+func instanceConnectEndpointsFinder(conn *ec2_sdkv2.Client, input *ec2_sdkv2.DescribeInstanceConnectEndpointsInput) tfresource.Finder[awstypes.Ec2InstanceConnectEndpoint] {
+	paginator := ec2_sdkv2.NewDescribeInstanceConnectEndpointsPaginator(conn, input)
+
+	return tfresource.Finder[awstypes.Ec2InstanceConnectEndpoint]{
+		List: tfresource.ListPagesV2(paginator.HasMorePages, paginator.NextPage, func(page *ec2_sdkv2.DescribeInstanceConnectEndpointsOutput) []awstypes.Ec2InstanceConnectEndpoint {
+			return page.InstanceConnectEndpoints
+		}),
+		NotFound:    tfresource.NotFoundErrCodesV2(errCodeInvalidInstanceConnectEndpointIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindInstanceCreditSpecification(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeInstanceCreditSpecificationsInput) (*ec2.InstanceCreditSpecification, error) {
+	return tfresource.FindSinglePtr(ctx, instanceCreditSpecificationsFinder(conn, input))
+}
+
+func FindInstanceCreditSpecifications(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeInstanceCreditSpecificationsInput) ([]*ec2.InstanceCreditSpecification, error) {
+	return tfresource.FindPtrs(ctx, instanceCreditSpecificationsFinder(conn, input))
+}
+
+func instanceCreditSpecificationsFinder(conn *ec2.EC2, input *ec2.DescribeInstanceCreditSpecificationsInput) tfresource.Finder[*ec2.InstanceCreditSpecification] {
+	return tfresource.Finder[*ec2.InstanceCreditSpecification]{
+		List: tfresource.ListPages(conn.DescribeInstanceCreditSpecificationsPagesWithContext, input, func(page *ec2.DescribeInstanceCreditSpecificationsOutput) []*ec2.InstanceCreditSpecification {
+			return page.InstanceCreditSpecifications
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidInstanceIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindInstanceType(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeInstanceTypesInput) (*ec2.InstanceTypeInfo, error) {
+	return tfresource.FindSinglePtr(ctx, instanceTypesFinder(conn, input))
+}
+
+func FindInstanceTypes(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeInstanceTypesInput) ([]*ec2.InstanceTypeInfo, error) {
+	return tfresource.FindPtrs(ctx, instanceTypesFinder(conn, input))
+}
+
+func instanceTypesFinder(conn *ec2.EC2, input *ec2.DescribeInstanceTypesInput) tfresource.Finder[*ec2.InstanceTypeInfo] {
+	return tfresource.Finder[*ec2.InstanceTypeInfo]{
+		List: tfresource.ListPages(conn.DescribeInstanceTypesPagesWithContext, input, func(page *ec2.DescribeInstanceTypesOutput) []*ec2.InstanceTypeInfo {
+			return page.InstanceTypes
+		}),
+		LastRequest: input,
+	}
+}
+
+func FindInternetGateway(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeInternetGatewaysInput) (*ec2.InternetGateway, error) {
+	return tfresource.FindSinglePtr(ctx, internetGatewaysFinder(conn, input))
+}
+
+func FindInternetGateways(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeInternetGatewaysInput) ([]*ec2.InternetGateway, error) {
+	return tfresource.FindPtrs(ctx, internetGatewaysFinder(conn, input))
+}
+
+func internetGatewaysFinder(conn *ec2.EC2, input *ec2.DescribeInternetGatewaysInput) tfresource.Finder[*ec2.InternetGateway] {
+	return tfresource.Finder[*ec2.InternetGateway]{
+		List: tfresource.ListPages(conn.DescribeInternetGatewaysPagesWithContext, input, func(page *ec2.DescribeInternetGatewaysOutput) []*ec2.InternetGateway {
+			return page.InternetGateways
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidInternetGatewayIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindKeyPair(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeKeyPairsInput) (*ec2.KeyPairInfo, error) {
+	return tfresource.FindSinglePtr(ctx, keyPairsFinder(conn, input))
+}
+
+func FindKeyPairs(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeKeyPairsInput) ([]*ec2.KeyPairInfo, error) {
+	return tfresource.FindPtrs(ctx, keyPairsFinder(conn, input))
+}
+
+func keyPairsFinder(conn *ec2.EC2, input *ec2.DescribeKeyPairsInput) tfresource.Finder[*ec2.KeyPairInfo] {
+	return tfresource.Finder[*ec2.KeyPairInfo]{
+		List: tfresource.ListOnce(conn.DescribeKeyPairsWithContext, input, func(page *ec2.DescribeKeyPairsOutput) []*ec2.KeyPairInfo {
+			return page.KeyPairs
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidKeyPairNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindLaunchTemplate(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeLaunchTemplatesInput) (*ec2.LaunchTemplate, error) {
+	return tfresource.FindSinglePtr(ctx, launchTemplatesFinder(conn, input))
+}
+
+func FindLaunchTemplates(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeLaunchTemplatesInput) ([]*ec2.LaunchTemplate, error) {
+	return tfresource.FindPtrs(ctx, launchTemplatesFinder(conn, input))
+}
+
+func launchTemplatesFinder(conn *ec2.EC2, input *ec2.DescribeLaunchTemplatesInput) tfresource.Finder[*ec2.LaunchTemplate] {
+	return tfresource.Finder[*ec2.LaunchTemplate]{
+		List: tfresource.ListPages(conn.DescribeLaunchTemplatesPagesWithContext, input, func(page *ec2.DescribeLaunchTemplatesOutput) []*ec2.LaunchTemplate {
+			return page.LaunchTemplates
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidLaunchTemplateIdMalformed, errCodeInvalidLaunchTemplateIdNotFound, errCodeInvalidLaunchTemplateNameNotFoundException),
+		LastRequest: input,
+	}
+}
+
+func FindLaunchTemplateVersion(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeLaunchTemplateVersionsInput) (*ec2.LaunchTemplateVersion, error) {
+	output, err := tfresource.FindSinglePtr(ctx, launchTemplateVersionsFinder(conn, input))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.LaunchTemplateData == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindLaunchTemplateVersions(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeLaunchTemplateVersionsInput) ([]*ec2.LaunchTemplateVersion, error) {
+	return tfresource.FindPtrs(ctx, launchTemplateVersionsFinder(conn, input))
+}
+
+func launchTemplateVersionsFinder(conn *ec2.EC2, input *ec2.DescribeLaunchTemplateVersionsInput) tfresource.Finder[*ec2.LaunchTemplateVersion] {
+	return tfresource.Finder[*ec2.LaunchTemplateVersion]{
+		List: tfresource.ListPages(conn.DescribeLaunchTemplateVersionsPagesWithContext, input, func(page *ec2.DescribeLaunchTemplateVersionsOutput) []*ec2.LaunchTemplateVersion {
+			return page.LaunchTemplateVersions
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidLaunchTemplateIdNotFound, errCodeInvalidLaunchTemplateNameNotFoundException, errCodeInvalidLaunchTemplateIdVersionNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindLocalGateway(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeLocalGatewaysInput) (*ec2.LocalGateway, error) {
+	return tfresource.FindSinglePtr(ctx, localGatewaysFinder(conn, input))
+}
+
+func FindLocalGateways(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeLocalGatewaysInput) ([]*ec2.LocalGateway, error) {
+	return tfresource.FindPtrs(ctx, localGatewaysFinder(conn, input))
+}
+
+func localGatewaysFinder(conn *ec2.EC2, input *ec2.DescribeLocalGatewaysInput) tfresource.Finder[*ec2.LocalGateway] {
+	return tfresource.Finder[*ec2.LocalGateway]{
+		List: tfresource.ListPages(conn.DescribeLocalGatewaysPagesWithContext, input, func(page *ec2.DescribeLocalGatewaysOutput) []*ec2.LocalGateway {
+			return page.LocalGateways
+		}),
+		LastRequest: input,
+	}
+}
+
+func FindLocalGatewayRouteTable(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeLocalGatewayRouteTablesInput) (*ec2.LocalGatewayRouteTable, error) {
+	return tfresource.FindSinglePtr(ctx, localGatewayRouteTablesFinder(conn, input))
+}
+
+func FindLocalGatewayRouteTables(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeLocalGatewayRouteTablesInput) ([]*ec2.LocalGatewayRouteTable, error) {
+	return tfresource.FindPtrs(ctx, localGatewayRouteTablesFinder(conn, input))
+}
+
+func localGatewayRouteTablesFinder(conn *ec2.EC2, input *ec2.DescribeLocalGatewayRouteTablesInput) tfresource.Finder[*ec2.LocalGatewayRouteTable] {
+	return tfresource.Finder[*ec2.LocalGatewayRouteTable]{
+		List: tfresource.ListPages(conn.DescribeLocalGatewayRouteTablesPagesWithContext, input, func(page *ec2.DescribeLocalGatewayRouteTablesOutput) []*ec2.LocalGatewayRouteTable {
+			return page.LocalGatewayRouteTables
+		}),
+		LastRequest: input,
+	}
+}
+
+func FindLocalGatewayVirtualInterfaceGroup(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeLocalGatewayVirtualInterfaceGroupsInput) (*ec2.LocalGatewayVirtualInterfaceGroup, error) {
+	return tfresource.FindSinglePtr(ctx, localGatewayVirtualInterfaceGroupsFinder(conn, input))
+}
+
+func FindLocalGatewayVirtualInterfaceGroups(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeLocalGatewayVirtualInterfaceGroupsInput) ([]*ec2.LocalGatewayVirtualInterfaceGroup, error) {
+	return tfresource.FindPtrs(ctx, localGatewayVirtualInterfaceGroupsFinder(conn, input))
+}
+
+func localGatewayVirtualInterfaceGroupsFinder(conn *ec2.EC2, input *ec2.DescribeLocalGatewayVirtualInterfaceGroupsInput) tfresource.Finder[*ec2.LocalGatewayVirtualInterfaceGroup] {
+	return tfresource.Finder[*ec2.LocalGatewayVirtualInterfaceGroup]{
+		List: tfresource.ListPages(conn.DescribeLocalGatewayVirtualInterfaceGroupsPagesWithContext, input, func(page *ec2.DescribeLocalGatewayVirtualInterfaceGroupsOutput) []*ec2.LocalGatewayVirtualInterfaceGroup {
+			return page.LocalGatewayVirtualInterfaceGroups
+		}),
+		LastRequest: input,
+	}
+}
+
+func FindManagedPrefixList(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeManagedPrefixListsInput) (*ec2.ManagedPrefixList, error) {
+	return tfresource.FindSinglePtr(ctx, managedPrefixListsFinder(conn, input))
+}
+
+func FindManagedPrefixLists(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeManagedPrefixListsInput) ([]*ec2.ManagedPrefixList, error) {
+	return tfresource.FindPtrs(ctx, managedPrefixListsFinder(conn, input))
+}
+
+func managedPrefixListsFinder(conn *ec2.EC2, input *ec2.DescribeManagedPrefixListsInput) tfresource.Finder[*ec2.ManagedPrefixList] {
+	return tfresource.Finder[*ec2.ManagedPrefixList]{
+		List: tfresource.ListPages(conn.DescribeManagedPrefixListsPagesWithContext, input, func(page *ec2.DescribeManagedPrefixListsOutput) []*ec2.ManagedPrefixList {
+			return page.PrefixLists
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidPrefixListIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindNATGateway(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNatGatewaysInput) (*ec2.NatGateway, error) {
+	return tfresource.FindSinglePtr(ctx, natGatewaysFinder(conn, input))
+}
+
+func FindNATGateways(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNatGatewaysInput) ([]*ec2.NatGateway, error) {
+	return tfresource.FindPtrs(ctx, natGatewaysFinder(conn, input))
+}
+
+func natGatewaysFinder(conn *ec2.EC2, input *ec2.DescribeNatGatewaysInput) tfresource.Finder[*ec2.NatGateway] {
+	return tfresource.Finder[*ec2.NatGateway]{
+		List: tfresource.ListPages(conn.DescribeNatGatewaysPagesWithContext, input, func(page *ec2.DescribeNatGatewaysOutput) []*ec2.NatGateway {
+			return page.NatGateways
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeNatGatewayNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindNetworkACL(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNetworkAclsInput) (*ec2.NetworkAcl, error) {
+	return tfresource.FindSinglePtr(ctx, networkACLsFinder(conn, input))
+}
+
+func FindNetworkACLs(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNetworkAclsInput) ([]*ec2.NetworkAcl, error) {
+	return tfresource.FindPtrs(ctx, networkACLsFinder(conn, input))
+}
+
+func networkACLsFinder(conn *ec2.EC2, input *ec2.DescribeNetworkAclsInput) tfresource.Finder[*ec2.NetworkAcl] {
+	return tfresource.Finder[*ec2.NetworkAcl]{
+		List: tfresource.ListPages(conn.DescribeNetworkAclsPagesWithContext, input, func(page *ec2.DescribeNetworkAclsOutput) []*ec2.NetworkAcl {
+			return page.NetworkAcls
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidNetworkACLIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindNetworkInsightsAnalysis(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNetworkInsightsAnalysesInput) (*ec2.NetworkInsightsAnalysis, error) {
+	return tfresource.FindSinglePtr(ctx, networkInsightsAnalysesFinder(conn, input))
+}
+
+func FindNetworkInsightsAnalyses(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNetworkInsightsAnalysesInput) ([]*ec2.NetworkInsightsAnalysis, error) {
+	return tfresource.FindPtrs(ctx, networkInsightsAnalysesFinder(conn, input))
+}
+
+func networkInsightsAnalysesFinder(conn *ec2.EC2, input *ec2.DescribeNetworkInsightsAnalysesInput) tfresource.Finder[*ec2.NetworkInsightsAnalysis] {
+	return tfresource.Finder[*ec2.NetworkInsightsAnalysis]{
+		List: tfresource.ListPages(conn.DescribeNetworkInsightsAnalysesPagesWithContext, input, func(page *ec2.DescribeNetworkInsightsAnalysesOutput) []*ec2.NetworkInsightsAnalysis {
+			return page.NetworkInsightsAnalyses
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidNetworkInsightsAnalysisIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindNetworkInsightsPath(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNetworkInsightsPathsInput) (*ec2.NetworkInsightsPath, error) {
+	return tfresource.FindSinglePtr(ctx, networkInsightsPathsFinder(conn, input))
+}
+
+func FindNetworkInsightsPaths(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNetworkInsightsPathsInput) ([]*ec2.NetworkInsightsPath, error) {
+	return tfresource.FindPtrs(ctx, networkInsightsPathsFinder(conn, input))
+}
+
+func networkInsightsPathsFinder(conn *ec2.EC2, input *ec2.DescribeNetworkInsightsPathsInput) tfresource.Finder[*ec2.NetworkInsightsPath] {
+	return tfresource.Finder[*ec2.NetworkInsightsPath]{
+		List: tfresource.ListPages(conn.DescribeNetworkInsightsPathsPagesWithContext, input, func(page *ec2.DescribeNetworkInsightsPathsOutput) []*ec2.NetworkInsightsPath {
+			return page.NetworkInsightsPaths
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidNetworkInsightsPathIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindNetworkInterface(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNetworkInterfacesInput) (*ec2.NetworkInterface, error) {
+	return tfresource.FindSinglePtr(ctx, networkInterfacesFinder(conn, input))
+}
+
+func FindNetworkInterfaces(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNetworkInterfacesInput) ([]*ec2.NetworkInterface, error) {
+	return tfresource.FindPtrs(ctx, networkInterfacesFinder(conn, input))
+}
+
+func networkInterfacesFinder(conn *ec2.EC2, input *ec2.DescribeNetworkInterfacesInput) tfresource.Finder[*ec2.NetworkInterface] {
+	return tfresource.Finder[*ec2.NetworkInterface]{
+		List: tfresource.ListPages(conn.DescribeNetworkInterfacesPagesWithContext, input, func(page *ec2.DescribeNetworkInterfacesOutput) []*ec2.NetworkInterface {
+			return page.NetworkInterfaces
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidNetworkInterfaceIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindPrefixList(ctx context.Context, conn *ec2.EC2, input *ec2.DescribePrefixListsInput) (*ec2.PrefixList, error) {
+	return tfresource.FindSinglePtr(ctx, prefixListsFinder(conn, input))
+}
+
+func FindPrefixLists(ctx context.Context, conn *ec2.EC2, input *ec2.DescribePrefixListsInput) ([]*ec2.PrefixList, error) {
+	return tfresource.FindPtrs(ctx, prefixListsFinder(conn, input))
+}
+
+func prefixListsFinder(conn *ec2.EC2, input *ec2.DescribePrefixListsInput) tfresource.Finder[*ec2.PrefixList] {
+	return tfresource.Finder[*ec2.PrefixList]{
+		List: tfresource.ListPages(conn.DescribePrefixListsPagesWithContext, input, func(page *ec2.DescribePrefixListsOutput) []*ec2.PrefixList {
+			return page.PrefixLists
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidPrefixListIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindPublicIPv4Pool(ctx context.Context, conn *ec2.EC2, input *ec2.DescribePublicIpv4PoolsInput) (*ec2.PublicIpv4Pool, error) {
+	return tfresource.FindSinglePtr(ctx, publicIPv4PoolsFinder(conn, input))
+}
+
+func FindPublicIPv4Pools(ctx context.Context, conn *ec2.EC2, input *ec2.DescribePublicIpv4PoolsInput) ([]*ec2.PublicIpv4Pool, error) {
+	return tfresource.FindPtrs(ctx, publicIPv4PoolsFinder(conn, input))
+}
+
+func publicIPv4PoolsFinder(conn *ec2.EC2, input *ec2.DescribePublicIpv4PoolsInput) tfresource.Finder[*ec2.PublicIpv4Pool] {
+	return tfresource.Finder[*ec2.PublicIpv4Pool]{
+		List: tfresource.ListPages(conn.DescribePublicIpv4PoolsPagesWithContext, input, func(page *ec2.DescribePublicIpv4PoolsOutput) []*ec2.PublicIpv4Pool {
+			return page.PublicIpv4Pools
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidPublicIpv4PoolIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindRouteTable(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeRouteTablesInput) (*ec2.RouteTable, error) {
+	return tfresource.FindSinglePtr(ctx, routeTablesFinder(conn, input))
+}
+
+func FindRouteTables(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeRouteTablesInput) ([]*ec2.RouteTable, error) {
+	return tfresource.FindPtrs(ctx, routeTablesFinder(conn, input))
+}
+
+func routeTablesFinder(conn *ec2.EC2, input *ec2.DescribeRouteTablesInput) tfresource.Finder[*ec2.RouteTable] {
+	return tfresource.Finder[*ec2.RouteTable]{
+		List: tfresource.ListPages(conn.DescribeRouteTablesPagesWithContext, input, func(page *ec2.DescribeRouteTablesOutput) []*ec2.RouteTable {
+			return page.RouteTables
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidRouteTableIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindSecurityGroup(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSecurityGroupsInput) (*ec2.SecurityGroup, error) {
+	return tfresource.FindSinglePtr(ctx, securityGroupsFinder(conn, input))
+}
+
+func FindSecurityGroups(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSecurityGroupsInput) ([]*ec2.SecurityGroup, error) {
+	return tfresource.FindPtrs(ctx, securityGroupsFinder(conn, input))
+}
+
+func securityGroupsFinder(conn *ec2.EC2, input *ec2.DescribeSecurityGroupsInput) tfresource.Finder[*ec2.SecurityGroup] {
+	return tfresource.Finder[*ec2.SecurityGroup]{
+		List: tfresource.ListPages(conn.DescribeSecurityGroupsPagesWithContext, input, func(page *ec2.DescribeSecurityGroupsOutput) []*ec2.SecurityGroup {
+			return page.SecurityGroups
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidGroupNotFound, errCodeInvalidSecurityGroupIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindSecurityGroupRule(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSecurityGroupRulesInput) (*ec2.SecurityGroupRule, error) {
+	return tfresource.FindSinglePtr(ctx, securityGroupRulesFinder(conn, input))
+}
+
+func FindSecurityGroupRules(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSecurityGroupRulesInput) ([]*ec2.SecurityGroupRule, error) {
+	return tfresource.FindPtrs(ctx, securityGroupRulesFinder(conn, input))
+}
+
+func securityGroupRulesFinder(conn *ec2.EC2, input *ec2.DescribeSecurityGroupRulesInput) tfresource.Finder[*ec2.SecurityGroupRule] {
+	return tfresource.Finder[*ec2.SecurityGroupRule]{
+		List: tfresource.ListPages(conn.DescribeSecurityGroupRulesPagesWithContext, input, func(page *ec2.DescribeSecurityGroupRulesOutput) []*ec2.SecurityGroupRule {
+			return page.SecurityGroupRules
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidSecurityGroupRuleIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindSnapshot(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSnapshotsInput) (*ec2.Snapshot, error) {
+	return tfresource.FindSinglePtr(ctx, snapshotsFinder(conn, input))
+}
+
+func FindSnapshots(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSnapshotsInput) ([]*ec2.Snapshot, error) {
+	return tfresource.FindPtrs(ctx, snapshotsFinder(conn, input))
+}
+
+func snapshotsFinder(conn *ec2.EC2, input *ec2.DescribeSnapshotsInput) tfresource.Finder[*ec2.Snapshot] {
+	return tfresource.Finder[*ec2.Snapshot]{
+		List: tfresource.ListPages(conn.DescribeSnapshotsPagesWithContext, input, func(page *ec2.DescribeSnapshotsOutput) []*ec2.Snapshot {
+			return page.Snapshots
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidSnapshotNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindSnapshotTierStatus(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSnapshotTierStatusInput) (*ec2.SnapshotTierStatus, error) {
+	return tfresource.FindSinglePtr(ctx, snapshotTierStatusesFinder(conn, input))
+}
+
+func FindSnapshotTierStatuses(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSnapshotTierStatusInput) ([]*ec2.SnapshotTierStatus, error) {
+	return tfresource.FindPtrs(ctx, snapshotTierStatusesFinder(conn, input))
+}
+
+func snapshotTierStatusesFinder(conn *ec2.EC2, input *ec2.DescribeSnapshotTierStatusInput) tfresource.Finder[*ec2.SnapshotTierStatus] {
+	return tfresource.Finder[*ec2.SnapshotTierStatus]{
+		List: tfresource.ListPages(conn.DescribeSnapshotTierStatusPagesWithContext, input, func(page *ec2.DescribeSnapshotTierStatusOutput) []*ec2.SnapshotTierStatus {
+			return page.SnapshotTierStatuses
+		}),
+		LastRequest: input,
+	}
+}
+
+func FindSpotFleetRequest(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSpotFleetRequestsInput) (*ec2.SpotFleetRequestConfig, error) {
+	output, err := tfresource.FindSinglePtr(ctx, spotFleetRequestsFinder(conn, input))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.SpotFleetRequestConfig == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindSpotFleetRequests(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSpotFleetRequestsInput) ([]*ec2.SpotFleetRequestConfig, error) {
+	return tfresource.FindPtrs(ctx, spotFleetRequestsFinder(conn, input))
+}
+
+func spotFleetRequestsFinder(conn *ec2.EC2, input *ec2.DescribeSpotFleetRequestsInput) tfresource.Finder[*ec2.SpotFleetRequestConfig] {
+	return tfresource.Finder[*ec2.SpotFleetRequestConfig]{
+		List: tfresource.ListPages(conn.DescribeSpotFleetRequestsPagesWithContext, input, func(page *ec2.DescribeSpotFleetRequestsOutput) []*ec2.SpotFleetRequestConfig {
+			return page.SpotFleetRequestConfigs
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidSpotFleetRequestIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindSpotInstanceRequest(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSpotInstanceRequestsInput) (*ec2.SpotInstanceRequest, error) {
+	output, err := tfresource.FindSinglePtr(ctx, spotInstanceRequestsFinder(conn, input))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Status == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindSpotInstanceRequests(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSpotInstanceRequestsInput) ([]*ec2.SpotInstanceRequest, error) {
+	return tfresource.FindPtrs(ctx, spotInstanceRequestsFinder(conn, input))
+}
+
+func spotInstanceRequestsFinder(conn *ec2.EC2, input *ec2.DescribeSpotInstanceRequestsInput) tfresource.Finder[*ec2.SpotInstanceRequest] {
+	return tfresource.Finder[*ec2.SpotInstanceRequest]{
+		List: tfresource.ListPages(conn.DescribeSpotInstanceRequestsPagesWithContext, input, func(page *ec2.DescribeSpotInstanceRequestsOutput) []*ec2.SpotInstanceRequest {
+			return page.SpotInstanceRequests
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidSpotInstanceRequestIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindSubnet(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSubnetsInput) (*ec2.Subnet, error) {
+	return tfresource.FindSinglePtr(ctx, subnetsFinder(conn, input))
+}
+
+func FindSubnets(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSubnetsInput) ([]*ec2.Subnet, error) {
+	return tfresource.FindPtrs(ctx, subnetsFinder(conn, input))
+}
+
+func subnetsFinder(conn *ec2.EC2, input *ec2.DescribeSubnetsInput) tfresource.Finder[*ec2.Subnet] {
+	return tfresource.Finder[*ec2.Subnet]{
+		List: tfresource.ListPages(conn.DescribeSubnetsPagesWithContext, input, func(page *ec2.DescribeSubnetsOutput) []*ec2.Subnet {
+			return page.Subnets
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidSubnetIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindTrafficMirrorFilter(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTrafficMirrorFiltersInput) (*ec2.TrafficMirrorFilter, error) {
+	return tfresource.FindSinglePtr(ctx, trafficMirrorFiltersFinder(conn, input))
+}
+
+func FindTrafficMirrorFilters(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTrafficMirrorFiltersInput) ([]*ec2.TrafficMirrorFilter, error) {
+	return tfresource.FindPtrs(ctx, trafficMirrorFiltersFinder(conn, input))
+}
+
+func trafficMirrorFiltersFinder(conn *ec2.EC2, input *ec2.DescribeTrafficMirrorFiltersInput) tfresource.Finder[*ec2.TrafficMirrorFilter] {
+	return tfresource.Finder[*ec2.TrafficMirrorFilter]{
+		List: tfresource.ListPages(conn.DescribeTrafficMirrorFiltersPagesWithContext, input, func(page *ec2.DescribeTrafficMirrorFiltersOutput) []*ec2.TrafficMirrorFilter {
+			return page.TrafficMirrorFilters
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidTrafficMirrorFilterIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindTrafficMirrorSession(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTrafficMirrorSessionsInput) (*ec2.TrafficMirrorSession, error) {
+	return tfresource.FindSinglePtr(ctx, trafficMirrorSessionsFinder(conn, input))
+}
+
+func FindTrafficMirrorSessions(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTrafficMirrorSessionsInput) ([]*ec2.TrafficMirrorSession, error) {
+	return tfresource.FindPtrs(ctx, trafficMirrorSessionsFinder(conn, input))
+}
+
+func trafficMirrorSessionsFinder(conn *ec2.EC2, input *ec2.DescribeTrafficMirrorSessionsInput) tfresource.Finder[*ec2.TrafficMirrorSession] {
+	return tfresource.Finder[*ec2.TrafficMirrorSession]{
+		List: tfresource.ListPages(conn.DescribeTrafficMirrorSessionsPagesWithContext, input, func(page *ec2.DescribeTrafficMirrorSessionsOutput) []*ec2.TrafficMirrorSession {
+			return page.TrafficMirrorSessions
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidTrafficMirrorSessionIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindTrafficMirrorTarget(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTrafficMirrorTargetsInput) (*ec2.TrafficMirrorTarget, error) {
+	return tfresource.FindSinglePtr(ctx, trafficMirrorTargetsFinder(conn, input))
+}
+
+func FindTrafficMirrorTargets(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTrafficMirrorTargetsInput) ([]*ec2.TrafficMirrorTarget, error) {
+	return tfresource.FindPtrs(ctx, trafficMirrorTargetsFinder(conn, input))
+}
+
+func trafficMirrorTargetsFinder(conn *ec2.EC2, input *ec2.DescribeTrafficMirrorTargetsInput) tfresource.Finder[*ec2.TrafficMirrorTarget] {
+	return tfresource.Finder[*ec2.TrafficMirrorTarget]{
+		List: tfresource.ListPages(conn.DescribeTrafficMirrorTargetsPagesWithContext, input, func(page *ec2.DescribeTrafficMirrorTargetsOutput) []*ec2.TrafficMirrorTarget {
+			return page.TrafficMirrorTargets
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidTrafficMirrorTargetIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindTransitGateway(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTransitGatewaysInput) (*ec2.TransitGateway, error) {
+	output, err := tfresource.FindSinglePtr(ctx, transitGatewaysFinder(conn, input))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Options == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindTransitGateways(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTransitGatewaysInput) ([]*ec2.TransitGateway, error) {
+	return tfresource.FindPtrs(ctx, transitGatewaysFinder(conn, input))
+}
+
+func transitGatewaysFinder(conn *ec2.EC2, input *ec2.DescribeTransitGatewaysInput) tfresource.Finder[*ec2.TransitGateway] {
+	return tfresource.Finder[*ec2.TransitGateway]{
+		List: tfresource.ListPages(conn.DescribeTransitGatewaysPagesWithContext, input, func(page *ec2.DescribeTransitGatewaysOutput) []*ec2.TransitGateway {
+			return page.TransitGateways
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidTransitGatewayIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindTransitGatewayAttachment(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTransitGatewayAttachmentsInput) (*ec2.TransitGatewayAttachment, error) {
+	return tfresource.FindSinglePtr(ctx, transitGatewayAttachmentsFinder(conn, input))
+}
+
+func FindTransitGatewayAttachments(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTransitGatewayAttachmentsInput) ([]*ec2.TransitGatewayAttachment, error) {
+	return tfresource.FindPtrs(ctx, transitGatewayAttachmentsFinder(conn, input))
+}
+
+func transitGatewayAttachmentsFinder(conn *ec2.EC2, input *ec2.DescribeTransitGatewayAttachmentsInput) tfresource.Finder[*ec2.TransitGatewayAttachment] {
+	return tfresource.Finder[*ec2.TransitGatewayAttachment]{
+		List: tfresource.ListPages(conn.DescribeTransitGatewayAttachmentsPagesWithContext, input, func(page *ec2.DescribeTransitGatewayAttachmentsOutput) []*ec2.TransitGatewayAttachment {
+			return page.TransitGatewayAttachments
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidTransitGatewayAttachmentIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindTransitGatewayConnect(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTransitGatewayConnectsInput) (*ec2.TransitGatewayConnect, error) {
+	output, err := tfresource.FindSinglePtr(ctx, transitGatewayConnectsFinder(conn, input))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Options == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindTransitGatewayConnects(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTransitGatewayConnectsInput) ([]*ec2.TransitGatewayConnect, error) {
+	return tfresource.FindPtrs(ctx, transitGatewayConnectsFinder(conn, input))
+}
+
+func transitGatewayConnectsFinder(conn *ec2.EC2, input *ec2.DescribeTransitGatewayConnectsInput) tfresource.Finder[*ec2.TransitGatewayConnect] {
+	return tfresource.Finder[*ec2.TransitGatewayConnect]{
+		List: tfresource.ListPages(conn.DescribeTransitGatewayConnectsPagesWithContext, input, func(page *ec2.DescribeTransitGatewayConnectsOutput) []*ec2.TransitGatewayConnect {
+			return page.TransitGatewayConnects
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidTransitGatewayAttachmentIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindTransitGatewayMulticastDomain(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTransitGatewayMulticastDomainsInput) (*ec2.TransitGatewayMulticastDomain, error) {
+	output, err := tfresource.FindSinglePtr(ctx, transitGatewayMulticastDomainsFinder(conn, input))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Options == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindTransitGatewayMulticastDomains(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTransitGatewayMulticastDomainsInput) ([]*ec2.TransitGatewayMulticastDomain, error) {
+	return tfresource.FindPtrs(ctx, transitGatewayMulticastDomainsFinder(conn, input))
+}
+
+func transitGatewayMulticastDomainsFinder(conn *ec2.EC2, input *ec2.DescribeTransitGatewayMulticastDomainsInput) tfresource.Finder[*ec2.TransitGatewayMulticastDomain] {
+	return tfresource.Finder[*ec2.TransitGatewayMulticastDomain]{
+		List: tfresource.ListPages(conn.DescribeTransitGatewayMulticastDomainsPagesWithContext, input, func(page *ec2.DescribeTransitGatewayMulticastDomainsOutput) []*ec2.TransitGatewayMulticastDomain {
+			return page.TransitGatewayMulticastDomains
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidTransitGatewayMulticastDomainIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindTransitGatewayMulticastDomainAssociation(ctx context.Context, conn *ec2.EC2, input *ec2.GetTransitGatewayMulticastDomainAssociationsInput) (*ec2.TransitGatewayMulticastDomainAssociation, error) {
+	output, err := tfresource.FindSinglePtr(ctx, transitGatewayMulticastDomainAssociationsFinder(conn, input))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Subnet == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindTransitGatewayMulticastDomainAssociations(ctx context.Context, conn *ec2.EC2, input *ec2.GetTransitGatewayMulticastDomainAssociationsInput) ([]*ec2.TransitGatewayMulticastDomainAssociation, error) {
+	return tfresource.FindPtrs(ctx, transitGatewayMulticastDomainAssociationsFinder(conn, input))
+}
+
+func transitGatewayMulticastDomainAssociationsFinder(conn *ec2.EC2, input *ec2.GetTransitGatewayMulticastDomainAssociationsInput) tfresource.Finder[*ec2.TransitGatewayMulticastDomainAssociation] {
+	return tfresource.Finder[*ec2.TransitGatewayMulticastDomainAssociation]{
+		List: tfresource.ListPages(conn.GetTransitGatewayMulticastDomainAssociationsPagesWithContext, input, func(page *ec2.GetTransitGatewayMulticastDomainAssociationsOutput) []*ec2.TransitGatewayMulticastDomainAssociation {
+			return page.MulticastDomainAssociations
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidTransitGatewayMulticastDomainIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindTransitGatewayPeeringAttachment(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTransitGatewayPeeringAttachmentsInput) (*ec2.TransitGatewayPeeringAttachment, error) {
+	output, err := tfresource.FindSinglePtr(ctx, transitGatewayPeeringAttachmentsFinder(conn, input))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.AccepterTgwInfo == nil || output.RequesterTgwInfo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindTransitGatewayPeeringAttachments(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTransitGatewayPeeringAttachmentsInput) ([]*ec2.TransitGatewayPeeringAttachment, error) {
+	return tfresource.FindPtrs(ctx, transitGatewayPeeringAttachmentsFinder(conn, input))
+}
+
+func transitGatewayPeeringAttachmentsFinder(conn *ec2.EC2, input *ec2.DescribeTransitGatewayPeeringAttachmentsInput) tfresource.Finder[*ec2.TransitGatewayPeeringAttachment] {
+	return tfresource.Finder[*ec2.TransitGatewayPeeringAttachment]{
+		List: tfresource.ListPages(conn.DescribeTransitGatewayPeeringAttachmentsPagesWithContext, input, func(page *ec2.DescribeTransitGatewayPeeringAttachmentsOutput) []*ec2.TransitGatewayPeeringAttachment {
+			return page.TransitGatewayPeeringAttachments
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidTransitGatewayAttachmentIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindTransitGatewayPolicyTable(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTransitGatewayPolicyTablesInput) (*ec2.TransitGatewayPolicyTable, error) {
+	return tfresource.FindSinglePtr(ctx, transitGatewayPolicyTablesFinder(conn, input))
+}
+
+func FindTransitGatewayPolicyTables(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTransitGatewayPolicyTablesInput) ([]*ec2.TransitGatewayPolicyTable, error) {
+	return tfresource.FindPtrs(ctx, transitGatewayPolicyTablesFinder(conn, input))
+}
+
+func transitGatewayPolicyTablesFinder(conn *ec2.EC2, input *ec2.DescribeTransitGatewayPolicyTablesInput) tfresource.Finder[*ec2.TransitGatewayPolicyTable] {
+	return tfresource.Finder[*ec2.TransitGatewayPolicyTable]{
+		List: tfresource.ListPages(conn.DescribeTransitGatewayPolicyTablesPagesWithContext, input, func(page *ec2.DescribeTransitGatewayPolicyTablesOutput) []*ec2.TransitGatewayPolicyTable {
+			return page.TransitGatewayPolicyTables
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidTransitGatewayPolicyTableIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindTransitGatewayPolicyTableAssociation(ctx context.Context, conn *ec2.EC2, input *ec2.GetTransitGatewayPolicyTableAssociationsInput) (*ec2.TransitGatewayPolicyTableAssociation, error) {
+	return tfresource.FindSinglePtr(ctx, transitGatewayPolicyTableAssociationsFinder(conn, input))
+}
+
+func FindTransitGatewayPolicyTableAssociations(ctx context.Context, conn *ec2.EC2, input *ec2.GetTransitGatewayPolicyTableAssociationsInput) ([]*ec2.TransitGatewayPolicyTableAssociation, error) {
+	return tfresource.FindPtrs(ctx, transitGatewayPolicyTableAssociationsFinder(conn, input))
+}
+
+func transitGatewayPolicyTableAssociationsFinder(conn *ec2.EC2, input *ec2.GetTransitGatewayPolicyTableAssociationsInput) tfresource.Finder[*ec2.TransitGatewayPolicyTableAssociation] {
+	return tfresource.Finder[*ec2.TransitGatewayPolicyTableAssociation]{
+		List: tfresource.ListPages(conn.GetTransitGatewayPolicyTableAssociationsPagesWithContext, input, func(page *ec2.GetTransitGatewayPolicyTableAssociationsOutput) []*ec2.TransitGatewayPolicyTableAssociation {
+			return page.Associations
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidTransitGatewayPolicyTableIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindTransitGatewayPrefixListReference(ctx context.Context, conn *ec2.EC2, input *ec2.GetTransitGatewayPrefixListReferencesInput) (*ec2.TransitGatewayPrefixListReference, error) {
+	return tfresource.FindSinglePtr(ctx, transitGatewayPrefixListReferencesFinder(conn, input))
+}
+
+func FindTransitGatewayPrefixListReferences(ctx context.Context, conn *ec2.EC2, input *ec2.GetTransitGatewayPrefixListReferencesInput) ([]*ec2.TransitGatewayPrefixListReference, error) {
+	return tfresource.FindPtrs(ctx, transitGatewayPrefixListReferencesFinder(conn, input))
+}
+
+func transitGatewayPrefixListReferencesFinder(conn *ec2.EC2, input *ec2.GetTransitGatewayPrefixListReferencesInput) tfresource.Finder[*ec2.TransitGatewayPrefixListReference] {
+	return tfresource.Finder[*ec2.TransitGatewayPrefixListReference]{
+		List: tfresource.ListPages(conn.GetTransitGatewayPrefixListReferencesPagesWithContext, input, func(page *ec2.GetTransitGatewayPrefixListReferencesOutput) []*ec2.TransitGatewayPrefixListReference {
+			return page.TransitGatewayPrefixListReferences
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidRouteTableIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindTransitGatewayRouteTable(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTransitGatewayRouteTablesInput) (*ec2.TransitGatewayRouteTable, error) {
+	return tfresource.FindSinglePtr(ctx, transitGatewayRouteTablesFinder(conn, input))
+}
+
+func FindTransitGatewayRouteTables(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTransitGatewayRouteTablesInput) ([]*ec2.TransitGatewayRouteTable, error) {
+	return tfresource.FindPtrs(ctx, transitGatewayRouteTablesFinder(conn, input))
+}
+
+func transitGatewayRouteTablesFinder(conn *ec2.EC2, input *ec2.DescribeTransitGatewayRouteTablesInput) tfresource.Finder[*ec2.TransitGatewayRouteTable] {
+	return tfresource.Finder[*ec2.TransitGatewayRouteTable]{
+		List: tfresource.ListPages(conn.DescribeTransitGatewayRouteTablesPagesWithContext, input, func(page *ec2.DescribeTransitGatewayRouteTablesOutput) []*ec2.TransitGatewayRouteTable {
+			return page.TransitGatewayRouteTables
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidRouteTableIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindTransitGatewayRouteTableAssociation(ctx context.Context, conn *ec2.EC2, input *ec2.GetTransitGatewayRouteTableAssociationsInput) (*ec2.TransitGatewayRouteTableAssociation, error) {
+	return tfresource.FindSinglePtr(ctx, transitGatewayRouteTableAssociationsFinder(conn, input))
+}
+
+func FindTransitGatewayRouteTableAssociations(ctx context.Context, conn *ec2.EC2, input *ec2.GetTransitGatewayRouteTableAssociationsInput) ([]*ec2.TransitGatewayRouteTableAssociation, error) {
+	return tfresource.FindPtrs(ctx, transitGatewayRouteTableAssociationsFinder(conn, input))
+}
+
+func transitGatewayRouteTableAssociationsFinder(conn *ec2.EC2, input *ec2.GetTransitGatewayRouteTableAssociationsInput) tfresource.Finder[*ec2.TransitGatewayRouteTableAssociation] {
+	return tfresource.Finder[*ec2.TransitGatewayRouteTableAssociation]{
+		List: tfresource.ListPages(conn.GetTransitGatewayRouteTableAssociationsPagesWithContext, input, func(page *ec2.GetTransitGatewayRouteTableAssociationsOutput) []*ec2.TransitGatewayRouteTableAssociation {
+			return page.Associations
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidRouteTableIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindTransitGatewayRouteTablePropagation(ctx context.Context, conn *ec2.EC2, input *ec2.GetTransitGatewayRouteTablePropagationsInput) (*ec2.TransitGatewayRouteTablePropagation, error) {
+	return tfresource.FindSinglePtr(ctx, transitGatewayRouteTablePropagationsFinder(conn, input))
+}
+
+func FindTransitGatewayRouteTablePropagations(ctx context.Context, conn *ec2.EC2, input *ec2.GetTransitGatewayRouteTablePropagationsInput) ([]*ec2.TransitGatewayRouteTablePropagation, error) {
+	return tfresource.FindPtrs(ctx, transitGatewayRouteTablePropagationsFinder(conn, input))
+}
+
+func transitGatewayRouteTablePropagationsFinder(conn *ec2.EC2, input *ec2.GetTransitGatewayRouteTablePropagationsInput) tfresource.Finder[*ec2.TransitGatewayRouteTablePropagation] {
+	return tfresource.Finder[*ec2.TransitGatewayRouteTablePropagation]{
+		List: tfresource.ListPages(conn.GetTransitGatewayRouteTablePropagationsPagesWithContext, input, func(page *ec2.GetTransitGatewayRouteTablePropagationsOutput) []*ec2.TransitGatewayRouteTablePropagation {
+			return page.TransitGatewayRouteTablePropagations
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidRouteTableIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindTransitGatewayVPCAttachment(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTransitGatewayVpcAttachmentsInput) (*ec2.TransitGatewayVpcAttachment, error) {
+	output, err := tfresource.FindSinglePtr(ctx, transitGatewayVPCAttachmentsFinder(conn, input))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Options == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindTransitGatewayVPCAttachments(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTransitGatewayVpcAttachmentsInput) ([]*ec2.TransitGatewayVpcAttachment, error) {
+	return tfresource.FindPtrs(ctx, transitGatewayVPCAttachmentsFinder(conn, input))
+}
+
+func transitGatewayVPCAttachmentsFinder(conn *ec2.EC2, input *ec2.DescribeTransitGatewayVpcAttachmentsInput) tfresource.Finder[*ec2.TransitGatewayVpcAttachment] {
+	return tfresource.Finder[*ec2.TransitGatewayVpcAttachment]{
+		List: tfresource.ListPages(conn.DescribeTransitGatewayVpcAttachmentsPagesWithContext, input, func(page *ec2.DescribeTransitGatewayVpcAttachmentsOutput) []*ec2.TransitGatewayVpcAttachment {
+			return page.TransitGatewayVpcAttachments
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidTransitGatewayAttachmentIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindVPC(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVpcsInput) (*ec2.Vpc, error) {
+	return tfresource.FindSinglePtr(ctx, vpcsFinder(conn, input))
+}
+
+func FindVPCs(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVpcsInput) ([]*ec2.Vpc, error) {
+	return tfresource.FindPtrs(ctx, vpcsFinder(conn, input))
+}
+
+func vpcsFinder(conn *ec2.EC2, input *ec2.DescribeVpcsInput) tfresource.Finder[*ec2.Vpc] {
+	return tfresource.Finder[*ec2.Vpc]{
+		List: tfresource.ListPages(conn.DescribeVpcsPagesWithContext, input, func(page *ec2.DescribeVpcsOutput) []*ec2.Vpc {
+			return page.Vpcs
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidVPCIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindVPCConnectionNotification(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVpcEndpointConnectionNotificationsInput) (*ec2.ConnectionNotification, error) {
+	return tfresource.FindSinglePtr(ctx, vpcConnectionNotificationsFinder(conn, input))
+}
+
+func FindVPCConnectionNotifications(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVpcEndpointConnectionNotificationsInput) ([]*ec2.ConnectionNotification, error) {
+	return tfresource.FindPtrs(ctx, vpcConnectionNotificationsFinder(conn, input))
+}
+
+func vpcConnectionNotificationsFinder(conn *ec2.EC2, input *ec2.DescribeVpcEndpointConnectionNotificationsInput) tfresource.Finder[*ec2.ConnectionNotification] {
+	return tfresource.Finder[*ec2.ConnectionNotification]{
+		List: tfresource.ListPages(conn.DescribeVpcEndpointConnectionNotificationsPagesWithContext, input, func(page *ec2.DescribeVpcEndpointConnectionNotificationsOutput) []*ec2.ConnectionNotification {
+			return page.ConnectionNotificationSet
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidConnectionNotification),
+		LastRequest: input,
+	}
+}
+
+func FindVPCEndpoint(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVpcEndpointsInput) (*ec2.VpcEndpoint, error) {
+	return tfresource.FindSinglePtr(ctx, vpcEndpointsFinder(conn, input))
+}
+
+func FindVPCEndpoints(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVpcEndpointsInput) ([]*ec2.VpcEndpoint, error) {
+	return tfresource.FindPtrs(ctx, vpcEndpointsFinder(conn, input))
+}
+
+func vpcEndpointsFinder(conn *ec2.EC2, input *ec2.DescribeVpcEndpointsInput) tfresource.Finder[*ec2.VpcEndpoint] {
+	return tfresource.Finder[*ec2.VpcEndpoint]{
+		List: tfresource.ListPages(conn.DescribeVpcEndpointsPagesWithContext, input, func(page *ec2.DescribeVpcEndpointsOutput) []*ec2.VpcEndpoint {
+			return page.VpcEndpoints
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidVPCEndpointIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindVPCEndpointServiceConfiguration(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVpcEndpointServiceConfigurationsInput) (*ec2.ServiceConfiguration, error) {
+	return tfresource.FindSinglePtr(ctx, vpcEndpointServiceConfigurationsFinder(conn, input))
+}
+
+func FindVPCEndpointServiceConfigurations(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVpcEndpointServiceConfigurationsInput) ([]*ec2.ServiceConfiguration, error) {
+	return tfresource.FindPtrs(ctx, vpcEndpointServiceConfigurationsFinder(conn, input))
+}
+
+func vpcEndpointServiceConfigurationsFinder(conn *ec2.EC2, input *ec2.DescribeVpcEndpointServiceConfigurationsInput) tfresource.Finder[*ec2.ServiceConfiguration] {
+	return tfresource.Finder[*ec2.ServiceConfiguration]{
+		List: tfresource.ListPages(conn.DescribeVpcEndpointServiceConfigurationsPagesWithContext, input, func(page *ec2.DescribeVpcEndpointServiceConfigurationsOutput) []*ec2.ServiceConfiguration {
+			return page.ServiceConfigurations
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidVPCEndpointServiceIdNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindVPCPeeringConnection(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVpcPeeringConnectionsInput) (*ec2.VpcPeeringConnection, error) {
+	output, err := tfresource.FindSinglePtr(ctx, vpcPeeringConnectionsFinder(conn, input))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Status == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindVPCPeeringConnections(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVpcPeeringConnectionsInput) ([]*ec2.VpcPeeringConnection, error) {
+	return tfresource.FindPtrs(ctx, vpcPeeringConnectionsFinder(conn, input))
+}
+
+func vpcPeeringConnectionsFinder(conn *ec2.EC2, input *ec2.DescribeVpcPeeringConnectionsInput) tfresource.Finder[*ec2.VpcPeeringConnection] {
+	return tfresource.Finder[*ec2.VpcPeeringConnection]{
+		List: tfresource.ListPages(conn.DescribeVpcPeeringConnectionsPagesWithContext, input, func(page *ec2.DescribeVpcPeeringConnectionsOutput) []*ec2.VpcPeeringConnection {
+			return page.VpcPeeringConnections
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidVPCPeeringConnectionIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindVPNConnection(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVpnConnectionsInput) (*ec2.VpnConnection, error) {
+	return tfresource.FindSinglePtr(ctx, vpnConnectionsFinder(conn, input))
+}
+
+func FindVPNConnections(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVpnConnectionsInput) ([]*ec2.VpnConnection, error) {
+	return tfresource.FindPtrs(ctx, vpnConnectionsFinder(conn, input))
+}
+
+func vpnConnectionsFinder(conn *ec2.EC2, input *ec2.DescribeVpnConnectionsInput) tfresource.Finder[*ec2.VpnConnection] {
+	return tfresource.Finder[*ec2.VpnConnection]{
+		List: tfresource.ListOnce(conn.DescribeVpnConnectionsWithContext, input, func(page *ec2.DescribeVpnConnectionsOutput) []*ec2.VpnConnection {
+			return page.VpnConnections
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidVPNConnectionIDNotFound),
+		LastRequest: input,
+	}
+}
+
+func FindVolumeModification(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVolumesModificationsInput) (*ec2.VolumeModification, error) {
+	return tfresource.FindSinglePtr(ctx, volumeModificationsFinder(conn, input))
+}
+
+func FindVolumeModifications(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVolumesModificationsInput) ([]*ec2.VolumeModification, error) {
+	return tfresource.FindPtrs(ctx, volumeModificationsFinder(conn, input))
+}
+
+func volumeModificationsFinder(conn *ec2.EC2, input *ec2.DescribeVolumesModificationsInput) tfresource.Finder[*ec2.VolumeModification] {
+	return tfresource.Finder[*ec2.VolumeModification]{
+		List: tfresource.ListPages(conn.DescribeVolumesModificationsPagesWithContext, input, func(page *ec2.DescribeVolumesModificationsOutput) []*ec2.VolumeModification {
+			return page.VolumesModifications
+		}),
+		NotFound:    tfresource.NotFoundErrCodes(errCodeInvalidVolumeNotFound),
+		LastRequest: input,
+	}
+}
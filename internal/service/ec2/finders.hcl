@@ -0,0 +1,583 @@
+finder "AvailabilityZone" {
+  operation    = "DescribeAvailabilityZones"
+  output_field = "AvailabilityZones"
+  item_type    = "AvailabilityZone"
+}
+
+finder "COIPPool" {
+  operation             = "DescribeCoipPools"
+  paginated             = true
+  output_field          = "CoipPools"
+  item_type             = "CoipPool"
+  not_found_error_codes = ["errCodeInvalidPoolIDNotFound"]
+}
+
+finder "CapacityReservation" {
+  operation             = "DescribeCapacityReservations"
+  paginated             = true
+  output_field          = "CapacityReservations"
+  item_type             = "CapacityReservation"
+  not_found_error_codes = ["errCodeInvalidCapacityReservationIdNotFound"]
+}
+
+finder "CarrierGateway" {
+  operation             = "DescribeCarrierGateways"
+  paginated             = true
+  output_field          = "CarrierGateways"
+  item_type             = "CarrierGateway"
+  not_found_error_codes = ["errCodeInvalidCarrierGatewayIDNotFound"]
+}
+
+finder "ClientVPNAuthorizationRule" {
+  operation             = "DescribeClientVpnAuthorizationRules"
+  paginated             = true
+  output_field          = "AuthorizationRules"
+  item_type             = "AuthorizationRule"
+  not_found_error_codes = ["errCodeInvalidClientVPNEndpointIdNotFound"]
+  required_fields       = ["Status"]
+}
+
+finder "ClientVPNEndpoint" {
+  operation             = "DescribeClientVpnEndpoints"
+  paginated             = true
+  output_field          = "ClientVpnEndpoints"
+  item_type             = "ClientVpnEndpoint"
+  not_found_error_codes = ["errCodeInvalidClientVPNEndpointIdNotFound"]
+  required_fields       = ["Status"]
+}
+
+finder "ClientVPNNetworkAssociation" {
+  operation             = "DescribeClientVpnTargetNetworks"
+  paginated             = true
+  output_field          = "ClientVpnTargetNetworks"
+  item_type             = "TargetNetwork"
+  not_found_error_codes = ["errCodeInvalidClientVPNEndpointIdNotFound", "errCodeInvalidClientVPNAssociationIdNotFound"]
+  required_fields       = ["Status"]
+}
+
+finder "ClientVPNRoute" {
+  operation             = "DescribeClientVpnRoutes"
+  paginated             = true
+  output_field          = "Routes"
+  item_type             = "ClientVpnRoute"
+  not_found_error_codes = ["errCodeInvalidClientVPNEndpointIdNotFound"]
+  required_fields       = ["Status"]
+}
+
+finder "CustomerGateway" {
+  operation             = "DescribeCustomerGateways"
+  output_field          = "CustomerGateways"
+  item_type             = "CustomerGateway"
+  not_found_error_codes = ["errCodeInvalidCustomerGatewayIDNotFound"]
+}
+
+finder "DHCPOptions" {
+  operation             = "DescribeDhcpOptions"
+  paginated             = true
+  output_field          = "DhcpOptions"
+  item_type             = "DhcpOptions"
+  plural                = "DHCPOptionses"
+  not_found_error_codes = ["errCodeInvalidDHCPOptionIDNotFound"]
+}
+
+finder "EBSVolume" {
+  operation             = "DescribeVolumes"
+  paginated             = true
+  output_field          = "Volumes"
+  item_type             = "Volume"
+  not_found_error_codes = ["errCodeInvalidVolumeNotFound"]
+}
+
+finder "EgressOnlyInternetGateway" {
+  operation    = "DescribeEgressOnlyInternetGateways"
+  paginated    = true
+  output_field = "EgressOnlyInternetGateways"
+  item_type    = "EgressOnlyInternetGateway"
+}
+
+finder "Fleet" {
+  operation             = "DescribeFleets"
+  paginated             = true
+  output_field          = "Fleets"
+  item_type             = "FleetData"
+  not_found_error_codes = ["errCodeInvalidFleetIdNotFound"]
+}
+
+finder "FlowLog" {
+  operation    = "DescribeFlowLogs"
+  paginated    = true
+  output_field = "FlowLogs"
+  item_type    = "FlowLog"
+}
+
+finder "Host" {
+  operation             = "DescribeHosts"
+  paginated             = true
+  output_field          = "Hosts"
+  item_type             = "Host"
+  not_found_error_codes = ["errCodeInvalidHostIDNotFound"]
+  required_fields       = ["HostProperties"]
+}
+
+finder "IPAM" {
+  operation             = "DescribeIpams"
+  paginated             = true
+  output_field          = "Ipams"
+  item_type             = "Ipam"
+  not_found_error_codes = ["errCodeInvalidIPAMIdNotFound"]
+}
+
+finder "IPAMPool" {
+  operation             = "DescribeIpamPools"
+  paginated             = true
+  output_field          = "IpamPools"
+  item_type             = "IpamPool"
+  not_found_error_codes = ["errCodeInvalidIPAMPoolIdNotFound"]
+}
+
+finder "IPAMPoolAllocation" {
+  operation             = "GetIpamPoolAllocations"
+  paginated             = true
+  output_field          = "IpamPoolAllocations"
+  item_type             = "IpamPoolAllocation"
+  not_found_error_codes = ["errCodeInvalidIPAMPoolAllocationIdNotFound", "errCodeInvalidIPAMPoolIdNotFound"]
+}
+
+finder "IPAMPoolCIDR" {
+  operation             = "GetIpamPoolCidrs"
+  paginated             = true
+  output_field          = "IpamPoolCidrs"
+  item_type             = "IpamPoolCidr"
+  not_found_error_codes = ["errCodeInvalidIPAMPoolIdNotFound"]
+}
+
+finder "IPAMResourceDiscovery" {
+  operation             = "DescribeIpamResourceDiscoveries"
+  paginated             = true
+  output_field          = "IpamResourceDiscoveries"
+  item_type             = "IpamResourceDiscovery"
+  plural                = "IPAMResourceDiscoveries"
+  not_found_error_codes = ["errCodeInvalidIPAMResourceDiscoveryIdNotFound"]
+}
+
+finder "IPAMResourceDiscoveryAssociation" {
+  operation             = "DescribeIpamResourceDiscoveryAssociations"
+  paginated             = true
+  output_field          = "IpamResourceDiscoveryAssociations"
+  item_type             = "IpamResourceDiscoveryAssociation"
+  not_found_error_codes = ["errCodeInvalidIPAMResourceDiscoveryAssociationIdNotFound"]
+}
+
+finder "IPAMScope" {
+  operation             = "DescribeIpamScopes"
+  paginated             = true
+  output_field          = "IpamScopes"
+  item_type             = "IpamScope"
+  not_found_error_codes = ["errCodeInvalidIPAMScopeIdNotFound"]
+}
+
+finder "Image" {
+  operation             = "DescribeImages"
+  paginated             = true
+  output_field          = "Images"
+  item_type             = "Image"
+  not_found_error_codes = ["errCodeInvalidAMIIDNotFound"]
+}
+
+finder "InstanceConnectEndpoint" {
+  sdk_version           = 2
+  operation             = "DescribeInstanceConnectEndpoints"
+  paginated             = true
+  output_field          = "InstanceConnectEndpoints"
+  item_type             = "Ec2InstanceConnectEndpoint"
+  not_found_error_codes = ["errCodeInvalidInstanceConnectEndpointIdNotFound"]
+}
+
+finder "InstanceCreditSpecification" {
+  operation             = "DescribeInstanceCreditSpecifications"
+  paginated             = true
+  output_field          = "InstanceCreditSpecifications"
+  item_type             = "InstanceCreditSpecification"
+  not_found_error_codes = ["errCodeInvalidInstanceIDNotFound"]
+}
+
+finder "InstanceType" {
+  operation    = "DescribeInstanceTypes"
+  paginated    = true
+  output_field = "InstanceTypes"
+  item_type    = "InstanceTypeInfo"
+}
+
+finder "InternetGateway" {
+  operation             = "DescribeInternetGateways"
+  paginated             = true
+  output_field          = "InternetGateways"
+  item_type             = "InternetGateway"
+  not_found_error_codes = ["errCodeInvalidInternetGatewayIDNotFound"]
+}
+
+finder "KeyPair" {
+  operation             = "DescribeKeyPairs"
+  output_field          = "KeyPairs"
+  item_type             = "KeyPairInfo"
+  not_found_error_codes = ["errCodeInvalidKeyPairNotFound"]
+}
+
+finder "LaunchTemplate" {
+  operation             = "DescribeLaunchTemplates"
+  paginated             = true
+  output_field          = "LaunchTemplates"
+  item_type             = "LaunchTemplate"
+  not_found_error_codes = ["errCodeInvalidLaunchTemplateIdMalformed", "errCodeInvalidLaunchTemplateIdNotFound", "errCodeInvalidLaunchTemplateNameNotFoundException"]
+}
+
+finder "LaunchTemplateVersion" {
+  operation             = "DescribeLaunchTemplateVersions"
+  paginated             = true
+  output_field          = "LaunchTemplateVersions"
+  item_type             = "LaunchTemplateVersion"
+  not_found_error_codes = ["errCodeInvalidLaunchTemplateIdNotFound", "errCodeInvalidLaunchTemplateNameNotFoundException", "errCodeInvalidLaunchTemplateIdVersionNotFound"]
+  required_fields       = ["LaunchTemplateData"]
+}
+
+finder "LocalGateway" {
+  operation    = "DescribeLocalGateways"
+  paginated    = true
+  output_field = "LocalGateways"
+  item_type    = "LocalGateway"
+}
+
+finder "LocalGatewayRouteTable" {
+  operation    = "DescribeLocalGatewayRouteTables"
+  paginated    = true
+  output_field = "LocalGatewayRouteTables"
+  item_type    = "LocalGatewayRouteTable"
+}
+
+finder "LocalGatewayVirtualInterfaceGroup" {
+  operation    = "DescribeLocalGatewayVirtualInterfaceGroups"
+  paginated    = true
+  output_field = "LocalGatewayVirtualInterfaceGroups"
+  item_type    = "LocalGatewayVirtualInterfaceGroup"
+}
+
+finder "ManagedPrefixList" {
+  operation             = "DescribeManagedPrefixLists"
+  paginated             = true
+  output_field          = "PrefixLists"
+  item_type             = "ManagedPrefixList"
+  not_found_error_codes = ["errCodeInvalidPrefixListIDNotFound"]
+}
+
+finder "NATGateway" {
+  operation             = "DescribeNatGateways"
+  paginated             = true
+  output_field          = "NatGateways"
+  item_type             = "NatGateway"
+  not_found_error_codes = ["errCodeNatGatewayNotFound"]
+}
+
+finder "NetworkACL" {
+  operation             = "DescribeNetworkAcls"
+  paginated             = true
+  output_field          = "NetworkAcls"
+  item_type             = "NetworkAcl"
+  not_found_error_codes = ["errCodeInvalidNetworkACLIDNotFound"]
+}
+
+finder "NetworkInsightsAnalysis" {
+  operation             = "DescribeNetworkInsightsAnalyses"
+  paginated             = true
+  output_field          = "NetworkInsightsAnalyses"
+  item_type             = "NetworkInsightsAnalysis"
+  plural                = "NetworkInsightsAnalyses"
+  not_found_error_codes = ["errCodeInvalidNetworkInsightsAnalysisIdNotFound"]
+}
+
+finder "NetworkInsightsPath" {
+  operation             = "DescribeNetworkInsightsPaths"
+  paginated             = true
+  output_field          = "NetworkInsightsPaths"
+  item_type             = "NetworkInsightsPath"
+  not_found_error_codes = ["errCodeInvalidNetworkInsightsPathIdNotFound"]
+}
+
+finder "NetworkInterface" {
+  operation             = "DescribeNetworkInterfaces"
+  paginated             = true
+  output_field          = "NetworkInterfaces"
+  item_type             = "NetworkInterface"
+  not_found_error_codes = ["errCodeInvalidNetworkInterfaceIDNotFound"]
+}
+
+finder "PrefixList" {
+  operation             = "DescribePrefixLists"
+  paginated             = true
+  output_field          = "PrefixLists"
+  item_type             = "PrefixList"
+  not_found_error_codes = ["errCodeInvalidPrefixListIdNotFound"]
+}
+
+finder "PublicIPv4Pool" {
+  operation             = "DescribePublicIpv4Pools"
+  paginated             = true
+  output_field          = "PublicIpv4Pools"
+  item_type             = "PublicIpv4Pool"
+  not_found_error_codes = ["errCodeInvalidPublicIpv4PoolIDNotFound"]
+}
+
+finder "RouteTable" {
+  operation             = "DescribeRouteTables"
+  paginated             = true
+  output_field          = "RouteTables"
+  item_type             = "RouteTable"
+  not_found_error_codes = ["errCodeInvalidRouteTableIDNotFound"]
+}
+
+finder "SecurityGroup" {
+  operation             = "DescribeSecurityGroups"
+  paginated             = true
+  output_field          = "SecurityGroups"
+  item_type             = "SecurityGroup"
+  not_found_error_codes = ["errCodeInvalidGroupNotFound", "errCodeInvalidSecurityGroupIDNotFound"]
+}
+
+finder "SecurityGroupRule" {
+  operation             = "DescribeSecurityGroupRules"
+  paginated             = true
+  output_field          = "SecurityGroupRules"
+  item_type             = "SecurityGroupRule"
+  not_found_error_codes = ["errCodeInvalidSecurityGroupRuleIdNotFound"]
+}
+
+finder "Snapshot" {
+  operation             = "DescribeSnapshots"
+  paginated             = true
+  output_field          = "Snapshots"
+  item_type             = "Snapshot"
+  not_found_error_codes = ["errCodeInvalidSnapshotNotFound"]
+}
+
+finder "SnapshotTierStatus" {
+  operation    = "DescribeSnapshotTierStatus"
+  paginated    = true
+  output_field = "SnapshotTierStatuses"
+  item_type    = "SnapshotTierStatus"
+  plural       = "SnapshotTierStatuses"
+}
+
+finder "SpotFleetRequest" {
+  operation             = "DescribeSpotFleetRequests"
+  paginated             = true
+  output_field          = "SpotFleetRequestConfigs"
+  item_type             = "SpotFleetRequestConfig"
+  not_found_error_codes = ["errCodeInvalidSpotFleetRequestIdNotFound"]
+  required_fields       = ["SpotFleetRequestConfig"]
+}
+
+finder "SpotInstanceRequest" {
+  operation             = "DescribeSpotInstanceRequests"
+  paginated             = true
+  output_field          = "SpotInstanceRequests"
+  item_type             = "SpotInstanceRequest"
+  not_found_error_codes = ["errCodeInvalidSpotInstanceRequestIDNotFound"]
+  required_fields       = ["Status"]
+}
+
+finder "Subnet" {
+  operation             = "DescribeSubnets"
+  paginated             = true
+  output_field          = "Subnets"
+  item_type             = "Subnet"
+  not_found_error_codes = ["errCodeInvalidSubnetIDNotFound"]
+}
+
+finder "TrafficMirrorFilter" {
+  operation             = "DescribeTrafficMirrorFilters"
+  paginated             = true
+  output_field          = "TrafficMirrorFilters"
+  item_type             = "TrafficMirrorFilter"
+  not_found_error_codes = ["errCodeInvalidTrafficMirrorFilterIdNotFound"]
+}
+
+finder "TrafficMirrorSession" {
+  operation             = "DescribeTrafficMirrorSessions"
+  paginated             = true
+  output_field          = "TrafficMirrorSessions"
+  item_type             = "TrafficMirrorSession"
+  not_found_error_codes = ["errCodeInvalidTrafficMirrorSessionIdNotFound"]
+}
+
+finder "TrafficMirrorTarget" {
+  operation             = "DescribeTrafficMirrorTargets"
+  paginated             = true
+  output_field          = "TrafficMirrorTargets"
+  item_type             = "TrafficMirrorTarget"
+  not_found_error_codes = ["errCodeInvalidTrafficMirrorTargetIdNotFound"]
+}
+
+finder "TransitGateway" {
+  operation             = "DescribeTransitGateways"
+  paginated             = true
+  output_field          = "TransitGateways"
+  item_type             = "TransitGateway"
+  not_found_error_codes = ["errCodeInvalidTransitGatewayIDNotFound"]
+  required_fields       = ["Options"]
+}
+
+finder "TransitGatewayAttachment" {
+  operation             = "DescribeTransitGatewayAttachments"
+  paginated             = true
+  output_field          = "TransitGatewayAttachments"
+  item_type             = "TransitGatewayAttachment"
+  not_found_error_codes = ["errCodeInvalidTransitGatewayAttachmentIDNotFound"]
+}
+
+finder "TransitGatewayConnect" {
+  operation             = "DescribeTransitGatewayConnects"
+  paginated             = true
+  output_field          = "TransitGatewayConnects"
+  item_type             = "TransitGatewayConnect"
+  not_found_error_codes = ["errCodeInvalidTransitGatewayAttachmentIDNotFound"]
+  required_fields       = ["Options"]
+}
+
+finder "TransitGatewayMulticastDomain" {
+  operation             = "DescribeTransitGatewayMulticastDomains"
+  paginated             = true
+  output_field          = "TransitGatewayMulticastDomains"
+  item_type             = "TransitGatewayMulticastDomain"
+  not_found_error_codes = ["errCodeInvalidTransitGatewayMulticastDomainIdNotFound"]
+  required_fields       = ["Options"]
+}
+
+finder "TransitGatewayMulticastDomainAssociation" {
+  operation             = "GetTransitGatewayMulticastDomainAssociations"
+  paginated             = true
+  output_field          = "MulticastDomainAssociations"
+  item_type             = "TransitGatewayMulticastDomainAssociation"
+  not_found_error_codes = ["errCodeInvalidTransitGatewayMulticastDomainIdNotFound"]
+  required_fields       = ["Subnet"]
+}
+
+finder "TransitGatewayPeeringAttachment" {
+  operation             = "DescribeTransitGatewayPeeringAttachments"
+  paginated             = true
+  output_field          = "TransitGatewayPeeringAttachments"
+  item_type             = "TransitGatewayPeeringAttachment"
+  not_found_error_codes = ["errCodeInvalidTransitGatewayAttachmentIDNotFound"]
+  required_fields       = ["AccepterTgwInfo", "RequesterTgwInfo"]
+}
+
+finder "TransitGatewayPolicyTable" {
+  operation             = "DescribeTransitGatewayPolicyTables"
+  paginated             = true
+  output_field          = "TransitGatewayPolicyTables"
+  item_type             = "TransitGatewayPolicyTable"
+  not_found_error_codes = ["errCodeInvalidTransitGatewayPolicyTableIdNotFound"]
+}
+
+finder "TransitGatewayPolicyTableAssociation" {
+  operation             = "GetTransitGatewayPolicyTableAssociations"
+  paginated             = true
+  output_field          = "Associations"
+  item_type             = "TransitGatewayPolicyTableAssociation"
+  not_found_error_codes = ["errCodeInvalidTransitGatewayPolicyTableIdNotFound"]
+}
+
+finder "TransitGatewayPrefixListReference" {
+  operation             = "GetTransitGatewayPrefixListReferences"
+  paginated             = true
+  output_field          = "TransitGatewayPrefixListReferences"
+  item_type             = "TransitGatewayPrefixListReference"
+  not_found_error_codes = ["errCodeInvalidRouteTableIDNotFound"]
+}
+
+finder "TransitGatewayRouteTable" {
+  operation             = "DescribeTransitGatewayRouteTables"
+  paginated             = true
+  output_field          = "TransitGatewayRouteTables"
+  item_type             = "TransitGatewayRouteTable"
+  not_found_error_codes = ["errCodeInvalidRouteTableIDNotFound"]
+}
+
+finder "TransitGatewayRouteTableAssociation" {
+  operation             = "GetTransitGatewayRouteTableAssociations"
+  paginated             = true
+  output_field          = "Associations"
+  item_type             = "TransitGatewayRouteTableAssociation"
+  not_found_error_codes = ["errCodeInvalidRouteTableIDNotFound"]
+}
+
+finder "TransitGatewayRouteTablePropagation" {
+  operation             = "GetTransitGatewayRouteTablePropagations"
+  paginated             = true
+  output_field          = "TransitGatewayRouteTablePropagations"
+  item_type             = "TransitGatewayRouteTablePropagation"
+  not_found_error_codes = ["errCodeInvalidRouteTableIDNotFound"]
+}
+
+finder "TransitGatewayVPCAttachment" {
+  operation             = "DescribeTransitGatewayVpcAttachments"
+  paginated             = true
+  output_field          = "TransitGatewayVpcAttachments"
+  item_type             = "TransitGatewayVpcAttachment"
+  not_found_error_codes = ["errCodeInvalidTransitGatewayAttachmentIDNotFound"]
+  required_fields       = ["Options"]
+}
+
+finder "VPC" {
+  operation             = "DescribeVpcs"
+  paginated             = true
+  output_field          = "Vpcs"
+  item_type             = "Vpc"
+  not_found_error_codes = ["errCodeInvalidVPCIDNotFound"]
+}
+
+finder "VPCConnectionNotification" {
+  operation             = "DescribeVpcEndpointConnectionNotifications"
+  paginated             = true
+  output_field          = "ConnectionNotificationSet"
+  item_type             = "ConnectionNotification"
+  not_found_error_codes = ["errCodeInvalidConnectionNotification"]
+}
+
+finder "VPCEndpoint" {
+  operation             = "DescribeVpcEndpoints"
+  paginated             = true
+  output_field          = "VpcEndpoints"
+  item_type             = "VpcEndpoint"
+  not_found_error_codes = ["errCodeInvalidVPCEndpointIdNotFound"]
+}
+
+finder "VPCEndpointServiceConfiguration" {
+  operation             = "DescribeVpcEndpointServiceConfigurations"
+  paginated             = true
+  output_field          = "ServiceConfigurations"
+  item_type             = "ServiceConfiguration"
+  not_found_error_codes = ["errCodeInvalidVPCEndpointServiceIdNotFound"]
+}
+
+finder "VPCPeeringConnection" {
+  operation             = "DescribeVpcPeeringConnections"
+  paginated             = true
+  output_field          = "VpcPeeringConnections"
+  item_type             = "VpcPeeringConnection"
+  not_found_error_codes = ["errCodeInvalidVPCPeeringConnectionIDNotFound"]
+  required_fields       = ["Status"]
+}
+
+finder "VPNConnection" {
+  operation             = "DescribeVpnConnections"
+  output_field          = "VpnConnections"
+  item_type             = "VpnConnection"
+  not_found_error_codes = ["errCodeInvalidVPNConnectionIDNotFound"]
+}
+
+finder "VolumeModification" {
+  operation             = "DescribeVolumesModifications"
+  paginated             = true
+  output_field          = "VolumesModifications"
+  item_type             = "VolumeModification"
+  not_found_error_codes = ["errCodeInvalidVolumeNotFound"]
+}
@@ -18,7 +18,6 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -3286,39 +3285,32 @@ func WaitInstanceReadyWithContext(ctx context.Context, conn *ec2.EC2, id string,
 }
 
 func WaitInstanceConnectEndpointCreated(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.Ec2InstanceConnectEndpoint, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.Ec2InstanceConnectEndpointStateCreateInProgress),
-		Target:  enum.Slice(types.Ec2InstanceConnectEndpointStateCreateComplete),
-		Refresh: StatusInstanceConnectEndpointState(ctx, conn, id),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*types.Ec2InstanceConnectEndpoint); ok {
-		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.StateMessage)))
-
-		return output, err
-	}
-
-	return nil, err
+	return instanceConnectEndpointWaiter(conn, id, timeout,
+		[]types.Ec2InstanceConnectEndpointState{types.Ec2InstanceConnectEndpointStateCreateInProgress},
+		[]types.Ec2InstanceConnectEndpointState{types.Ec2InstanceConnectEndpointStateCreateComplete},
+	).Wait(ctx)
 }
 
 func WaitInstanceConnectEndpointDeleted(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.Ec2InstanceConnectEndpoint, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.Ec2InstanceConnectEndpointStateDeleteInProgress),
-		Target:  []string{},
-		Refresh: StatusInstanceConnectEndpointState(ctx, conn, id),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*types.Ec2InstanceConnectEndpoint); ok {
-		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.StateMessage)))
+	return instanceConnectEndpointWaiter(conn, id, timeout,
+		[]types.Ec2InstanceConnectEndpointState{types.Ec2InstanceConnectEndpointStateDeleteInProgress},
+		nil,
+	).Wait(ctx)
+}
 
-		return output, err
+func instanceConnectEndpointWaiter(conn *ec2_sdkv2.Client, id string, timeout time.Duration, pending, target []types.Ec2InstanceConnectEndpointState) tfresource.Waiter[types.Ec2InstanceConnectEndpoint, types.Ec2InstanceConnectEndpointState] {
+	return tfresource.Waiter[types.Ec2InstanceConnectEndpoint, types.Ec2InstanceConnectEndpointState]{
+		Find: func(ctx context.Context) (*types.Ec2InstanceConnectEndpoint, error) {
+			return FindInstanceConnectEndpointByID(ctx, conn, id)
+		},
+		Status: func(v *types.Ec2InstanceConnectEndpoint) types.Ec2InstanceConnectEndpointState {
+			return v.State
+		},
+		FailureReason: func(v *types.Ec2InstanceConnectEndpoint) error {
+			return errors.New(aws_sdkv2.ToString(v.StateMessage))
+		},
+		Pending: pending,
+		Target:  target,
+		Timeout: timeout,
 	}
-
-	return nil, err
 }
@@ -0,0 +1,820 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_ecs_container_definitions")
+func DataSourceContainerDefinitions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceContainerDefinitionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"container_definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"command": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"cpu": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"depends_on": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"condition": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ecs.ContainerCondition_Values(), false),
+									},
+									"container_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"disable_networking": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"dns_search_domains": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"dns_servers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsIPAddress,
+							},
+						},
+						"docker_labels": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"docker_security_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"entry_point": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"environment": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"environment_file": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      ecs.EnvironmentFileTypeS3,
+										ValidateFunc: validation.StringInSlice(ecs.EnvironmentFileType_Values(), false),
+									},
+									"value": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"essential": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"extra_host": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hostname": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"ip_address": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsIPAddress,
+									},
+								},
+							},
+						},
+						"firelens_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"options": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ecs.FirelensConfigurationType_Values(), false),
+									},
+								},
+							},
+						},
+						"health_check": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"command": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"interval": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(5, 300),
+									},
+									"retries": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 10),
+									},
+									"start_period": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 300),
+									},
+									"timeout": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(2, 120),
+									},
+								},
+							},
+						},
+						"hostname": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"image": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"interactive": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"links": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"linux_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"capabilities": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"add": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"drop": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"init_process_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"shared_memory_size": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"log_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_driver": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ecs.LogDriver_Values(), false),
+									},
+									"options": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"secret_option": containerDefinitionsSecretSchema(),
+								},
+							},
+						},
+						"memory": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(6),
+						},
+						"memory_reservation": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(6),
+						},
+						"mount_point": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"container_path": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"read_only": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"source_volume": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 255),
+								validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only letters, numbers, hyphens and underscores"),
+							),
+						},
+						"port_mapping": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"app_protocol": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(ecs.ApplicationProtocol_Values(), false),
+									},
+									"container_port": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+									"container_port_range": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"host_port": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IsPortNumberOrZero,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"protocol": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      ecs.TransportProtocolTcp,
+										ValidateFunc: validation.StringInSlice(ecs.TransportProtocol_Values(), false),
+									},
+								},
+							},
+						},
+						"privileged": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"pseudo_terminal": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"readonly_root_filesystem": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"repository_credentials_parameter": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"resource_requirement": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ecs.ResourceType_Values(), false),
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"secret": containerDefinitionsSecretSchema(),
+						"start_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(2, 120),
+						},
+						"stop_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(2, 120),
+						},
+						"system_control": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"namespace": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"ulimit": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hard_limit": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ecs.UlimitName_Values(), false),
+									},
+									"soft_limit": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+								},
+							},
+						},
+						"user": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"volumes_from": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"read_only": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"source_container": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"working_directory": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ecs.NetworkMode_Values(), false),
+			},
+		},
+	}
+}
+
+func containerDefinitionsSecretSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value_from": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceContainerDefinitionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	definitions := expandContainerDefinitionsConfig(d.Get("container_definition").([]interface{}))
+	networkMode := d.Get("network_mode").(string)
+
+	for _, err := range validContainerDefinitions(definitions, networkMode) {
+		diags = sdkdiag.AppendErrorf(diags, "invalid ECS container definitions: %s", err)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	json, err := canonicalContainerDefinitionsJSON(definitions, networkMode == ecs.NetworkModeAwsvpc)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "building ECS container definitions: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(json)))
+	d.Set("json", json)
+
+	return diags
+}
+
+func expandContainerDefinitionsConfig(tfList []interface{}) []*ecs.ContainerDefinition {
+	var apiObjects []*ecs.ContainerDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.ContainerDefinition{
+			Essential: aws.Bool(tfMap["essential"].(bool)),
+			Image:     aws.String(tfMap["image"].(string)),
+			Name:      aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Command = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["cpu"].(int); ok && v != 0 {
+			apiObject.Cpu = aws.Int64(int64(v))
+		}
+
+		for _, tfMapRaw := range tfMap["depends_on"].([]interface{}) {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				apiObject.DependsOn = append(apiObject.DependsOn, &ecs.ContainerDependency{
+					Condition:     aws.String(tfMap["condition"].(string)),
+					ContainerName: aws.String(tfMap["container_name"].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["disable_networking"].(bool); ok && v {
+			apiObject.DisableNetworking = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["dns_search_domains"].([]interface{}); ok && len(v) > 0 {
+			apiObject.DnsSearchDomains = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["dns_servers"].([]interface{}); ok && len(v) > 0 {
+			apiObject.DnsServers = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["docker_labels"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.DockerLabels = flex.ExpandStringMap(v)
+		}
+
+		if v, ok := tfMap["docker_security_options"].([]interface{}); ok && len(v) > 0 {
+			apiObject.DockerSecurityOptions = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["entry_point"].([]interface{}); ok && len(v) > 0 {
+			apiObject.EntryPoint = flex.ExpandStringList(v)
+		}
+
+		for k, v := range tfMap["environment"].(map[string]interface{}) {
+			apiObject.Environment = append(apiObject.Environment, &ecs.KeyValuePair{
+				Name:  aws.String(k),
+				Value: aws.String(v.(string)),
+			})
+		}
+
+		for _, tfMapRaw := range tfMap["environment_file"].([]interface{}) {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				apiObject.EnvironmentFiles = append(apiObject.EnvironmentFiles, &ecs.EnvironmentFile{
+					Type:  aws.String(tfMap["type"].(string)),
+					Value: aws.String(tfMap["value"].(string)),
+				})
+			}
+		}
+
+		for _, tfMapRaw := range tfMap["extra_host"].([]interface{}) {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				apiObject.ExtraHosts = append(apiObject.ExtraHosts, &ecs.HostEntry{
+					Hostname:  aws.String(tfMap["hostname"].(string)),
+					IpAddress: aws.String(tfMap["ip_address"].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["firelens_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.FirelensConfiguration = &ecs.FirelensConfiguration{
+				Type: aws.String(tfMap["type"].(string)),
+			}
+
+			if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+				apiObject.FirelensConfiguration.Options = flex.ExpandStringMap(v)
+			}
+		}
+
+		if v, ok := tfMap["health_check"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.HealthCheck = &ecs.HealthCheck{
+				Command: flex.ExpandStringList(tfMap["command"].([]interface{})),
+			}
+
+			if v, ok := tfMap["interval"].(int); ok && v != 0 {
+				apiObject.HealthCheck.Interval = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["retries"].(int); ok && v != 0 {
+				apiObject.HealthCheck.Retries = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["start_period"].(int); ok && v != 0 {
+				apiObject.HealthCheck.StartPeriod = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["timeout"].(int); ok && v != 0 {
+				apiObject.HealthCheck.Timeout = aws.Int64(int64(v))
+			}
+		}
+
+		if v, ok := tfMap["hostname"].(string); ok && v != "" {
+			apiObject.Hostname = aws.String(v)
+		}
+
+		if v, ok := tfMap["interactive"].(bool); ok && v {
+			apiObject.Interactive = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["links"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Links = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["linux_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.LinuxParameters = &ecs.LinuxParameters{}
+
+			if v, ok := tfMap["capabilities"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				apiObject.LinuxParameters.Capabilities = &ecs.KernelCapabilities{}
+
+				if v, ok := tfMap["add"].([]interface{}); ok && len(v) > 0 {
+					apiObject.LinuxParameters.Capabilities.Add = flex.ExpandStringList(v)
+				}
+
+				if v, ok := tfMap["drop"].([]interface{}); ok && len(v) > 0 {
+					apiObject.LinuxParameters.Capabilities.Drop = flex.ExpandStringList(v)
+				}
+			}
+
+			if v, ok := tfMap["init_process_enabled"].(bool); ok && v {
+				apiObject.LinuxParameters.InitProcessEnabled = aws.Bool(v)
+			}
+
+			if v, ok := tfMap["shared_memory_size"].(int); ok && v != 0 {
+				apiObject.LinuxParameters.SharedMemorySize = aws.Int64(int64(v))
+			}
+		}
+
+		if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.LogConfiguration = &ecs.LogConfiguration{
+				LogDriver:     aws.String(tfMap["log_driver"].(string)),
+				SecretOptions: expandContainerDefinitionsSecrets(tfMap["secret_option"].([]interface{})),
+			}
+
+			if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+				apiObject.LogConfiguration.Options = flex.ExpandStringMap(v)
+			}
+		}
+
+		if v, ok := tfMap["memory"].(int); ok && v != 0 {
+			apiObject.Memory = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["memory_reservation"].(int); ok && v != 0 {
+			apiObject.MemoryReservation = aws.Int64(int64(v))
+		}
+
+		for _, tfMapRaw := range tfMap["mount_point"].([]interface{}) {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				apiObject.MountPoints = append(apiObject.MountPoints, &ecs.MountPoint{
+					ContainerPath: aws.String(tfMap["container_path"].(string)),
+					ReadOnly:      aws.Bool(tfMap["read_only"].(bool)),
+					SourceVolume:  aws.String(tfMap["source_volume"].(string)),
+				})
+			}
+		}
+
+		for _, tfMapRaw := range tfMap["port_mapping"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			portMapping := &ecs.PortMapping{
+				Protocol: aws.String(tfMap["protocol"].(string)),
+			}
+
+			if v, ok := tfMap["app_protocol"].(string); ok && v != "" {
+				portMapping.AppProtocol = aws.String(v)
+			}
+
+			if v, ok := tfMap["container_port"].(int); ok && v != 0 {
+				portMapping.ContainerPort = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["container_port_range"].(string); ok && v != "" {
+				portMapping.ContainerPortRange = aws.String(v)
+			}
+
+			if v, ok := tfMap["host_port"].(int); ok && v != 0 {
+				portMapping.HostPort = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["name"].(string); ok && v != "" {
+				portMapping.Name = aws.String(v)
+			}
+
+			apiObject.PortMappings = append(apiObject.PortMappings, portMapping)
+		}
+
+		if v, ok := tfMap["privileged"].(bool); ok && v {
+			apiObject.Privileged = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["pseudo_terminal"].(bool); ok && v {
+			apiObject.PseudoTerminal = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["readonly_root_filesystem"].(bool); ok && v {
+			apiObject.ReadonlyRootFilesystem = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["repository_credentials_parameter"].(string); ok && v != "" {
+			apiObject.RepositoryCredentials = &ecs.RepositoryCredentials{
+				CredentialsParameter: aws.String(v),
+			}
+		}
+
+		for _, tfMapRaw := range tfMap["resource_requirement"].([]interface{}) {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				apiObject.ResourceRequirements = append(apiObject.ResourceRequirements, &ecs.ResourceRequirement{
+					Type:  aws.String(tfMap["type"].(string)),
+					Value: aws.String(tfMap["value"].(string)),
+				})
+			}
+		}
+
+		apiObject.Secrets = expandContainerDefinitionsSecrets(tfMap["secret"].([]interface{}))
+
+		if v, ok := tfMap["start_timeout"].(int); ok && v != 0 {
+			apiObject.StartTimeout = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["stop_timeout"].(int); ok && v != 0 {
+			apiObject.StopTimeout = aws.Int64(int64(v))
+		}
+
+		for _, tfMapRaw := range tfMap["system_control"].([]interface{}) {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				apiObject.SystemControls = append(apiObject.SystemControls, &ecs.SystemControl{
+					Namespace: aws.String(tfMap["namespace"].(string)),
+					Value:     aws.String(tfMap["value"].(string)),
+				})
+			}
+		}
+
+		for _, tfMapRaw := range tfMap["ulimit"].([]interface{}) {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				apiObject.Ulimits = append(apiObject.Ulimits, &ecs.Ulimit{
+					HardLimit: aws.Int64(int64(tfMap["hard_limit"].(int))),
+					Name:      aws.String(tfMap["name"].(string)),
+					SoftLimit: aws.Int64(int64(tfMap["soft_limit"].(int))),
+				})
+			}
+		}
+
+		if v, ok := tfMap["user"].(string); ok && v != "" {
+			apiObject.User = aws.String(v)
+		}
+
+		for _, tfMapRaw := range tfMap["volumes_from"].([]interface{}) {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				apiObject.VolumesFrom = append(apiObject.VolumesFrom, &ecs.VolumeFrom{
+					ReadOnly:        aws.Bool(tfMap["read_only"].(bool)),
+					SourceContainer: aws.String(tfMap["source_container"].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["working_directory"].(string); ok && v != "" {
+			apiObject.WorkingDirectory = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandContainerDefinitionsSecrets(tfList []interface{}) []*ecs.Secret {
+	var apiObjects []*ecs.Secret
+
+	for _, tfMapRaw := range tfList {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			apiObjects = append(apiObjects, &ecs.Secret{
+				Name:      aws.String(tfMap["name"].(string)),
+				ValueFrom: aws.String(tfMap["value_from"].(string)),
+			})
+		}
+	}
+
+	return apiObjects
+}
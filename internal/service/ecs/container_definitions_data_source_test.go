@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECSContainerDefinitionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ecs_container_definitions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerDefinitionsDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", `[{"dependsOn":[{"condition":"START","containerName":"log-router"}],"environment":[{"name":"A","value":"1"},{"name":"B","value":"2"}],"essential":true,"healthCheck":{"command":["CMD-SHELL","curl -f http://localhost/ || exit 1"],"interval":30,"retries":3},"image":"nginx:latest","memory":512,"name":"app","portMappings":[{"containerPort":80,"hostPort":80,"name":"http"}]},{"essential":false,"firelensConfiguration":{"type":"fluentbit"},"image":"public.ecr.aws/aws-observability/aws-for-fluent-bit:stable","memoryReservation":64,"name":"log-router"}]`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSContainerDefinitionsDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccContainerDefinitionsDataSourceConfig_invalid,
+				ExpectError: regexache.MustCompile(`depends_on container "database" does not exist`),
+			},
+		},
+	})
+}
+
+func TestAccECSContainerDefinitionsDataSource_taskDefinition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def ecs.TaskDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerDefinitionsDataSourceConfig_taskDefinition(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttrPair(resourceName, "container_definitions", "data.aws_ecs_container_definitions.test", "json"),
+				),
+			},
+			{
+				Config:   testAccContainerDefinitionsDataSourceConfig_taskDefinition(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+const testAccContainerDefinitionsDataSourceConfig_basic = `
+data "aws_ecs_container_definitions" "test" {
+  network_mode = "awsvpc"
+
+  container_definition {
+    name   = "app"
+    image  = "nginx:latest"
+    cpu    = 0
+    memory = 512
+
+    environment = {
+      B = "2"
+      A = "1"
+    }
+
+    port_mapping {
+      name           = "http"
+      container_port = 80
+    }
+
+    health_check {
+      command  = ["CMD-SHELL", "curl -f http://localhost/ || exit 1"]
+      interval = 30
+      retries  = 3
+    }
+
+    depends_on {
+      container_name = "log-router"
+      condition      = "START"
+    }
+  }
+
+  container_definition {
+    name               = "log-router"
+    image              = "public.ecr.aws/aws-observability/aws-for-fluent-bit:stable"
+    essential          = false
+    memory_reservation = 64
+
+    firelens_configuration {
+      type = "fluentbit"
+    }
+  }
+}
+`
+
+const testAccContainerDefinitionsDataSourceConfig_invalid = `
+data "aws_ecs_container_definitions" "test" {
+  container_definition {
+    name  = "app"
+    image = "nginx:latest"
+
+    depends_on {
+      container_name = "database"
+      condition      = "HEALTHY"
+    }
+  }
+}
+`
+
+func testAccContainerDefinitionsDataSourceConfig_taskDefinition(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ecs_container_definitions" "test" {
+  network_mode = "awsvpc"
+
+  container_definition {
+    name   = "app"
+    image  = "nginx:latest"
+    memory = 128
+
+    environment = {
+      B = "2"
+      A = "1"
+    }
+
+    port_mapping {
+      container_port = 80
+    }
+  }
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family                = %[1]q
+  network_mode          = "awsvpc"
+  container_definitions = data.aws_ecs_container_definitions.test.json
+}
+`, rName)
+}
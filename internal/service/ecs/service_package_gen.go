@@ -33,6 +33,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceContainerDefinition,
 			TypeName: "aws_ecs_container_definition",
 		},
+		{
+			Factory:  DataSourceContainerDefinitions,
+			TypeName: "aws_ecs_container_definitions",
+		},
 		{
 			Factory:  DataSourceService,
 			TypeName: "aws_ecs_service",
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/mitchellh/copystructure"
 )
 
@@ -112,3 +113,18 @@ func (cd containerDefinitions) OrderEnvironmentVariables() {
 		})
 	}
 }
+
+// canonicalContainerDefinitionsJSON returns the container definitions in the form stored in state by the
+// aws_ecs_task_definition resource, with defaults normalized as for diff suppression.
+func canonicalContainerDefinitionsJSON(definitions []*ecs.ContainerDefinition, isAWSVPC bool) (string, error) {
+	if err := containerDefinitions(definitions).Reduce(isAWSVPC); err != nil {
+		return "", err
+	}
+
+	v, err := flattenContainerDefinitions(definitions)
+	if err != nil {
+		return "", err
+	}
+
+	return structure.NormalizeJsonString(v)
+}
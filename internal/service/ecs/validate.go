@@ -5,8 +5,11 @@ package ecs
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
@@ -62,3 +65,124 @@ func validPlacementStrategy(stratType, stratField string) error {
 	}
 	return nil
 }
+
+// validContainerDefinitions checks the relationships between container definitions that
+// the ECS API would otherwise only reject when the task definition is registered.
+func validContainerDefinitions(definitions []*ecs.ContainerDefinition, networkMode string) []error {
+	var errs []error
+
+	names := make(map[string]bool)
+	for _, def := range definitions {
+		name := aws.StringValue(def.Name)
+
+		if names[name] {
+			errs = append(errs, fmt.Errorf("duplicate container name %q", name))
+		}
+
+		names[name] = true
+	}
+
+	essential := false
+	portNames := make(map[string]bool)
+	ports := make(map[string]string)
+
+	for _, def := range definitions {
+		name := aws.StringValue(def.Name)
+
+		if aws.BoolValue(def.Essential) {
+			essential = true
+		}
+
+		if def.Memory != nil && def.MemoryReservation != nil && aws.Int64Value(def.MemoryReservation) > aws.Int64Value(def.Memory) {
+			errs = append(errs, fmt.Errorf("container %q: memory_reservation (%d) must not be greater than memory (%d)", name, aws.Int64Value(def.MemoryReservation), aws.Int64Value(def.Memory)))
+		}
+
+		for _, v := range def.DependsOn {
+			if other := aws.StringValue(v.ContainerName); other == name {
+				errs = append(errs, fmt.Errorf("container %q: depends_on must not refer to the container itself", name))
+			} else if !names[other] {
+				errs = append(errs, fmt.Errorf("container %q: depends_on container %q does not exist", name, other))
+			}
+		}
+
+		for _, v := range def.VolumesFrom {
+			if other := aws.StringValue(v.SourceContainer); other == name {
+				errs = append(errs, fmt.Errorf("container %q: volumes_from must not refer to the container itself", name))
+			} else if !names[other] {
+				errs = append(errs, fmt.Errorf("container %q: volumes_from source container %q does not exist", name, other))
+			}
+		}
+
+		for _, v := range def.Links {
+			if networkMode == ecs.NetworkModeAwsvpc || networkMode == ecs.NetworkModeHost {
+				errs = append(errs, fmt.Errorf("container %q: links are not supported with the %s network mode", name, networkMode))
+				break
+			}
+
+			// Links have the form "name" or "name:alias".
+			other, _, _ := strings.Cut(aws.StringValue(v), ":")
+			if !names[other] {
+				errs = append(errs, fmt.Errorf("container %q: linked container %q does not exist", name, other))
+			}
+		}
+
+		environment := make(map[string]bool)
+		for _, v := range def.Environment {
+			environment[aws.StringValue(v.Name)] = true
+		}
+
+		for _, v := range def.Secrets {
+			if environment[aws.StringValue(v.Name)] {
+				errs = append(errs, fmt.Errorf("container %q: %q is defined in both environment and secret", name, aws.StringValue(v.Name)))
+			}
+		}
+
+		for _, v := range def.PortMappings {
+			containerPort, hostPort := aws.Int64Value(v.ContainerPort), aws.Int64Value(v.HostPort)
+
+			if v.ContainerPort == nil && v.ContainerPortRange == nil {
+				errs = append(errs, fmt.Errorf("container %q: port_mapping must specify container_port or container_port_range", name))
+				continue
+			}
+
+			if v.ContainerPort != nil && v.ContainerPortRange != nil {
+				errs = append(errs, fmt.Errorf("container %q: port_mapping must not specify both container_port and container_port_range", name))
+				continue
+			}
+
+			if portName := aws.StringValue(v.Name); portName != "" {
+				if portNames[portName] {
+					errs = append(errs, fmt.Errorf("container %q: duplicate port mapping name %q", name, portName))
+				}
+
+				portNames[portName] = true
+			}
+
+			if v.ContainerPort == nil {
+				continue
+			}
+
+			if (networkMode == ecs.NetworkModeAwsvpc || networkMode == ecs.NetworkModeHost) && hostPort != 0 && hostPort != containerPort {
+				errs = append(errs, fmt.Errorf("container %q: host_port (%d) must equal container_port (%d) with the %s network mode", name, hostPort, containerPort, networkMode))
+			}
+
+			// Containers in a task share the network namespace with the awsvpc and host network modes.
+			key := fmt.Sprintf("%d/%s", containerPort, aws.StringValue(v.Protocol))
+			if networkMode != ecs.NetworkModeAwsvpc && networkMode != ecs.NetworkModeHost {
+				key = name + "/" + key
+			}
+
+			if other, ok := ports[key]; ok {
+				errs = append(errs, fmt.Errorf("container %q: container_port %d (%s) is already mapped by container %q", name, containerPort, aws.StringValue(v.Protocol), other))
+			}
+
+			ports[key] = name
+		}
+	}
+
+	if !essential {
+		errs = append(errs, fmt.Errorf("at least one container must be essential"))
+	}
+
+	return errs
+}
@@ -4,7 +4,11 @@
 package ecs
 
 import (
+	"reflect"
 	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
 )
 
 func TestValidPlacementConstraint(t *testing.T) {
@@ -92,3 +96,135 @@ func TestValidPlacementStrategy(t *testing.T) {
 		}
 	}
 }
+
+func TestValidContainerDefinitions(t *testing.T) {
+	t.Parallel()
+
+	container := func(name string, f func(*ecs.ContainerDefinition)) *ecs.ContainerDefinition {
+		def := &ecs.ContainerDefinition{
+			Essential: aws.Bool(true),
+			Image:     aws.String("nginx"),
+			Name:      aws.String(name),
+		}
+
+		if f != nil {
+			f(def)
+		}
+
+		return def
+	}
+
+	cases := map[string]struct {
+		definitions []*ecs.ContainerDefinition
+		networkMode string
+		want        []string
+	}{
+		"valid": {
+			definitions: []*ecs.ContainerDefinition{
+				container("app", func(def *ecs.ContainerDefinition) {
+					def.DependsOn = []*ecs.ContainerDependency{{ContainerName: aws.String("sidecar"), Condition: aws.String(ecs.ContainerConditionStart)}}
+					def.Links = []*string{aws.String("sidecar:proxy")}
+					def.PortMappings = []*ecs.PortMapping{{ContainerPort: aws.Int64(80), Protocol: aws.String(ecs.TransportProtocolTcp)}}
+				}),
+				container("sidecar", func(def *ecs.ContainerDefinition) {
+					def.Essential = aws.Bool(false)
+					def.PortMappings = []*ecs.PortMapping{{ContainerPort: aws.Int64(80), Protocol: aws.String(ecs.TransportProtocolTcp)}}
+				}),
+			},
+			networkMode: ecs.NetworkModeBridge,
+		},
+		"duplicate names": {
+			definitions: []*ecs.ContainerDefinition{container("app", nil), container("app", nil)},
+			want:        []string{`duplicate container name "app"`},
+		},
+		"no essential container": {
+			definitions: []*ecs.ContainerDefinition{
+				container("app", func(def *ecs.ContainerDefinition) { def.Essential = aws.Bool(false) }),
+			},
+			want: []string{"at least one container must be essential"},
+		},
+		"missing references": {
+			definitions: []*ecs.ContainerDefinition{
+				container("app", func(def *ecs.ContainerDefinition) {
+					def.DependsOn = []*ecs.ContainerDependency{{ContainerName: aws.String("db"), Condition: aws.String(ecs.ContainerConditionHealthy)}}
+					def.VolumesFrom = []*ecs.VolumeFrom{{SourceContainer: aws.String("app")}}
+					def.Links = []*string{aws.String("cache")}
+				}),
+			},
+			want: []string{
+				`container "app": depends_on container "db" does not exist`,
+				`container "app": volumes_from must not refer to the container itself`,
+				`container "app": linked container "cache" does not exist`,
+			},
+		},
+		"memory": {
+			definitions: []*ecs.ContainerDefinition{
+				container("app", func(def *ecs.ContainerDefinition) {
+					def.Memory = aws.Int64(256)
+					def.MemoryReservation = aws.Int64(512)
+				}),
+			},
+			want: []string{`container "app": memory_reservation (512) must not be greater than memory (256)`},
+		},
+		"secret and environment": {
+			definitions: []*ecs.ContainerDefinition{
+				container("app", func(def *ecs.ContainerDefinition) {
+					def.Environment = []*ecs.KeyValuePair{{Name: aws.String("PASSWORD"), Value: aws.String("")}}
+					def.Secrets = []*ecs.Secret{{Name: aws.String("PASSWORD"), ValueFrom: aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/password")}}
+				}),
+			},
+			want: []string{`container "app": "PASSWORD" is defined in both environment and secret`},
+		},
+		"awsvpc": {
+			definitions: []*ecs.ContainerDefinition{
+				container("app", func(def *ecs.ContainerDefinition) {
+					def.Links = []*string{aws.String("sidecar")}
+					def.PortMappings = []*ecs.PortMapping{{ContainerPort: aws.Int64(80), HostPort: aws.Int64(8080), Protocol: aws.String(ecs.TransportProtocolTcp)}}
+				}),
+				container("sidecar", func(def *ecs.ContainerDefinition) {
+					def.PortMappings = []*ecs.PortMapping{{ContainerPort: aws.Int64(80), Protocol: aws.String(ecs.TransportProtocolTcp)}}
+				}),
+			},
+			networkMode: ecs.NetworkModeAwsvpc,
+			want: []string{
+				`container "app": links are not supported with the awsvpc network mode`,
+				`container "app": host_port (8080) must equal container_port (80) with the awsvpc network mode`,
+				`container "sidecar": container_port 80 (tcp) is already mapped by container "app"`,
+			},
+		},
+		"port mappings": {
+			definitions: []*ecs.ContainerDefinition{
+				container("app", func(def *ecs.ContainerDefinition) {
+					def.PortMappings = []*ecs.PortMapping{
+						{Name: aws.String("http"), ContainerPort: aws.Int64(80), Protocol: aws.String(ecs.TransportProtocolTcp)},
+						{Name: aws.String("http"), ContainerPortRange: aws.String("8000-8010"), Protocol: aws.String(ecs.TransportProtocolTcp)},
+						{Protocol: aws.String(ecs.TransportProtocolTcp)},
+					}
+				}),
+			},
+			want: []string{
+				`container "app": duplicate port mapping name "http"`,
+				`container "app": port_mapping must specify container_port or container_port_range`,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			errs := validContainerDefinitions(tc.definitions, tc.networkMode)
+
+			var got []string
+			for _, err := range errs {
+				got = append(got, err.Error())
+			}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,373 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	kubeconfigAuthModeExec  = "exec"
+	kubeconfigAuthModeToken = "token"
+
+	kubeconfigExecAPIVersion = "client.authentication.k8s.io/v1beta1"
+)
+
+func kubeconfigAuthMode_Values() []string {
+	return []string{
+		kubeconfigAuthModeExec,
+		kubeconfigAuthModeToken,
+	}
+}
+
+// @SDKDataSource("aws_eks_kubeconfig")
+func DataSourceKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceKubeconfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"auth_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      kubeconfigAuthModeToken,
+				ValidateFunc: validation.StringInSlice(kubeconfigAuthMode_Values(), false),
+			},
+			"cluster": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			"current_context": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"exec_command": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "aws",
+			},
+			"forward_session_name": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"kubeconfig": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"session_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(2, 64),
+			},
+			"token_expiration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKubeconfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*conns.AWSClient)
+	conn := client.EKSConn(ctx)
+
+	authMode := d.Get("auth_mode").(string)
+	roleARN := d.Get("role_arn").(string)
+	sessionName := d.Get("session_name").(string)
+
+	// `aws eks get-token` has no way to set the role session name.
+	if authMode == kubeconfigAuthModeExec && (sessionName != "" || d.Get("forward_session_name").(bool)) {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Kubeconfig: session_name and forward_session_name are not supported with auth_mode = %q", kubeconfigAuthModeExec)
+	}
+
+	if sessionName == "" && d.Get("forward_session_name").(bool) {
+		v, err := callerSessionName(ctx, client.STSConn(ctx))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EKS Kubeconfig: forwarding session name: %s", err)
+		}
+
+		sessionName = v
+	}
+
+	var generator Generator
+	stsConn := client.STSConn(ctx)
+
+	if authMode == kubeconfigAuthModeToken {
+		v, err := NewGenerator(d.Get("forward_session_name").(bool), false)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "getting token generator: %s", err)
+		}
+
+		generator = v
+
+		if roleARN != "" {
+			creds := stscreds.NewCredentials(client.Session, roleARN, func(p *stscreds.AssumeRoleProvider) {
+				if sessionName != "" {
+					p.RoleSessionName = sessionName
+				}
+			})
+			stsConn = sts.New(client.Session, aws.NewConfig().WithCredentials(creds))
+		}
+	}
+
+	config := kubeconfig{
+		APIVersion:  "v1",
+		Kind:        "Config",
+		Preferences: map[string]interface{}{},
+	}
+
+	var names []string
+	var tfList []interface{}
+	var expiration time.Time
+
+	for _, tfMapRaw := range d.Get("cluster").([]interface{}) {
+		tfMap := tfMapRaw.(map[string]interface{})
+		name := tfMap["name"].(string)
+
+		cluster, err := FindClusterByName(ctx, conn, name)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EKS Cluster (%s): %s", name, err)
+		}
+
+		contextName := aws.StringValue(cluster.Arn)
+		if v, ok := tfMap["alias"].(string); ok && v != "" {
+			contextName = v
+		}
+
+		// Local clusters on Outposts are identified by ID rather than name.
+		clusterID := name
+		if cluster.OutpostConfig != nil {
+			clusterID = aws.StringValue(cluster.Id)
+		}
+
+		user := kubeconfigUser{}
+
+		switch authMode {
+		case kubeconfigAuthModeExec:
+			user.Exec = expandKubeconfigExec(d.Get("exec_command").(string), client.Region, clusterID, roleARN)
+		default:
+			token, err := generator.GetWithSTS(ctx, clusterID, stsConn)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "getting token for EKS Cluster (%s): %s", name, err)
+			}
+
+			user.Token = token.Token
+
+			if expiration.IsZero() || token.Expiration.Before(expiration) {
+				expiration = token.Expiration
+			}
+		}
+
+		var certificateAuthorityData string
+		if cluster.CertificateAuthority != nil {
+			certificateAuthorityData = aws.StringValue(cluster.CertificateAuthority.Data)
+		}
+
+		config.Clusters = append(config.Clusters, kubeconfigNamedCluster{
+			Name: contextName,
+			Cluster: kubeconfigCluster{
+				CertificateAuthorityData: certificateAuthorityData,
+				Server:                   aws.StringValue(cluster.Endpoint),
+			},
+		})
+		config.Contexts = append(config.Contexts, kubeconfigNamedContext{
+			Name: contextName,
+			Context: kubeconfigContext{
+				Cluster: contextName,
+				User:    contextName,
+			},
+		})
+		config.Users = append(config.Users, kubeconfigNamedUser{
+			Name: contextName,
+			User: user,
+		})
+
+		names = append(names, name)
+		tfMap["arn"] = aws.StringValue(cluster.Arn)
+		tfMap["endpoint"] = aws.StringValue(cluster.Endpoint)
+		tfList = append(tfList, tfMap)
+	}
+
+	config.CurrentContext = config.Contexts[0].Name
+	if v, ok := d.GetOk("current_context"); ok {
+		if !kubeconfigHasContext(config, v.(string)) {
+			return sdkdiag.AppendErrorf(diags, "reading EKS Kubeconfig: current_context (%s) doesn't match any cluster", v.(string))
+		}
+
+		config.CurrentContext = v.(string)
+	}
+
+	b, err := yaml.Marshal(config)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Kubeconfig: %s", err)
+	}
+
+	d.SetId(strings.Join(names, ","))
+	if err := d.Set("cluster", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting cluster: %s", err)
+	}
+	d.Set("current_context", config.CurrentContext)
+	d.Set("kubeconfig", string(b))
+	if !expiration.IsZero() {
+		d.Set("token_expiration", expiration.UTC().Format(time.RFC3339))
+	} else {
+		d.Set("token_expiration", nil)
+	}
+
+	return diags
+}
+
+// callerSessionName returns the role session name of the caller's assumed role.
+func callerSessionName(ctx context.Context, conn *sts.STS) (string, error) {
+	output, err := conn.GetCallerIdentityWithContext(ctx, &sts.GetCallerIdentityInput{})
+
+	if err != nil {
+		return "", err
+	}
+
+	return sessionNameFromARN(aws.StringValue(output.Arn))
+}
+
+// sessionNameFromARN returns the role session name from an assumed role ARN,
+// e.g. arn:aws:sts::123456789012:assumed-role/RoleName/SessionName.
+func sessionNameFromARN(s string) (string, error) {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return "", err
+	}
+
+	parts := strings.Split(v.Resource, "/")
+
+	if v.Service != "sts" || len(parts) != 3 || parts[0] != "assumed-role" {
+		return "", fmt.Errorf("caller (%s) is not an assumed role", s)
+	}
+
+	return parts[2], nil
+}
+
+// expandKubeconfigExec returns an exec credential plugin stanza that runs `aws eks get-token`.
+func expandKubeconfigExec(command, region, clusterID, roleARN string) *kubeconfigExec {
+	exec := &kubeconfigExec{
+		APIVersion:      kubeconfigExecAPIVersion,
+		Command:         command,
+		Args:            []string{"--region", region, "eks", "get-token", "--cluster-name", clusterID, "--output", "json"},
+		InteractiveMode: "Never",
+	}
+
+	if roleARN != "" {
+		exec.Args = append(exec.Args, "--role-arn", roleARN)
+	}
+
+	return exec
+}
+
+func kubeconfigHasContext(config kubeconfig, name string) bool {
+	for _, v := range config.Contexts {
+		if v.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// kubeconfig is a minimal representation of a kubeconfig file.
+// See https://kubernetes.io/docs/reference/config-api/kubeconfig.v1/.
+type kubeconfig struct {
+	APIVersion     string                   `yaml:"apiVersion"`
+	Kind           string                   `yaml:"kind"`
+	Clusters       []kubeconfigNamedCluster `yaml:"clusters"`
+	Contexts       []kubeconfigNamedContext `yaml:"contexts"`
+	CurrentContext string                   `yaml:"current-context"`
+	Preferences    map[string]interface{}   `yaml:"preferences"`
+	Users          []kubeconfigNamedUser    `yaml:"users"`
+}
+
+type kubeconfigNamedCluster struct {
+	Name    string            `yaml:"name"`
+	Cluster kubeconfigCluster `yaml:"cluster"`
+}
+
+type kubeconfigCluster struct {
+	CertificateAuthorityData string `yaml:"certificate-authority-data,omitempty"`
+	Server                   string `yaml:"server"`
+}
+
+type kubeconfigNamedContext struct {
+	Name    string            `yaml:"name"`
+	Context kubeconfigContext `yaml:"context"`
+}
+
+type kubeconfigContext struct {
+	Cluster string `yaml:"cluster"`
+	User    string `yaml:"user"`
+}
+
+type kubeconfigNamedUser struct {
+	Name string         `yaml:"name"`
+	User kubeconfigUser `yaml:"user"`
+}
+
+type kubeconfigUser struct {
+	Exec  *kubeconfigExec `yaml:"exec,omitempty"`
+	Token string          `yaml:"token,omitempty"`
+}
+
+type kubeconfigExec struct {
+	APIVersion      string   `yaml:"apiVersion"`
+	Args            []string `yaml:"args"`
+	Command         string   `yaml:"command"`
+	InteractiveMode string   `yaml:"interactiveMode"`
+}
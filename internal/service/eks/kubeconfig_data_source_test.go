@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/eks"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"gopkg.in/yaml.v2"
+)
+
+func TestExpandKubeconfigExec(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		RoleARN  string
+		Expected string
+	}{
+		{
+			TestName: "no role",
+			Expected: `apiVersion: client.authentication.k8s.io/v1beta1
+args:
+- --region
+- us-west-2
+- eks
+- get-token
+- --cluster-name
+- test
+- --output
+- json
+command: aws
+interactiveMode: Never
+`,
+		},
+		{
+			TestName: "role",
+			RoleARN:  "arn:aws:iam::123456789012:role/test",
+			Expected: `apiVersion: client.authentication.k8s.io/v1beta1
+args:
+- --region
+- us-west-2
+- eks
+- get-token
+- --cluster-name
+- test
+- --output
+- json
+- --role-arn
+- arn:aws:iam::123456789012:role/test
+command: aws
+interactiveMode: Never
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := yaml.Marshal(tfeks.ExpandKubeconfigExec("aws", "us-west-2", "test", testCase.RoleARN))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := string(b); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAccEKSKubeconfigDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_kubeconfig.test"
+	resourceName := "aws_eks_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eks.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubeconfigDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "auth_mode", "token"),
+					resource.TestCheckResourceAttr(dataSourceName, "cluster.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster.0.endpoint", resourceName, "endpoint"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "current_context", resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "token_expiration"),
+					testAccCheckKubeconfigToken(dataSourceName, rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSKubeconfigDataSource_exec(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_kubeconfig.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eks.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubeconfigDataSourceConfig_exec(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "auth_mode", "exec"),
+					resource.TestCheckResourceAttr(dataSourceName, "current_context", "test"),
+					resource.TestCheckResourceAttr(dataSourceName, "token_expiration", ""),
+					testAccCheckKubeconfigExec(dataSourceName, rName),
+				),
+			},
+		},
+	})
+}
+
+type testAccKubeconfig struct {
+	CurrentContext string `yaml:"current-context"`
+	Users          []struct {
+		Name string `yaml:"name"`
+		User struct {
+			Exec *struct {
+				Args    []string `yaml:"args"`
+				Command string   `yaml:"command"`
+			} `yaml:"exec"`
+			Token string `yaml:"token"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+}
+
+func testAccKubeconfigUnmarshal(s *terraform.State, n string) (*testAccKubeconfig, error) {
+	rs, ok := s.RootModule().Resources[n]
+	if !ok {
+		return nil, fmt.Errorf("Not found: %s", n)
+	}
+
+	var config testAccKubeconfig
+	if err := yaml.Unmarshal([]byte(rs.Primary.Attributes["kubeconfig"]), &config); err != nil {
+		return nil, err
+	}
+
+	if len(config.Users) != 1 {
+		return nil, fmt.Errorf("expected 1 user, got %d", len(config.Users))
+	}
+
+	return &config, nil
+}
+
+func testAccCheckKubeconfigToken(n, clusterName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config, err := testAccKubeconfigUnmarshal(s, n)
+		if err != nil {
+			return err
+		}
+
+		identity, err := tfeks.NewVerifier(clusterName).Verify(config.Users[0].User.Token)
+		if err != nil {
+			return fmt.Errorf("verifying token for cluster %q: %w", clusterName, err)
+		}
+
+		if identity.ARN == "" {
+			return fmt.Errorf("Unexpected blank ARN for token identity")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKubeconfigExec(n, clusterName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config, err := testAccKubeconfigUnmarshal(s, n)
+		if err != nil {
+			return err
+		}
+
+		user := config.Users[0]
+
+		if got, want := user.Name, "test"; got != want {
+			return fmt.Errorf("user name = %q, want %q", got, want)
+		}
+
+		if user.User.Token != "" || user.User.Exec == nil {
+			return fmt.Errorf("expected exec stanza without token")
+		}
+
+		if got, want := user.User.Exec.Command, "aws"; got != want {
+			return fmt.Errorf("exec command = %q, want %q", got, want)
+		}
+
+		for i, v := range user.User.Exec.Args {
+			if v == "--cluster-name" && i+1 < len(user.User.Exec.Args) && user.User.Exec.Args[i+1] == clusterName {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("exec args %v don't include cluster name %q", user.User.Exec.Args, clusterName)
+	}
+}
+
+func testAccKubeconfigDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_required(rName), `
+data "aws_eks_kubeconfig" "test" {
+  cluster {
+    name = aws_eks_cluster.test.name
+  }
+}
+`)
+}
+
+func TestAccEKSKubeconfigDataSource_execSessionName(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eks.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubeconfigDataSourceConfig_execSessionName(rName),
+				ExpectError: regexache.MustCompile(`session_name and forward_session_name are not supported with auth_mode = "exec"`),
+			},
+		},
+	})
+}
+
+func testAccKubeconfigDataSourceConfig_exec(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_required(rName), `
+data "aws_eks_kubeconfig" "test" {
+  auth_mode = "exec"
+
+  cluster {
+    name  = aws_eks_cluster.test.name
+    alias = "test"
+  }
+}
+`)
+}
+
+func testAccKubeconfigDataSourceConfig_execSessionName(rName string) string {
+	return fmt.Sprintf(`
+data "aws_eks_kubeconfig" "test" {
+  auth_mode    = "exec"
+  session_name = "test"
+
+  cluster {
+    name = %[1]q
+  }
+}
+`, rName)
+}
@@ -45,6 +45,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceClusters,
 			TypeName: "aws_eks_clusters",
 		},
+		{
+			Factory:  DataSourceKubeconfig,
+			TypeName: "aws_eks_kubeconfig",
+		},
 		{
 			Factory:  DataSourceNodeGroup,
 			TypeName: "aws_eks_node_group",
@@ -7,6 +7,7 @@
 package elasticbeanstalk
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -15,7 +16,9 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -29,6 +32,7 @@ func init() {
 		Name: "aws_elastic_beanstalk_environment",
 		F:    sweepEnvironments,
 	})
+	discovery.Register("aws_elastic_beanstalk_environment", listEnvironments)
 }
 
 func sweepApplications(region string) error {
@@ -78,13 +82,35 @@ func sweepEnvironments(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
+
+	sweepResources, err := listEnvironments(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Elastic Beanstalk Environment sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing Elastic Beanstalk Environments (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping Elastic Beanstalk Environments (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listEnvironments(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.ElasticBeanstalkConn(ctx)
 	input := &elasticbeanstalk.DescribeEnvironmentsInput{
 		IncludeDeleted: aws.Bool(false),
 	}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = describeEnvironmentsPages(ctx, conn, input, func(page *elasticbeanstalk.EnvironmentDescriptionsMessage, lastPage bool) bool {
+	err := describeEnvironmentsPages(ctx, conn, input, func(page *elasticbeanstalk.EnvironmentDescriptionsMessage, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -102,20 +128,9 @@ func sweepEnvironments(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Elastic Beanstalk Environment sweep for %s: %s", region, err)
-		return nil
-	}
-
 	if err != nil {
-		return fmt.Errorf("listing Elastic Beanstalk Environments (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("sweeping Elastic Beanstalk Environments (%s): %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
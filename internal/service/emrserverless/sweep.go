@@ -7,6 +7,7 @@
 package emrserverless
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,7 +15,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/emrserverless"
 	"github.com/aws/aws-sdk-go-v2/service/emrserverless/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -22,6 +25,7 @@ func init() {
 		Name: "aws_emrserverless_application",
 		F:    sweepApplications,
 	})
+	discovery.Register("aws_emrserverless_application", listApplications)
 }
 
 func sweepApplications(region string) error {
@@ -30,6 +34,28 @@ func sweepApplications(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listApplications(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EMR Serverless Application sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing EMR Serverless Applications (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EMR Serverless Applications (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listApplications(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.EMRServerlessClient(ctx)
 	input := &emrserverless.ListApplicationsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
@@ -38,13 +64,8 @@ func sweepApplications(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping EMR Serverless Application sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error listing EMR Serverless Applications (%s): %w", region, err)
+			return nil, err
 		}
 
 		for _, v := range page.Applications {
@@ -60,11 +81,5 @@ func sweepApplications(region string) error {
 		}
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping EMR Serverless Applications (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
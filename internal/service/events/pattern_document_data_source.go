@@ -0,0 +1,368 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
+)
+
+// @SDKDataSource("aws_cloudwatch_event_pattern_document")
+func DataSourcePatternDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePatternDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"field": dataSourcePatternDocumentFieldSchema(),
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"matched_sample_events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"or": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field": dataSourcePatternDocumentFieldSchema(),
+					},
+				},
+			},
+			"sample_events": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"unmatched_sample_events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourcePatternDocumentFieldSchema() *schema.Schema {
+	stringList := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"anything_but": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"equals_ignore_case": stringList(),
+							"numbers": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeFloat},
+							},
+							"prefix": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"suffix": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"values":   stringList(),
+							"wildcard": stringList(),
+						},
+					},
+				},
+				"booleans": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeBool},
+				},
+				"cidr": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.IsCIDR,
+					},
+				},
+				"equals_ignore_case": stringList(),
+				"exists": {
+					Type:         nullable.TypeNullableBool,
+					Optional:     true,
+					ValidateFunc: nullable.ValidateTypeStringNullableBool,
+				},
+				"null": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"numbers": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeFloat},
+				},
+				"numeric": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"condition": {
+								Type:     schema.TypeList,
+								Required: true,
+								MinItems: 1,
+								MaxItems: 2,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"operator": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringInSlice([]string{"=", "<", "<=", ">", ">="}, false),
+										},
+										"value": {
+											Type:     schema.TypeFloat,
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"path": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				"prefix":   stringList(),
+				"suffix":   stringList(),
+				"values":   stringList(),
+				"wildcard": stringList(),
+			},
+		},
+	}
+}
+
+func dataSourcePatternDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	pattern := make(map[string]interface{})
+
+	if err := expandEventPatternFields(pattern, d.Get("field").([]interface{})); err != nil {
+		return sdkdiag.AppendErrorf(diags, "building EventBridge event pattern: %s", err)
+	}
+
+	if v, ok := d.GetOk("or"); ok {
+		var alternatives []interface{}
+
+		for i, tfMapRaw := range v.([]interface{}) {
+			alternative := make(map[string]interface{})
+
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				if err := expandEventPatternFields(alternative, tfMap["field"].([]interface{})); err != nil {
+					return sdkdiag.AppendErrorf(diags, "building EventBridge event pattern: or %d: %s", i, err)
+				}
+			}
+
+			if len(alternative) == 0 {
+				return sdkdiag.AppendErrorf(diags, "building EventBridge event pattern: or %d: at least one field is required", i)
+			}
+
+			alternatives = append(alternatives, alternative)
+		}
+
+		pattern["$or"] = alternatives
+	}
+
+	if len(pattern) == 0 {
+		return sdkdiag.AppendErrorf(diags, "building EventBridge event pattern: at least one field or or block is required")
+	}
+
+	b, err := json.Marshal(pattern)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "building EventBridge event pattern: %s", err)
+	}
+	jsonString := string(b)
+
+	var matched, unmatched []string
+	for name, v := range d.Get("sample_events").(map[string]interface{}) {
+		ok, err := eventPatternMatches(jsonString, v.(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "evaluating EventBridge event pattern against sample event (%s): %s", name, err)
+		}
+
+		if ok {
+			matched = append(matched, name)
+		} else {
+			unmatched = append(unmatched, name)
+		}
+	}
+	sort.Strings(matched)
+	sort.Strings(unmatched)
+
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+	d.Set("json", jsonString)
+	d.Set("matched_sample_events", matched)
+	d.Set("unmatched_sample_events", unmatched)
+
+	return diags
+}
+
+// expandEventPatternFields adds the content filters for each field to the pattern.
+// Filters for the same path are combined using a logical OR.
+func expandEventPatternFields(pattern map[string]interface{}, tfList []interface{}) error {
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		path := tfMap["path"].(string)
+		matchers, err := expandEventPatternMatchers(tfMap)
+		if err != nil {
+			return fmt.Errorf("field %d (%s): %w", i, path, err)
+		}
+
+		if len(matchers) == 0 {
+			return fmt.Errorf("field %d (%s): at least one content filter is required", i, path)
+		}
+
+		parent := pattern
+		keys := strings.Split(path, ".")
+		for _, key := range keys[:len(keys)-1] {
+			switch v := parent[key].(type) {
+			case nil:
+				child := make(map[string]interface{})
+				parent[key] = child
+				parent = child
+			case map[string]interface{}:
+				parent = v
+			default:
+				return fmt.Errorf("field %d (%s): %q is also used as a leaf field", i, path, key)
+			}
+		}
+
+		key := keys[len(keys)-1]
+		switch v := parent[key].(type) {
+		case nil:
+			parent[key] = matchers
+		case []interface{}:
+			parent[key] = append(v, matchers...)
+		default:
+			return fmt.Errorf("field %d (%s): %q is also used as a parent field", i, path, key)
+		}
+	}
+
+	return nil
+}
+
+func expandEventPatternMatchers(tfMap map[string]interface{}) ([]interface{}, error) {
+	var matchers []interface{}
+
+	for _, v := range tfMap["values"].([]interface{}) {
+		matchers = append(matchers, v)
+	}
+
+	for _, v := range tfMap["numbers"].([]interface{}) {
+		matchers = append(matchers, v)
+	}
+
+	for _, v := range tfMap["booleans"].([]interface{}) {
+		matchers = append(matchers, v)
+	}
+
+	if tfMap["null"].(bool) {
+		matchers = append(matchers, nil)
+	}
+
+	for _, k := range []string{"prefix", "suffix", "equals_ignore_case", "wildcard", "cidr"} {
+		for _, v := range tfMap[k].([]interface{}) {
+			matchers = append(matchers, map[string]interface{}{strings.ReplaceAll(k, "_", "-"): v})
+		}
+	}
+
+	if v, ok := tfMap["anything_but"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		matcher, err := expandEventPatternAnythingBut(v[0].(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+
+		matchers = append(matchers, map[string]interface{}{"anything-but": matcher})
+	}
+
+	for _, tfMapRaw := range tfMap["numeric"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var conditions []interface{}
+		for _, tfMapRaw := range tfMap["condition"].([]interface{}) {
+			tfMap := tfMapRaw.(map[string]interface{})
+			conditions = append(conditions, tfMap["operator"].(string), tfMap["value"].(float64))
+		}
+
+		matchers = append(matchers, map[string]interface{}{"numeric": conditions})
+	}
+
+	if v, null, _ := nullable.Bool(tfMap["exists"].(string)).Value(); !null {
+		matchers = append(matchers, map[string]interface{}{"exists": v})
+	}
+
+	return matchers, nil
+}
+
+func expandEventPatternAnythingBut(tfMap map[string]interface{}) (interface{}, error) {
+	var apiObjects []interface{}
+	var operators []string
+
+	values := append(append([]interface{}{}, tfMap["values"].([]interface{})...), tfMap["numbers"].([]interface{})...)
+	if len(values) > 0 {
+		apiObjects = append(apiObjects, values)
+		operators = append(operators, "values")
+	}
+
+	for _, k := range []string{"prefix", "suffix"} {
+		if v := tfMap[k].(string); v != "" {
+			apiObjects = append(apiObjects, map[string]interface{}{k: v})
+			operators = append(operators, k)
+		}
+	}
+
+	for _, k := range []string{"equals_ignore_case", "wildcard"} {
+		if v := tfMap[k].([]interface{}); len(v) > 0 {
+			apiObjects = append(apiObjects, map[string]interface{}{strings.ReplaceAll(k, "_", "-"): v})
+			operators = append(operators, k)
+		}
+	}
+
+	if len(apiObjects) != 1 {
+		return nil, fmt.Errorf("anything_but must specify exactly one of values/numbers, prefix, suffix, equals_ignore_case or wildcard, got %v", operators)
+	}
+
+	return apiObjects[0], nil
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEventsPatternDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_event_pattern_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatternDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", `{"detail":{"c-count":[{"numeric":[">",0,"<=",5]}],"state":["running",{"prefix":"stop"}]},"source":["aws.ec2"]}`),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_sample_events.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_sample_events.0", "running"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_sample_events.1", "stopping"),
+					resource.TestCheckResourceAttr(dataSourceName, "unmatched_sample_events.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "unmatched_sample_events.0", "terminated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventsPatternDocumentDataSource_or(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_event_pattern_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatternDocumentDataSourceConfig_or,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", `{"$or":[{"detail":{"state":[{"anything-but":["running","pending"]}]}},{"detail":{"instance-id":[{"exists":false}]}}],"source":["aws.ec2"]}`),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_sample_events.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_sample_events.0", "stopped"),
+					resource.TestCheckResourceAttr(dataSourceName, "unmatched_sample_events.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "unmatched_sample_events.0", "running"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventsPatternDocumentDataSource_conflictingPaths(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPatternDocumentDataSourceConfig_conflictingPaths,
+				ExpectError: regexache.MustCompile(`"detail" is also used as a leaf field`),
+			},
+		},
+	})
+}
+
+const testAccPatternDocumentDataSourceConfig_basic = `
+data "aws_cloudwatch_event_pattern_document" "test" {
+  field {
+    path   = "source"
+    values = ["aws.ec2"]
+  }
+
+  field {
+    path   = "detail.state"
+    values = ["running"]
+    prefix = ["stop"]
+  }
+
+  field {
+    path = "detail.c-count"
+
+    numeric {
+      condition {
+        operator = ">"
+        value    = 0
+      }
+
+      condition {
+        operator = "<="
+        value    = 5
+      }
+    }
+  }
+
+  sample_events = {
+    running    = jsonencode({ source = "aws.ec2", detail = { state = "running", c-count = 5 } })
+    stopping   = jsonencode({ source = "aws.ec2", detail = { state = "stopping", c-count = 1 } })
+    terminated = jsonencode({ source = "aws.ec2", detail = { state = "terminated", c-count = 1 } })
+  }
+}
+`
+
+const testAccPatternDocumentDataSourceConfig_or = `
+data "aws_cloudwatch_event_pattern_document" "test" {
+  field {
+    path   = "source"
+    values = ["aws.ec2"]
+  }
+
+  or {
+    field {
+      path = "detail.state"
+
+      anything_but {
+        values = ["running", "pending"]
+      }
+    }
+  }
+
+  or {
+    field {
+      path   = "detail.instance-id"
+      exists = false
+    }
+  }
+
+  sample_events = {
+    running = jsonencode({ source = "aws.ec2", detail = { state = "running", instance-id = "i-1234567890abcdef0" } })
+    stopped = jsonencode({ source = "aws.ec2", detail = { state = "stopped", instance-id = "i-1234567890abcdef0" } })
+  }
+}
+`
+
+const testAccPatternDocumentDataSourceConfig_conflictingPaths = `
+data "aws_cloudwatch_event_pattern_document" "test" {
+  field {
+    path   = "detail"
+    values = ["example"]
+  }
+
+  field {
+    path   = "detail.state"
+    values = ["running"]
+  }
+}
+`
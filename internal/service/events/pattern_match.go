@@ -0,0 +1,448 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// eventPatternMatches returns whether an event matches an event pattern, following the
+// EventBridge content filtering rules.
+// See https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-event-patterns.html.
+func eventPatternMatches(pattern, event string) (bool, error) {
+	var p map[string]interface{}
+	if err := json.Unmarshal([]byte(pattern), &p); err != nil {
+		return false, fmt.Errorf("parsing event pattern: %w", err)
+	}
+
+	var e map[string]interface{}
+	if err := json.Unmarshal([]byte(event), &e); err != nil {
+		return false, fmt.Errorf("parsing event: %w", err)
+	}
+
+	return matchEventPatternObject(p, e)
+}
+
+func matchEventPatternObject(pattern, event map[string]interface{}) (bool, error) {
+	for key, v := range pattern {
+		if key == "$or" {
+			alternatives, ok := v.([]interface{})
+			if !ok {
+				return false, fmt.Errorf("$or must be an array, got %T", v)
+			}
+
+			matched := false
+			for _, alternative := range alternatives {
+				alternative, ok := alternative.(map[string]interface{})
+				if !ok {
+					return false, fmt.Errorf("$or alternatives must be objects, got %T", alternative)
+				}
+
+				ok, err := matchEventPatternObject(alternative, event)
+				if err != nil {
+					return false, err
+				}
+
+				if ok {
+					matched = true
+					break
+				}
+			}
+
+			if !matched {
+				return false, nil
+			}
+
+			continue
+		}
+
+		value, present := event[key]
+
+		switch v := v.(type) {
+		case map[string]interface{}:
+			ok, err := matchEventPatternNested(v, value)
+			if err != nil {
+				return false, err
+			}
+
+			if !ok {
+				return false, nil
+			}
+		case []interface{}:
+			ok, err := matchEventPatternLeaf(key, v, value, present)
+			if err != nil {
+				return false, err
+			}
+
+			if !ok {
+				return false, nil
+			}
+		default:
+			return false, fmt.Errorf("value for %q must be an object or an array, got %T", key, v)
+		}
+	}
+
+	return true, nil
+}
+
+func matchEventPatternNested(pattern map[string]interface{}, value interface{}) (bool, error) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		return matchEventPatternObject(pattern, value)
+	case []interface{}:
+		// A nested pattern matches an array of objects if it matches any of them.
+		for _, v := range value {
+			if v, ok := v.(map[string]interface{}); ok {
+				ok, err := matchEventPatternObject(pattern, v)
+				if err != nil {
+					return false, err
+				}
+
+				if ok {
+					return true, nil
+				}
+			}
+		}
+
+		return false, nil
+	default:
+		// A missing object only matches patterns that require all of its fields to be absent.
+		return matchEventPatternObject(pattern, map[string]interface{}{})
+	}
+}
+
+func matchEventPatternLeaf(key string, matchers []interface{}, value interface{}, present bool) (bool, error) {
+	// The values in a pattern array are evaluated using a logical OR.
+	for _, matcher := range matchers {
+		ok, err := matchEventPatternMatcher(key, matcher, value, present)
+		if err != nil {
+			return false, err
+		}
+
+		if ok {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func matchEventPatternMatcher(key string, matcher interface{}, value interface{}, present bool) (bool, error) {
+	m, ok := matcher.(map[string]interface{})
+
+	if !ok {
+		// Exact match of a string, number, boolean or null.
+		return present && anyEventValue(value, func(v interface{}) bool { return eventValuesEqual(matcher, v) }), nil
+	}
+
+	if len(m) != 1 {
+		return false, fmt.Errorf("content filter for %q must have exactly one key, got %d", key, len(m))
+	}
+
+	for operator, operand := range m {
+		switch operator {
+		case "exists":
+			exists, ok := operand.(bool)
+			if !ok {
+				return false, fmt.Errorf("exists for %q must be a boolean, got %T", key, operand)
+			}
+
+			if _, isObject := value.(map[string]interface{}); isObject {
+				present = false
+			}
+
+			return present == exists, nil
+		case "anything-but":
+			if !present {
+				return false, nil
+			}
+
+			match, err := eventPatternAnythingButMatcher(key, operand)
+			if err != nil {
+				return false, err
+			}
+
+			return !anyEventValue(value, match), nil
+		default:
+			match, err := eventPatternStringMatcher(key, operator, operand)
+			if err != nil {
+				return false, err
+			}
+
+			return present && anyEventValue(value, match), nil
+		}
+	}
+
+	return false, nil
+}
+
+func eventPatternAnythingButMatcher(key string, operand interface{}) (func(interface{}) bool, error) {
+	switch operand := operand.(type) {
+	case []interface{}:
+		return func(v interface{}) bool {
+			for _, o := range operand {
+				if eventValuesEqual(o, v) {
+					return true
+				}
+			}
+
+			return false
+		}, nil
+	case map[string]interface{}:
+		if len(operand) != 1 {
+			return nil, fmt.Errorf("anything-but filter for %q must have exactly one key, got %d", key, len(operand))
+		}
+
+		for operator, v := range operand {
+			// anything-but equals-ignore-case and wildcard also accept an array of values.
+			if values, ok := v.([]interface{}); ok {
+				var matches []func(interface{}) bool
+				for _, v := range values {
+					match, err := eventPatternStringMatcher(key, operator, v)
+					if err != nil {
+						return nil, err
+					}
+					matches = append(matches, match)
+				}
+
+				return func(v interface{}) bool {
+					for _, match := range matches {
+						if match(v) {
+							return true
+						}
+					}
+
+					return false
+				}, nil
+			}
+
+			return eventPatternStringMatcher(key, operator, v)
+		}
+	}
+
+	return func(v interface{}) bool { return eventValuesEqual(operand, v) }, nil
+}
+
+func eventPatternStringMatcher(key, operator string, operand interface{}) (func(interface{}) bool, error) {
+	switch operator {
+	case "prefix", "suffix":
+		var s string
+		ignoreCase := false
+
+		switch operand := operand.(type) {
+		case string:
+			s = operand
+		case map[string]interface{}:
+			v, ok := operand["equals-ignore-case"].(string)
+			if !ok || len(operand) != 1 {
+				return nil, fmt.Errorf("%s filter for %q must be a string or an equals-ignore-case object", operator, key)
+			}
+			s = v
+			ignoreCase = true
+		default:
+			return nil, fmt.Errorf("%s filter for %q must be a string, got %T", operator, key, operand)
+		}
+
+		return func(v interface{}) bool {
+			value, ok := v.(string)
+			if !ok {
+				return false
+			}
+
+			if ignoreCase {
+				value, s := strings.ToLower(value), strings.ToLower(s)
+				if operator == "prefix" {
+					return strings.HasPrefix(value, s)
+				}
+				return strings.HasSuffix(value, s)
+			}
+
+			if operator == "prefix" {
+				return strings.HasPrefix(value, s)
+			}
+			return strings.HasSuffix(value, s)
+		}, nil
+	case "equals-ignore-case":
+		s, ok := operand.(string)
+		if !ok {
+			return nil, fmt.Errorf("equals-ignore-case filter for %q must be a string, got %T", key, operand)
+		}
+
+		return func(v interface{}) bool {
+			value, ok := v.(string)
+			return ok && strings.EqualFold(value, s)
+		}, nil
+	case "wildcard":
+		s, ok := operand.(string)
+		if !ok {
+			return nil, fmt.Errorf("wildcard filter for %q must be a string, got %T", key, operand)
+		}
+
+		return func(v interface{}) bool {
+			value, ok := v.(string)
+			return ok && matchEventPatternWildcard(s, value)
+		}, nil
+	case "cidr":
+		s, ok := operand.(string)
+		if !ok {
+			return nil, fmt.Errorf("cidr filter for %q must be a string, got %T", key, operand)
+		}
+
+		_, network, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("cidr filter for %q: %w", key, err)
+		}
+
+		return func(v interface{}) bool {
+			value, ok := v.(string)
+			if !ok {
+				return false
+			}
+
+			ip := net.ParseIP(value)
+			return ip != nil && network.Contains(ip)
+		}, nil
+	case "numeric":
+		conditions, ok := operand.([]interface{})
+		if !ok || len(conditions) == 0 || len(conditions)%2 != 0 {
+			return nil, fmt.Errorf("numeric filter for %q must be an array of operator and value pairs", key)
+		}
+
+		var compares []func(float64) bool
+		for i := 0; i < len(conditions); i += 2 {
+			operator, ok := conditions[i].(string)
+			if !ok {
+				return nil, fmt.Errorf("numeric filter for %q: operator must be a string, got %T", key, conditions[i])
+			}
+
+			n, ok := conditions[i+1].(float64)
+			if !ok {
+				return nil, fmt.Errorf("numeric filter for %q: value must be a number, got %T", key, conditions[i+1])
+			}
+
+			switch operator {
+			case "=":
+				compares = append(compares, func(v float64) bool { return v == n })
+			case "<":
+				compares = append(compares, func(v float64) bool { return v < n })
+			case "<=":
+				compares = append(compares, func(v float64) bool { return v <= n })
+			case ">":
+				compares = append(compares, func(v float64) bool { return v > n })
+			case ">=":
+				compares = append(compares, func(v float64) bool { return v >= n })
+			default:
+				return nil, fmt.Errorf("numeric filter for %q: unsupported operator %q", key, operator)
+			}
+		}
+
+		return func(v interface{}) bool {
+			value, ok := v.(float64)
+			if !ok {
+				return false
+			}
+
+			for _, compare := range compares {
+				if !compare(value) {
+					return false
+				}
+			}
+
+			return true
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported content filter %q for %q", operator, key)
+}
+
+// anyEventValue returns whether the predicate is true for the event value or, if the event value is an array, any of its elements.
+func anyEventValue(value interface{}, f func(interface{}) bool) bool {
+	if values, ok := value.([]interface{}); ok {
+		for _, v := range values {
+			if f(v) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return f(value)
+}
+
+func eventValuesEqual(a, b interface{}) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case string:
+		v, ok := b.(string)
+		return ok && a == v
+	case float64:
+		v, ok := b.(float64)
+		return ok && a == v
+	case bool:
+		v, ok := b.(bool)
+		return ok && a == v
+	}
+
+	return false
+}
+
+// matchEventPatternWildcard matches a value against a pattern where '*' matches any sequence of characters.
+// A '*' can be escaped with a '\'.
+func matchEventPatternWildcard(pattern, value string) bool {
+	type token struct {
+		r        rune
+		wildcard bool
+	}
+
+	var tokens []token
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			tokens = append(tokens, token{r: r})
+			escaped = false
+		case r == '\\':
+			escaped = true
+		default:
+			tokens = append(tokens, token{r: r, wildcard: r == '*'})
+		}
+	}
+
+	v := []rune(value)
+	p, i, star, mark := 0, 0, -1, 0
+
+	for i < len(v) {
+		if p < len(tokens) && tokens[p].wildcard {
+			star, mark = p, i
+			p++
+			continue
+		}
+
+		if p < len(tokens) && tokens[p].r == v[i] {
+			p++
+			i++
+			continue
+		}
+
+		if star >= 0 {
+			p = star + 1
+			mark++
+			i = mark
+			continue
+		}
+
+		return false
+	}
+
+	for p < len(tokens) && tokens[p].wildcard {
+		p++
+	}
+
+	return p == len(tokens)
+}
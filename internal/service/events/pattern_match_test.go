@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"testing"
+)
+
+func TestEventPatternMatches(t *testing.T) {
+	t.Parallel()
+
+	const event = `{
+  "version": "0",
+  "id": "6a7e8feb-b491-4cf7-a9f1-bf3703467718",
+  "detail-type": "EC2 Instance State-change Notification",
+  "source": "aws.ec2",
+  "account": "123456789012",
+  "time": "2017-12-22T18:43:48Z",
+  "region": "us-west-1",
+  "resources": ["arn:aws:ec2:us-west-1:123456789012:instance/i-1234567890abcdef0"],
+  "detail": {
+    "instance-id": "i-1234567890abcdef0",
+    "state": "terminated",
+    "c-count": 5,
+    "d-count": 4,
+    "x-limit": 3.018e2,
+    "source-ip": "10.0.0.255",
+    "tags": ["prod", "web"],
+    "deleted": false,
+    "reason": null,
+    "placement": {"availability-zone": "us-west-1a"}
+  }
+}`
+
+	testcases := map[string]struct {
+		pattern string
+		want    bool
+		wantErr bool
+	}{
+		"exact": {
+			pattern: `{"source":["aws.ec2"],"detail":{"state":["running","terminated"]}}`,
+			want:    true,
+		},
+		"exact mismatch": {
+			pattern: `{"source":["aws.ec2"],"detail":{"state":["running"]}}`,
+		},
+		"exact number": {
+			pattern: `{"detail":{"c-count":[5]}}`,
+			want:    true,
+		},
+		"number is not string": {
+			pattern: `{"detail":{"c-count":["5"]}}`,
+		},
+		"boolean": {
+			pattern: `{"detail":{"deleted":[false]}}`,
+			want:    true,
+		},
+		"null": {
+			pattern: `{"detail":{"reason":[null]}}`,
+			want:    true,
+		},
+		"array intersection": {
+			pattern: `{"detail":{"tags":["web","api"]}}`,
+			want:    true,
+		},
+		"prefix": {
+			pattern: `{"detail-type":[{"prefix":"EC2 Instance"}]}`,
+			want:    true,
+		},
+		"prefix equals-ignore-case": {
+			pattern: `{"detail-type":[{"prefix":{"equals-ignore-case":"ec2 instance"}}]}`,
+			want:    true,
+		},
+		"suffix": {
+			pattern: `{"detail":{"instance-id":[{"suffix":"def1"}]}}`,
+		},
+		"equals-ignore-case": {
+			pattern: `{"detail":{"state":[{"equals-ignore-case":"TERMINATED"}]}}`,
+			want:    true,
+		},
+		"wildcard": {
+			pattern: `{"resources":[{"wildcard":"arn:aws:ec2:*:123456789012:instance/*"}]}`,
+			want:    true,
+		},
+		"anything-but": {
+			pattern: `{"detail":{"state":[{"anything-but":"running"}]}}`,
+			want:    true,
+		},
+		"anything-but list": {
+			pattern: `{"detail":{"state":[{"anything-but":["stopped","terminated"]}]}}`,
+		},
+		"anything-but prefix": {
+			pattern: `{"detail":{"state":[{"anything-but":{"prefix":"term"}}]}}`,
+		},
+		"anything-but missing field": {
+			pattern: `{"detail":{"missing":[{"anything-but":"running"}]}}`,
+		},
+		"numeric range": {
+			pattern: `{"detail":{"c-count":[{"numeric":[">",0,"<=",5]}],"x-limit":[{"numeric":["=",301.8]}]}}`,
+			want:    true,
+		},
+		"numeric out of range": {
+			pattern: `{"detail":{"d-count":[{"numeric":["<",4]}]}}`,
+		},
+		"exists": {
+			pattern: `{"detail":{"state":[{"exists":true}],"missing":[{"exists":false}]}}`,
+			want:    true,
+		},
+		"exists missing field": {
+			pattern: `{"detail":{"missing":[{"exists":true}]}}`,
+		},
+		"exists on object": {
+			pattern: `{"detail":{"placement":[{"exists":true}]}}`,
+		},
+		"cidr": {
+			pattern: `{"detail":{"source-ip":[{"cidr":"10.0.0.0/24"}]}}`,
+			want:    true,
+		},
+		"or": {
+			pattern: `{"source":["aws.ec2"],"$or":[{"detail":{"c-count":[{"numeric":[">",10]}]}},{"detail":{"d-count":[{"numeric":["<",10]}]}}]}`,
+			want:    true,
+		},
+		"or no match": {
+			pattern: `{"$or":[{"detail":{"c-count":[{"numeric":[">",10]}]}},{"source":["aws.s3"]}]}`,
+		},
+		"nested": {
+			pattern: `{"detail":{"placement":{"availability-zone":[{"prefix":"us-west-1"}]}}}`,
+			want:    true,
+		},
+		"unsupported filter": {
+			pattern: `{"detail":{"state":[{"regex":"^t"}]}}`,
+			wantErr: true,
+		},
+		"invalid numeric operator": {
+			pattern: `{"detail":{"c-count":[{"numeric":["!=",5]}]}}`,
+			wantErr: true,
+		},
+		"invalid value": {
+			pattern: `{"source":"aws.ec2"}`,
+			wantErr: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := eventPatternMatches(testcase.pattern, event)
+
+			if got, want := err != nil, testcase.wantErr; got != want {
+				t.Fatalf("err = %v, want error %t", err, want)
+			}
+
+			if got != testcase.want {
+				t.Errorf("got %t, want %t", got, testcase.want)
+			}
+		})
+	}
+}
+
+func TestMatchEventPatternWildcard(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		pattern string
+		value   string
+		want    bool
+	}{
+		{pattern: "*", value: "", want: true},
+		{pattern: "dir/*.png", value: "dir/image.png", want: true},
+		{pattern: "dir/*.png", value: "dir/image.jpg", want: false},
+		{pattern: "*/*/*.png", value: "a/b/c.png", want: true},
+		{pattern: `a\*b`, value: "a*b", want: true},
+		{pattern: `a\*b`, value: "axb", want: false},
+	}
+
+	for _, testcase := range testcases {
+		if got := matchEventPatternWildcard(testcase.pattern, testcase.value); got != testcase.want {
+			t.Errorf("matchEventPatternWildcard(%q, %q) = %t, want %t", testcase.pattern, testcase.value, got, testcase.want)
+		}
+	}
+}
@@ -7,14 +7,17 @@
 package glacier
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/glacier"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -22,6 +25,7 @@ func init() {
 		Name: "aws_glacier_vault",
 		F:    sweepVaults,
 	})
+	discovery.Register("aws_glacier_vault", listVaults)
 }
 
 func sweepVaults(region string) error {
@@ -30,6 +34,28 @@ func sweepVaults(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
+
+	sweepResources, err := listVaults(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Glacier Vault sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Glacier Vaults (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Glacier Vaults (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listVaults(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &glacier.ListVaultsInput{}
 	conn := client.GlacierClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
@@ -38,13 +64,8 @@ func sweepVaults(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Glacier Vault sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error listing Glacier Vaults (%s): %w", region, err)
+			return nil, err
 		}
 
 		for _, v := range page.VaultList {
@@ -56,11 +77,5 @@ func sweepVaults(region string) error {
 		}
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping Glacier Vaults (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
@@ -7,6 +7,7 @@
 package glue
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -16,7 +17,9 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -24,36 +27,43 @@ func init() {
 		Name: "aws_glue_catalog_database",
 		F:    sweepCatalogDatabases,
 	})
+	discovery.Register("aws_glue_catalog_database", listCatalogDatabases)
 
 	resource.AddTestSweepers("aws_glue_classifier", &resource.Sweeper{
 		Name: "aws_glue_classifier",
 		F:    sweepClassifiers,
 	})
+	discovery.Register("aws_glue_classifier", listClassifiers)
 
 	resource.AddTestSweepers("aws_glue_connection", &resource.Sweeper{
 		Name: "aws_glue_connection",
 		F:    sweepConnections,
 	})
+	discovery.Register("aws_glue_connection", listConnections)
 
 	resource.AddTestSweepers("aws_glue_crawler", &resource.Sweeper{
 		Name: "aws_glue_crawler",
 		F:    sweepCrawlers,
 	})
+	discovery.Register("aws_glue_crawler", listCrawlers)
 
 	resource.AddTestSweepers("aws_glue_dev_endpoint", &resource.Sweeper{
 		Name: "aws_glue_dev_endpoint",
 		F:    sweepDevEndpoints,
 	})
+	discovery.Register("aws_glue_dev_endpoint", listDevEndpoints)
 
 	resource.AddTestSweepers("aws_glue_job", &resource.Sweeper{
 		Name: "aws_glue_job",
 		F:    sweepJobs,
 	})
+	discovery.Register("aws_glue_job", listJobs)
 
 	resource.AddTestSweepers("aws_glue_ml_transform", &resource.Sweeper{
 		Name: "aws_glue_ml_transform",
 		F:    sweepMLTransforms,
 	})
+	discovery.Register("aws_glue_ml_transform", listMLTransforms)
 
 	resource.AddTestSweepers("aws_glue_registry", &resource.Sweeper{
 		Name: "aws_glue_registry",
@@ -74,6 +84,7 @@ func init() {
 		Name: "aws_glue_trigger",
 		F:    sweepTriggers,
 	})
+	discovery.Register("aws_glue_trigger", listTriggers)
 
 	resource.AddTestSweepers("aws_glue_workflow", &resource.Sweeper{
 		Name: "aws_glue_workflow",
@@ -87,13 +98,32 @@ func sweepCatalogDatabases(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	var sweeperErrs *multierror.Error
+
+	sweepResources, err := listCatalogDatabases(ctx, client)
+	if err != nil {
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Glue Catalog Database sweep for %s: %s", region, err)
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Glue Catalog Databases: %s", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Glue Catalog Databases: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func listCatalogDatabases(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.GlueConn(ctx)
 
 	sweepResources := make([]sweep.Sweepable, 0)
-	var sweeperErrs *multierror.Error
 
 	input := &glue.GetDatabasesInput{}
-	err = conn.GetDatabasesPagesWithContext(ctx, input, func(page *glue.GetDatabasesOutput, lastPage bool) bool {
+	err := conn.GetDatabasesPagesWithContext(ctx, input, func(page *glue.GetDatabasesOutput, lastPage bool) bool {
 		if len(page.DatabaseList) == 0 {
 			log.Printf("[INFO] No Glue Catalog Databases to sweep")
 			return false
@@ -113,34 +143,46 @@ func sweepCatalogDatabases(region string) error {
 		}
 		return !lastPage
 	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepClassifiers(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	var sweeperErrs *multierror.Error
+
+	sweepResources, err := listClassifiers(ctx, client)
 	if err != nil {
 		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Glue Catalog Database sweep for %s: %s", region, err)
+			log.Printf("[WARN] Skipping Glue Classifier sweep for %s: %s", region, err)
 			return nil
 		}
-		return fmt.Errorf("Error retrieving Glue Catalog Databases: %s", err)
+		return fmt.Errorf("Error retrieving Glue Classifiers: %s", err)
 	}
 
 	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Glue Catalog Databases: %w", err))
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Glue Classifiers: %w", err))
 	}
 
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepClassifiers(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func listClassifiers(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.GlueConn(ctx)
 
 	sweepResources := make([]sweep.Sweepable, 0)
-	var sweeperErrs *multierror.Error
 
 	input := &glue.GetClassifiersInput{}
-	err = conn.GetClassifiersPagesWithContext(ctx, input, func(page *glue.GetClassifiersOutput, lastPage bool) bool {
+	err := conn.GetClassifiersPagesWithContext(ctx, input, func(page *glue.GetClassifiersOutput, lastPage bool) bool {
 		if len(page.Classifiers) == 0 {
 			log.Printf("[INFO] No Glue Classifiers to sweep")
 			return false
@@ -170,37 +212,49 @@ func sweepClassifiers(region string) error {
 		}
 		return !lastPage
 	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepConnections(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	var sweeperErrs *multierror.Error
+
+	sweepResources, err := listConnections(ctx, client)
 	if err != nil {
 		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Glue Classifier sweep for %s: %s", region, err)
+			log.Printf("[WARN] Skipping Glue Connection sweep for %s: %s", region, err)
 			return nil
 		}
-		return fmt.Errorf("Error retrieving Glue Classifiers: %s", err)
+		return fmt.Errorf("Error retrieving Glue Connections: %s", err)
 	}
 
 	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Glue Classifiers: %w", err))
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping API Gateway VPC Links: %w", err))
 	}
 
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func listConnections(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.GlueConn(ctx)
 	catalogID := client.AccountID
 
 	sweepResources := make([]sweep.Sweepable, 0)
-	var sweeperErrs *multierror.Error
 
 	input := &glue.GetConnectionsInput{
 		CatalogId: aws.String(catalogID),
 	}
-	err = conn.GetConnectionsPagesWithContext(ctx, input, func(page *glue.GetConnectionsOutput, lastPage bool) bool {
+	err := conn.GetConnectionsPagesWithContext(ctx, input, func(page *glue.GetConnectionsOutput, lastPage bool) bool {
 		if len(page.ConnectionList) == 0 {
 			log.Printf("[INFO] No Glue Connections to sweep")
 			return false
@@ -217,12 +271,30 @@ func sweepConnections(region string) error {
 		}
 		return !lastPage
 	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepCrawlers(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	var sweeperErrs *multierror.Error
+
+	sweepResources, err := listCrawlers(ctx, client)
 	if err != nil {
 		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Glue Connection sweep for %s: %s", region, err)
+			log.Printf("[WARN] Skipping Glue Crawler sweep for %s: %s", region, err)
 			return nil
 		}
-		return fmt.Errorf("Error retrieving Glue Connections: %s", err)
+		return fmt.Errorf("Error retrieving Glue Crawlers: %s", err)
 	}
 
 	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
@@ -232,19 +304,13 @@ func sweepConnections(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepCrawlers(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func listCrawlers(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.GlueConn(ctx)
 
 	sweepResources := make([]sweep.Sweepable, 0)
-	var sweeperErrs *multierror.Error
 
 	input := &glue.GetCrawlersInput{}
-	err = conn.GetCrawlersPagesWithContext(ctx, input, func(page *glue.GetCrawlersOutput, lastPage bool) bool {
+	err := conn.GetCrawlersPagesWithContext(ctx, input, func(page *glue.GetCrawlersOutput, lastPage bool) bool {
 		if len(page.Crawlers) == 0 {
 			log.Printf("[INFO] No Glue Crawlers to sweep")
 			return false
@@ -260,19 +326,12 @@ func sweepCrawlers(region string) error {
 		}
 		return !lastPage
 	})
-	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Glue Crawler sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving Glue Crawlers: %s", err)
-	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping API Gateway VPC Links: %w", err))
+	if err != nil {
+		return nil, err
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepResources, nil
 }
 
 func sweepDevEndpoints(region string) error {
@@ -281,11 +340,33 @@ func sweepDevEndpoints(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listDevEndpoints(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Glue Dev Endpoint sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Glue Dev Endpoints (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Glue Dev Endpoints (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listDevEndpoints(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &glue.GetDevEndpointsInput{}
 	conn := client.GlueConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.GetDevEndpointsPagesWithContext(ctx, input, func(page *glue.GetDevEndpointsOutput, lastPage bool) bool {
+	err := conn.GetDevEndpointsPagesWithContext(ctx, input, func(page *glue.GetDevEndpointsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -307,35 +388,46 @@ func sweepDevEndpoints(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepJobs(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	sweepResources, err := listJobs(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Glue Dev Endpoint sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping Glue Job sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing Glue Dev Endpoints (%s): %w", region, err)
+		return fmt.Errorf("error listing Glue Jobs (%s): %w", region, err)
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping Glue Dev Endpoints (%s): %w", region, err)
+		return fmt.Errorf("error sweeping Glue Jobs (%s): %w", region, err)
 	}
 
 	return nil
 }
 
-func sweepJobs(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func listJobs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &glue.GetJobsInput{}
 	conn := client.GlueConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.GetJobsPagesWithContext(ctx, input, func(page *glue.GetJobsOutput, lastPage bool) bool {
+	err := conn.GetJobsPagesWithContext(ctx, input, func(page *glue.GetJobsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -351,22 +443,11 @@ func sweepJobs(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Glue Job sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("error listing Glue Jobs (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
 	if err != nil {
-		return fmt.Errorf("error sweeping Glue Jobs (%s): %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
 
 func sweepMLTransforms(region string) error {
@@ -375,13 +456,32 @@ func sweepMLTransforms(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
+
+	var sweeperErrs *multierror.Error
+
+	sweepResources, err := listMLTransforms(ctx, client)
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Glue ML Transforms sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Glue ML Transforms: %w", err))
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Glue ML Transforms: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func listMLTransforms(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.GlueConn(ctx)
 
 	sweepResources := make([]sweep.Sweepable, 0)
-	var sweeperErrs *multierror.Error
 
 	input := &glue.GetMLTransformsInput{}
-	err = conn.GetMLTransformsPagesWithContext(ctx, input, func(page *glue.GetMLTransformsOutput, lastPage bool) bool {
+	err := conn.GetMLTransformsPagesWithContext(ctx, input, func(page *glue.GetMLTransformsOutput, lastPage bool) bool {
 		if len(page.Transforms) == 0 {
 			log.Printf("[INFO] No Glue ML Transforms to sweep")
 			return false
@@ -398,19 +498,12 @@ func sweepMLTransforms(region string) error {
 		}
 		return !lastPage
 	})
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Glue ML Transforms sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-	}
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Glue ML Transforms: %w", err))
-	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Glue ML Transforms: %w", err))
+	if err != nil {
+		return nil, err
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepResources, nil
 }
 
 func sweepRegistry(region string) error {
@@ -533,13 +626,32 @@ func sweepTriggers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	var sweeperErrs *multierror.Error
+
+	sweepResources, err := listTriggers(ctx, client)
+	if err != nil {
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Glue Trigger sweep for %s: %s", region, err)
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Glue Triggers: %s", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Glue Triggers: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func listTriggers(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.GlueConn(ctx)
 
 	sweepResources := make([]sweep.Sweepable, 0)
-	var sweeperErrs *multierror.Error
 
 	input := &glue.GetTriggersInput{}
-	err = conn.GetTriggersPagesWithContext(ctx, input, func(page *glue.GetTriggersOutput, lastPage bool) bool {
+	err := conn.GetTriggersPagesWithContext(ctx, input, func(page *glue.GetTriggersOutput, lastPage bool) bool {
 		if page == nil || len(page.Triggers) == 0 {
 			log.Printf("[INFO] No Glue Triggers to sweep")
 			return false
@@ -556,19 +668,12 @@ func sweepTriggers(region string) error {
 		}
 		return !lastPage
 	})
-	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Glue Trigger sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving Glue Triggers: %s", err)
-	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Glue Triggers: %w", err))
+	if err != nil {
+		return nil, err
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepResources, nil
 }
 
 func sweepWorkflow(region string) error {
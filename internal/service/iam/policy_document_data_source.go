@@ -39,6 +39,25 @@ func DataSourcePolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"lint": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(policyLintPolicyType_Values(), false),
+						},
+						"warnings_as_errors": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 			"override_policy_documents": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -261,6 +280,37 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if v, ok := d.GetOk("lint"); ok && len(v.([]interface{})) > 0 {
+		var policyType string
+		var warningsAsErrors bool
+		if tfMap, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			policyType = tfMap["policy_type"].(string)
+			warningsAsErrors = tfMap["warnings_as_errors"].(bool)
+		}
+
+		findings, err := lintPolicyDocument(mergedDoc, policyType)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "linting IAM Policy Document: %s", err)
+		}
+
+		for _, finding := range findings {
+			severity := diag.Warning
+			if finding.Error || warningsAsErrors {
+				severity = diag.Error
+			}
+
+			diags = append(diags, diag.Diagnostic{
+				Severity: severity,
+				Summary:  finding.Summary,
+				Detail:   finding.Detail,
+			})
+		}
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
 	if err != nil {
 		// should never happen if the above code is correct
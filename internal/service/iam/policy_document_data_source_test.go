@@ -1614,3 +1614,89 @@ data "aws_iam_policy_document" "test" {
   override_policy_documents = ["{"]
 }
 `
+
+func TestAccIAMPolicyDocumentDataSource_lint(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_lint("sqs:SendMessage", "StringEquals", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "json"),
+				),
+			},
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_lint("sqs:SendMessage", "StringEqual", false),
+				ExpectError: regexache.MustCompile(`Unknown IAM policy condition operator`),
+			},
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_lint("sqs SendMessage", "StringEquals", false),
+				ExpectError: regexache.MustCompile(`Malformed IAM policy action`),
+			},
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_lint("sqs:SendMesage", "StringEquals", true),
+				ExpectError: regexache.MustCompile(`Unknown IAM policy action`),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_lintPolicyType(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_lintPolicyType("inline_user"),
+				ExpectError: regexache.MustCompile(`IAM policy too large`),
+			},
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_lintPolicyType("inline_role"),
+			},
+		},
+	})
+}
+
+func testAccPolicyDocumentDataSourceConfig_lint(action, test string, warningsAsErrors bool) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = [%[1]q]
+    resources = ["*"]
+
+    condition {
+      test     = %[2]q
+      variable = "aws:SourceAccount"
+      values   = ["123456789012"]
+    }
+  }
+
+  lint {
+    warnings_as_errors = %[3]t
+  }
+}
+`, action, test, warningsAsErrors)
+}
+
+func testAccPolicyDocumentDataSourceConfig_lintPolicyType(policyType string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["sqs:SendMessage"]
+    resources = [for i in range(60) : format("arn:aws:sqs:us-west-2:123456789012:queue-%%03d", i)]
+  }
+
+  lint {
+    policy_type = %[1]q
+  }
+}
+`, policyType)
+}
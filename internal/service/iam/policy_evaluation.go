@@ -0,0 +1,716 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// Decision keywords returned by the local policy evaluator. These match the
+// keywords returned by the IAM policy simulator.
+const (
+	policyEvaluationDecisionAllowed      = "allowed"
+	policyEvaluationDecisionExplicitDeny = "explicitDeny"
+	policyEvaluationDecisionImplicitDeny = "implicitDeny"
+)
+
+// Types of policy understood by the local policy evaluator.
+const (
+	policyEvaluationPolicyTypeIdentity            = "identity"
+	policyEvaluationPolicyTypePermissionsBoundary = "permissions-boundary"
+	policyEvaluationPolicyTypeResource            = "resource"
+	policyEvaluationPolicyTypeServiceControl      = "service-control"
+)
+
+const (
+	policyVersion2012 = "2012-10-17"
+)
+
+type policyEvaluationPolicy struct {
+	ID       string
+	Type     string
+	Document *IAMPolicyDoc
+}
+
+type policyEvaluationRequest struct {
+	Action    string
+	Resource  string
+	Principal string
+	// Context is keyed by lower-cased context key name, as context key names are case-insensitive.
+	Context map[string][]string
+}
+
+type policyEvaluationMatchedStatement struct {
+	Effect           string
+	Sid              string
+	SourcePolicyID   string
+	SourcePolicyType string
+}
+
+type policyEvaluationResult struct {
+	Action             string
+	Decision           string
+	MatchedStatements  []policyEvaluationMatchedStatement
+	MissingContextKeys []string
+	Resource           string
+}
+
+// policyEvaluator evaluates requests against policy documents locally, following the
+// AWS policy evaluation logic for a single account:
+//
+//  1. An explicit Deny in any policy results in "explicitDeny".
+//  2. If service control policies are specified, one of them must Allow the request.
+//  3. An Allow in a resource-based policy results in "allowed".
+//  4. If permissions boundaries are specified, one of them must Allow the request.
+//  5. An Allow in an identity-based policy results in "allowed".
+//  6. Otherwise the result is "implicitDeny".
+type policyEvaluator struct {
+	policies []policyEvaluationPolicy
+}
+
+func newPolicyEvaluator(policies ...policyEvaluationPolicy) *policyEvaluator {
+	return &policyEvaluator{
+		policies: policies,
+	}
+}
+
+// parsePolicyEvaluationPolicy parses a JSON policy document for use by the local policy evaluator.
+func parsePolicyEvaluationPolicy(id, policyType, document string) (policyEvaluationPolicy, error) {
+	policy := policyEvaluationPolicy{
+		ID:   id,
+		Type: policyType,
+	}
+
+	// The Statement element may be a single statement object rather than an array.
+	var raw struct {
+		Version   string
+		Id        string
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal([]byte(document), &raw); err != nil {
+		return policy, fmt.Errorf("parsing %s policy (%s): %w", policyType, id, err)
+	}
+
+	doc := &IAMPolicyDoc{
+		Version: raw.Version,
+		Id:      raw.Id,
+	}
+
+	if statement := bytes.TrimSpace(raw.Statement); len(statement) > 0 {
+		if statement[0] == '{' {
+			statement = append(append([]byte{'['}, statement...), ']')
+		}
+
+		if err := json.Unmarshal(statement, &doc.Statements); err != nil {
+			return policy, fmt.Errorf("parsing %s policy (%s) statements: %w", policyType, id, err)
+		}
+	}
+
+	for i, statement := range doc.Statements {
+		if statement == nil {
+			return policy, fmt.Errorf("%s policy (%s) statement %d is empty", policyType, id, i)
+		}
+
+		if statement.Effect != "Allow" && statement.Effect != "Deny" {
+			return policy, fmt.Errorf("%s policy (%s) statement %d: unsupported Effect %q", policyType, id, i, statement.Effect)
+		}
+
+		for _, condition := range statement.Conditions {
+			if _, err := parsePolicyConditionOperator(condition.Test); err != nil {
+				return policy, fmt.Errorf("%s policy (%s) statement %d: %w", policyType, id, i, err)
+			}
+		}
+	}
+
+	policy.Document = doc
+
+	return policy, nil
+}
+
+func (e *policyEvaluator) evaluate(request policyEvaluationRequest) *policyEvaluationResult {
+	result := &policyEvaluationResult{
+		Action:   request.Action,
+		Resource: request.Resource,
+	}
+
+	var allows, denies []policyEvaluationMatchedStatement
+	present := make(map[string]bool)
+	allowed := make(map[string]bool)
+	missing := make(map[string]struct{})
+
+	for _, policy := range e.policies {
+		present[policy.Type] = true
+
+		for _, statement := range policy.Document.Statements {
+			if !statementMatches(policy, statement, request, missing) {
+				continue
+			}
+
+			match := policyEvaluationMatchedStatement{
+				Effect:           statement.Effect,
+				Sid:              statement.Sid,
+				SourcePolicyID:   policy.ID,
+				SourcePolicyType: policy.Type,
+			}
+
+			if statement.Effect == "Deny" {
+				denies = append(denies, match)
+			} else {
+				allows = append(allows, match)
+				allowed[policy.Type] = true
+			}
+		}
+	}
+
+	for k := range missing {
+		result.MissingContextKeys = append(result.MissingContextKeys, k)
+	}
+	sort.Strings(result.MissingContextKeys)
+
+	switch {
+	case len(denies) > 0:
+		result.Decision = policyEvaluationDecisionExplicitDeny
+		result.MatchedStatements = denies
+	case present[policyEvaluationPolicyTypeServiceControl] && !allowed[policyEvaluationPolicyTypeServiceControl]:
+		result.Decision = policyEvaluationDecisionImplicitDeny
+	case allowed[policyEvaluationPolicyTypeResource]:
+		result.Decision = policyEvaluationDecisionAllowed
+		result.MatchedStatements = allows
+	case present[policyEvaluationPolicyTypePermissionsBoundary] && !allowed[policyEvaluationPolicyTypePermissionsBoundary]:
+		result.Decision = policyEvaluationDecisionImplicitDeny
+	case allowed[policyEvaluationPolicyTypeIdentity]:
+		result.Decision = policyEvaluationDecisionAllowed
+		result.MatchedStatements = allows
+	default:
+		result.Decision = policyEvaluationDecisionImplicitDeny
+	}
+
+	return result
+}
+
+func statementMatches(policy policyEvaluationPolicy, statement *IAMPolicyStatement, request policyEvaluationRequest, missing map[string]struct{}) bool {
+	variables := policy.Document.Version == policyVersion2012
+
+	if policy.Type == policyEvaluationPolicyTypeResource {
+		if statement.Principals != nil && !principalMatches(statement.Principals, request.Principal) {
+			return false
+		}
+
+		if statement.NotPrincipals != nil && principalMatches(statement.NotPrincipals, request.Principal) {
+			return false
+		}
+	}
+
+	switch {
+	case statement.Actions != nil:
+		if !anyPolicyPatternMatches(policyStringSlice(statement.Actions), request.Action, false, true, request, missing) {
+			return false
+		}
+	case statement.NotActions != nil:
+		if anyPolicyPatternMatches(policyStringSlice(statement.NotActions), request.Action, false, true, request, missing) {
+			return false
+		}
+	default:
+		return false
+	}
+
+	switch {
+	case statement.Resources != nil:
+		if !anyPolicyPatternMatches(policyStringSlice(statement.Resources), request.Resource, variables, false, request, missing) {
+			return false
+		}
+	case statement.NotResources != nil:
+		if anyPolicyPatternMatches(policyStringSlice(statement.NotResources), request.Resource, variables, false, request, missing) {
+			return false
+		}
+	default:
+		// Resource-based policies may omit the Resource element, in which case the statement applies to the resource the policy is attached to.
+		if policy.Type != policyEvaluationPolicyTypeResource {
+			return false
+		}
+	}
+
+	// Each condition must be satisfied.
+	for _, condition := range statement.Conditions {
+		if !conditionMatches(condition, variables, request, missing) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func principalMatches(principals IAMPolicyStatementPrincipalSet, principal string) bool {
+	for _, p := range principals {
+		for _, identifier := range policyStringSlice(p.Identifiers) {
+			if identifier == "*" && (p.Type == "*" || p.Type == "AWS") {
+				return true
+			}
+
+			if principal == "" {
+				continue
+			}
+
+			if identifier == principal {
+				return true
+			}
+
+			// An account ID or account root ARN matches any principal in that account.
+			if p.Type == "AWS" {
+				if v, err := arn.Parse(principal); err == nil {
+					if identifier == v.AccountID || identifier == fmt.Sprintf("arn:%s:iam::%s:root", v.Partition, v.AccountID) {
+						return true
+					}
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func anyPolicyPatternMatches(patterns []string, value string, variables, ignoreCase bool, request policyEvaluationRequest, missing map[string]struct{}) bool {
+	for _, pattern := range patterns {
+		tokens, ok := expandPolicyPattern(pattern, variables, request, missing)
+
+		if ok && matchPolicyPattern(tokens, []rune(value), ignoreCase) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// policyPatternToken is a single character in a policy pattern.
+// Wildcard tokens are unescaped '*' or '?' characters.
+type policyPatternToken struct {
+	r        rune
+	wildcard bool
+}
+
+// expandPolicyPattern tokenizes a policy pattern, substituting any policy variables from the request context.
+// Returns false if a policy variable has no value in the request context.
+func expandPolicyPattern(pattern string, variables bool, request policyEvaluationRequest, missing map[string]struct{}) ([]policyPatternToken, bool) {
+	var tokens []policyPatternToken
+
+	literal := func(s string) {
+		for _, r := range s {
+			tokens = append(tokens, policyPatternToken{r: r})
+		}
+	}
+
+	for len(pattern) > 0 {
+		if variables && strings.HasPrefix(pattern, "${") {
+			if end := strings.Index(pattern, "}"); end > 0 {
+				key, defaultValue, hasDefault := strings.Cut(pattern[2:end], ",")
+				key = strings.TrimSpace(key)
+				pattern = pattern[end+1:]
+
+				switch key {
+				case "*", "?", "$":
+					literal(key)
+					continue
+				}
+
+				if v := request.Context[strings.ToLower(key)]; len(v) == 1 {
+					literal(v[0])
+				} else if hasDefault {
+					literal(strings.Trim(strings.TrimSpace(defaultValue), "'"))
+				} else {
+					missing[key] = struct{}{}
+					return nil, false
+				}
+
+				continue
+			}
+		}
+
+		r, size := utf8.DecodeRuneInString(pattern)
+		pattern = pattern[size:]
+		tokens = append(tokens, policyPatternToken{r: r, wildcard: r == '*' || r == '?'})
+	}
+
+	return tokens, true
+}
+
+// matchPolicyPattern matches a value against a tokenized pattern where '*' matches any sequence of characters
+// and '?' matches any single character.
+func matchPolicyPattern(tokens []policyPatternToken, value []rune, ignoreCase bool) bool {
+	p, v, star, mark := 0, 0, -1, 0
+
+	for v < len(value) {
+		if p < len(tokens) && tokens[p].wildcard && tokens[p].r == '*' {
+			star, mark = p, v
+			p++
+			continue
+		}
+
+		if p < len(tokens) && ((tokens[p].wildcard && tokens[p].r == '?') || runesEqual(tokens[p].r, value[v], ignoreCase)) {
+			p++
+			v++
+			continue
+		}
+
+		if star >= 0 {
+			p = star + 1
+			mark++
+			v = mark
+			continue
+		}
+
+		return false
+	}
+
+	for p < len(tokens) && tokens[p].wildcard && tokens[p].r == '*' {
+		p++
+	}
+
+	return p == len(tokens)
+}
+
+func runesEqual(a, b rune, ignoreCase bool) bool {
+	if ignoreCase {
+		return unicode.ToLower(a) == unicode.ToLower(b)
+	}
+
+	return a == b
+}
+
+func policyPatternString(tokens []policyPatternToken) string {
+	var sb strings.Builder
+
+	for _, token := range tokens {
+		sb.WriteRune(token.r)
+	}
+
+	return sb.String()
+}
+
+type policyConditionQualifier int
+
+const (
+	policyConditionQualifierNone policyConditionQualifier = iota
+	policyConditionQualifierForAllValues
+	policyConditionQualifierForAnyValue
+)
+
+type policyConditionOperator struct {
+	ifExists  bool
+	name      string
+	negated   bool
+	qualifier policyConditionQualifier
+}
+
+// policyConditionMatchFuncs are the positive forms of the supported condition operators.
+// Negated operators (e.g. StringNotEquals) are the logical inverse of their positive form.
+var policyConditionMatchFuncs = map[string]struct {
+	match     func(policyValue []policyPatternToken, requestValue string) bool
+	negation  string
+	variables bool
+}{
+	"ArnEquals":                 {match: matchPolicyConditionARN, negation: "ArnNotEquals", variables: true},
+	"ArnLike":                   {match: matchPolicyConditionARN, negation: "ArnNotLike", variables: true},
+	"BinaryEquals":              {match: matchPolicyConditionString(false)},
+	"Bool":                      {match: matchPolicyConditionBool},
+	"DateEquals":                {match: matchPolicyConditionDate(func(c int) bool { return c == 0 }), negation: "DateNotEquals"},
+	"DateGreaterThan":           {match: matchPolicyConditionDate(func(c int) bool { return c > 0 })},
+	"DateGreaterThanEquals":     {match: matchPolicyConditionDate(func(c int) bool { return c >= 0 })},
+	"DateLessThan":              {match: matchPolicyConditionDate(func(c int) bool { return c < 0 })},
+	"DateLessThanEquals":        {match: matchPolicyConditionDate(func(c int) bool { return c <= 0 })},
+	"IpAddress":                 {match: matchPolicyConditionIPAddress, negation: "NotIpAddress"},
+	"NumericEquals":             {match: matchPolicyConditionNumeric(func(c int) bool { return c == 0 }), negation: "NumericNotEquals"},
+	"NumericGreaterThan":        {match: matchPolicyConditionNumeric(func(c int) bool { return c > 0 })},
+	"NumericGreaterThanEquals":  {match: matchPolicyConditionNumeric(func(c int) bool { return c >= 0 })},
+	"NumericLessThan":           {match: matchPolicyConditionNumeric(func(c int) bool { return c < 0 })},
+	"NumericLessThanEquals":     {match: matchPolicyConditionNumeric(func(c int) bool { return c <= 0 })},
+	"StringEquals":              {match: matchPolicyConditionString(false), negation: "StringNotEquals", variables: true},
+	"StringEqualsIgnoreCase":    {match: matchPolicyConditionString(true), negation: "StringNotEqualsIgnoreCase", variables: true},
+	"StringLike":                {match: matchPolicyConditionStringLike, negation: "StringNotLike", variables: true},
+	policyConditionOperatorNull: {},
+}
+
+const (
+	policyConditionOperatorNull = "Null"
+)
+
+// parsePolicyConditionOperator parses a condition operator such as "ForAnyValue:StringLikeIfExists".
+func parsePolicyConditionOperator(s string) (policyConditionOperator, error) {
+	var operator policyConditionOperator
+
+	name := s
+	if v, ok := strings.CutPrefix(name, "ForAllValues:"); ok {
+		name = v
+		operator.qualifier = policyConditionQualifierForAllValues
+	} else if v, ok := strings.CutPrefix(name, "ForAnyValue:"); ok {
+		name = v
+		operator.qualifier = policyConditionQualifierForAnyValue
+	}
+
+	if name != policyConditionOperatorNull {
+		if v, ok := strings.CutSuffix(name, "IfExists"); ok {
+			name = v
+			operator.ifExists = true
+		}
+	}
+
+	if _, ok := policyConditionMatchFuncs[name]; ok {
+		operator.name = name
+		return operator, nil
+	}
+
+	for k, v := range policyConditionMatchFuncs {
+		if v.negation != "" && v.negation == name {
+			operator.name = k
+			operator.negated = true
+			return operator, nil
+		}
+	}
+
+	return operator, fmt.Errorf("unsupported condition operator %q", s)
+}
+
+func conditionMatches(condition IAMPolicyStatementCondition, variables bool, request policyEvaluationRequest, missing map[string]struct{}) bool {
+	operator, err := parsePolicyConditionOperator(condition.Test)
+
+	if err != nil {
+		return false
+	}
+
+	policyValues := policyStringSlice(condition.Values)
+	requestValues, present := request.Context[strings.ToLower(condition.Variable)]
+	present = present && len(requestValues) > 0
+
+	if operator.name == policyConditionOperatorNull {
+		for _, v := range policyValues {
+			if isNull, err := strconv.ParseBool(v); err == nil && isNull != present {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if !present {
+		if operator.ifExists {
+			return true
+		}
+
+		missing[condition.Variable] = struct{}{}
+
+		switch operator.qualifier {
+		case policyConditionQualifierForAllValues:
+			return true
+		case policyConditionQualifierForAnyValue:
+			return false
+		default:
+			return operator.negated
+		}
+	}
+
+	funcs := policyConditionMatchFuncs[operator.name]
+
+	var patterns [][]policyPatternToken
+	for _, v := range policyValues {
+		if tokens, ok := expandPolicyPattern(v, variables && funcs.variables, request, missing); ok {
+			patterns = append(patterns, tokens)
+		}
+	}
+
+	// Values for a single condition key are evaluated using a logical OR.
+	matches := func(requestValue string) bool {
+		for _, pattern := range patterns {
+			if funcs.match(pattern, requestValue) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	switch operator.qualifier {
+	case policyConditionQualifierForAllValues:
+		for _, v := range requestValues {
+			if matches(v) == operator.negated {
+				return false
+			}
+		}
+
+		return true
+	case policyConditionQualifierForAnyValue:
+		for _, v := range requestValues {
+			if matches(v) != operator.negated {
+				return true
+			}
+		}
+
+		return false
+	default:
+		for _, v := range requestValues {
+			if matches(v) {
+				return !operator.negated
+			}
+		}
+
+		return operator.negated
+	}
+}
+
+func matchPolicyConditionString(ignoreCase bool) func([]policyPatternToken, string) bool {
+	return func(policyValue []policyPatternToken, requestValue string) bool {
+		if ignoreCase {
+			return strings.EqualFold(policyPatternString(policyValue), requestValue)
+		}
+
+		return policyPatternString(policyValue) == requestValue
+	}
+}
+
+func matchPolicyConditionStringLike(policyValue []policyPatternToken, requestValue string) bool {
+	return matchPolicyPattern(policyValue, []rune(requestValue), false)
+}
+
+func matchPolicyConditionBool(policyValue []policyPatternToken, requestValue string) bool {
+	return strings.EqualFold(policyPatternString(policyValue), requestValue)
+}
+
+// matchPolicyConditionARN matches each of the six colon-delimited components of an ARN separately.
+func matchPolicyConditionARN(policyValue []policyPatternToken, requestValue string) bool {
+	const (
+		arnSections = 6
+	)
+
+	var sections [][]policyPatternToken
+	start := 0
+	for i, token := range policyValue {
+		if len(sections) == arnSections-1 {
+			break
+		}
+
+		if !token.wildcard && token.r == ':' {
+			sections = append(sections, policyValue[start:i])
+			start = i + 1
+		}
+	}
+	sections = append(sections, policyValue[start:])
+
+	values := strings.SplitN(requestValue, ":", arnSections)
+
+	if len(sections) != arnSections || len(values) != arnSections {
+		return false
+	}
+
+	for i := range sections {
+		if !matchPolicyPattern(sections[i], []rune(values[i]), false) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchPolicyConditionIPAddress(policyValue []policyPatternToken, requestValue string) bool {
+	ip := net.ParseIP(requestValue)
+
+	if ip == nil {
+		return false
+	}
+
+	v := policyPatternString(policyValue)
+
+	if _, network, err := net.ParseCIDR(v); err == nil {
+		return network.Contains(ip)
+	}
+
+	return ip.Equal(net.ParseIP(v))
+}
+
+func matchPolicyConditionNumeric(compare func(int) bool) func([]policyPatternToken, string) bool {
+	return func(policyValue []policyPatternToken, requestValue string) bool {
+		p, err := strconv.ParseFloat(policyPatternString(policyValue), 64)
+
+		if err != nil {
+			return false
+		}
+
+		r, err := strconv.ParseFloat(requestValue, 64)
+
+		if err != nil {
+			return false
+		}
+
+		switch {
+		case r < p:
+			return compare(-1)
+		case r > p:
+			return compare(1)
+		default:
+			return compare(0)
+		}
+	}
+}
+
+func matchPolicyConditionDate(compare func(int) bool) func([]policyPatternToken, string) bool {
+	return func(policyValue []policyPatternToken, requestValue string) bool {
+		p, err := parsePolicyConditionDate(policyPatternString(policyValue))
+
+		if err != nil {
+			return false
+		}
+
+		r, err := parsePolicyConditionDate(requestValue)
+
+		if err != nil {
+			return false
+		}
+
+		return compare(r.Compare(p))
+	}
+}
+
+// parsePolicyConditionDate parses an ISO 8601 date or an epoch (UNIX) time.
+func parsePolicyConditionDate(s string) (time.Time, error) {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(v, 0), nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04Z07:00", time.DateOnly} {
+		if v, err := time.Parse(layout, s); err == nil {
+			return v, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date: %q", s)
+}
+
+// policyStringSlice returns the string values of a policy element that may be a single string or a list of strings.
+func policyStringSlice(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		var values []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				values = append(values, v)
+			}
+		}
+		return values
+	}
+
+	return nil
+}
@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_iam_policy_evaluation")
+func DataSourcePolicyEvaluation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePolicyEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			"action_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: `One or more names of actions, like "iam:CreateUser", that should be evaluated.`,
+			},
+			"context": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The key name of the context entry, such as "aws:SourceIp".`,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: `One or more values to assign to the context key. Values are interpreted according to the condition operator that tests them.`,
+						},
+					},
+				},
+				Description: `Each block specifies one item of additional context entry to include in the evaluated requests. These are the properties used in the 'Condition' element of a policy, and in policy variables.`,
+			},
+			"identity_policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+				Description: `Identity-based policies attached to the principal making the requests.`,
+			},
+			"permissions_boundary_policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+				Description: `Permissions boundary policies that limit the identity-based policies.`,
+			},
+			"principal": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The principal making the requests, such as an IAM role ARN or a service principal like "sns.amazonaws.com". Used to match the Principal and NotPrincipal elements of resource_policy_json. If an ARN is specified it is also used as the value of the "aws:PrincipalArn" context key if that key is not otherwise specified.`,
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: `ARNs of specific resources to use as the targets of the specified actions. If not specified, "*" is used.`,
+			},
+			"resource_policy_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				Description:  `A resource-based policy to associate with all of the target resources.`,
+			},
+			"service_control_policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+				Description: `Organizations service control policies that apply to the account of the principal making the requests.`,
+			},
+
+			// Result Attributes
+			"all_allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `A summary of the results attribute which is true if all of the results have decision "allowed", and false otherwise.`,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the action whose evaluation this result is describing.`,
+						},
+						"allowed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `A summary of attribute "decision" which is true only if the decision is "allowed".`,
+						},
+						"decision": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The decision keyword: "allowed", "explicitDeny", or "implicitDeny".`,
+						},
+						"matched_statements": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"effect": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The effect of the matched statement.`,
+									},
+									"sid": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The statement ID of the matched statement, if any.`,
+									},
+									"source_policy_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Identifier of one of the policies used as input to the evaluation.`,
+									},
+									"source_policy_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The type of the policy identified in source_policy_id.`,
+									},
+								},
+							},
+							Description: `Detail about which specific policy statements contributed to this result.`,
+						},
+						"missing_context_keys": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: `Set of context entry keys that were needed for one or more of the relevant policies but not included in the request.`,
+						},
+						"resource_arn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `ARN of the resource that the action was evaluated against.`,
+						},
+					},
+				},
+			},
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Do not use`,
+			},
+		},
+	}
+}
+
+func dataSourcePolicyEvaluationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var policies []policyEvaluationPolicy
+
+	addPolicies := func(k, policyType string) error {
+		for i, v := range d.Get(k).([]interface{}) {
+			v, ok := v.(string)
+			if !ok {
+				continue
+			}
+
+			policy, err := parsePolicyEvaluationPolicy(fmt.Sprintf("%s.%d", k, i), policyType, v)
+			if err != nil {
+				return err
+			}
+
+			policies = append(policies, policy)
+		}
+
+		return nil
+	}
+
+	if err := addPolicies("service_control_policies_json", policyEvaluationPolicyTypeServiceControl); err != nil {
+		return sdkdiag.AppendErrorf(diags, "evaluating IAM Policies: %s", err)
+	}
+	if v, ok := d.GetOk("resource_policy_json"); ok {
+		policy, err := parsePolicyEvaluationPolicy("resource_policy_json", policyEvaluationPolicyTypeResource, v.(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "evaluating IAM Policies: %s", err)
+		}
+
+		policies = append(policies, policy)
+	}
+	if err := addPolicies("permissions_boundary_policies_json", policyEvaluationPolicyTypePermissionsBoundary); err != nil {
+		return sdkdiag.AppendErrorf(diags, "evaluating IAM Policies: %s", err)
+	}
+	if err := addPolicies("identity_policies_json", policyEvaluationPolicyTypeIdentity); err != nil {
+		return sdkdiag.AppendErrorf(diags, "evaluating IAM Policies: %s", err)
+	}
+
+	principal := d.Get("principal").(string)
+	requestContext := make(map[string][]string)
+	for _, tfMapRaw := range d.Get("context").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		requestContext[strings.ToLower(tfMap["key"].(string))] = flex.ExpandStringValueList(tfMap["values"].([]interface{}))
+	}
+	if _, ok := requestContext["aws:principalarn"]; !ok && arn.IsARN(principal) {
+		requestContext["aws:principalarn"] = []string{principal}
+	}
+
+	actions := flex.ExpandStringValueSet(d.Get("action_names").(*schema.Set))
+	sort.Strings(actions)
+	resources := flex.ExpandStringValueSet(d.Get("resource_arns").(*schema.Set))
+	sort.Strings(resources)
+	if len(resources) == 0 {
+		resources = []string{"*"}
+	}
+
+	evaluator := newPolicyEvaluator(policies...)
+
+	// While we build the result we'll also tally up the number of allowed
+	// vs. denied decisions to use for our top-level "all_allowed" summary
+	// result.
+	allowedCount := 0
+	deniedCount := 0
+
+	var rawResults []interface{}
+	for _, action := range actions {
+		for _, resource := range resources {
+			result := evaluator.evaluate(policyEvaluationRequest{
+				Action:    action,
+				Context:   requestContext,
+				Principal: principal,
+				Resource:  resource,
+			})
+
+			allowed := result.Decision == policyEvaluationDecisionAllowed
+			if allowed {
+				allowedCount++
+			} else {
+				deniedCount++
+			}
+
+			rawMatchedStmts := make([]interface{}, len(result.MatchedStatements))
+			for i, stmt := range result.MatchedStatements {
+				rawMatchedStmts[i] = map[string]interface{}{
+					"effect":             stmt.Effect,
+					"sid":                stmt.Sid,
+					"source_policy_id":   stmt.SourcePolicyID,
+					"source_policy_type": stmt.SourcePolicyType,
+				}
+			}
+
+			rawResults = append(rawResults, map[string]interface{}{
+				"action_name":          result.Action,
+				"allowed":              allowed,
+				"decision":             result.Decision,
+				"matched_statements":   rawMatchedStmts,
+				"missing_context_keys": result.MissingContextKeys,
+				"resource_arn":         result.Resource,
+			})
+		}
+	}
+	if err := d.Set("results", rawResults); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting results: %s", err)
+	}
+
+	// "all" are allowed only if there is at least one result and no other
+	// results were denied.
+	d.Set("all_allowed", allowedCount > 0 && deniedCount == 0)
+
+	d.SetId("-")
+
+	return diags
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMPolicyEvaluationDataSource_basic(t *testing.T) {
+	// Evaluation is performed locally, but just instantiating the AWS
+	// provider requires some AWS API calls.
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "4"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.action_name", "s3:DeleteObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.resource_arn", "arn:aws:s3:::example/home/alice/notes.txt"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "explicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.0.sid", "DenyDelete"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.0.source_policy_id", "identity_policies_json.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.0.source_policy_type", "identity"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.action_name", "s3:GetObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.resource_arn", "arn:aws:s3:::example/home/alice/notes.txt"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.3.resource_arn", "arn:aws:s3:::example/home/bob/notes.txt"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.3.decision", "implicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.3.missing_context_keys.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyEvaluationDataSource_allAllowed(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig_allAllowed,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.resource_arn", "*"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.0.source_policy_type", "resource"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicyEvaluationDataSourceConfig_basic = `
+data "aws_iam_policy_document" "identity" {
+  statement {
+    actions   = ["s3:GetObject", "s3:DeleteObject"]
+    resources = ["arn:aws:s3:::example/home/$${aws:username}/*"]
+  }
+
+  statement {
+    sid       = "DenyDelete"
+    effect    = "Deny"
+    actions   = ["s3:DeleteObject"]
+    resources = ["*"]
+
+    condition {
+      test     = "Bool"
+      variable = "aws:MultiFactorAuthPresent"
+      values   = ["false"]
+    }
+  }
+}
+
+data "aws_iam_policy_evaluation" "test" {
+  identity_policies_json = [data.aws_iam_policy_document.identity.json]
+
+  action_names = ["s3:GetObject", "s3:DeleteObject"]
+  resource_arns = [
+    "arn:aws:s3:::example/home/alice/notes.txt",
+    "arn:aws:s3:::example/home/bob/notes.txt",
+  ]
+
+  context {
+    key    = "aws:username"
+    values = ["alice"]
+  }
+
+  context {
+    key    = "aws:MultiFactorAuthPresent"
+    values = ["false"]
+  }
+}
+`
+
+const testAccPolicyEvaluationDataSourceConfig_allAllowed = `
+data "aws_iam_policy_document" "resource" {
+  statement {
+    actions = ["sqs:SendMessage"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["sns.amazonaws.com"]
+    }
+
+    condition {
+      test     = "ArnLike"
+      variable = "aws:SourceArn"
+      values   = ["arn:aws:sns:*:123456789012:*"]
+    }
+  }
+}
+
+data "aws_iam_policy_evaluation" "test" {
+  resource_policy_json = data.aws_iam_policy_document.resource.json
+  principal            = "sns.amazonaws.com"
+
+  action_names = ["sqs:SendMessage"]
+
+  context {
+    key    = "aws:SourceArn"
+    values = ["arn:aws:sns:us-west-2:123456789012:example"]
+  }
+}
+`
@@ -0,0 +1,349 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPolicyEvaluator(t *testing.T) {
+	t.Parallel()
+
+	type policy struct {
+		policyType string
+		document   string
+	}
+
+	testcases := map[string]struct {
+		policies    []policy
+		request     policyEvaluationRequest
+		want        string
+		wantMissing []string
+	}{
+		"no policies": {
+			request: policyEvaluationRequest{Action: "s3:GetObject", Resource: "*"},
+			want:    policyEvaluationDecisionImplicitDeny,
+		},
+		"action wildcard": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:Get*","Resource":"*"}}`},
+			},
+			request: policyEvaluationRequest{Action: "S3:GetObject", Resource: "arn:aws:s3:::bucket/key"},
+			want:    policyEvaluationDecisionAllowed,
+		},
+		"action single character wildcard": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:Describe?","Resource":"*"}]}`},
+			},
+			request: policyEvaluationRequest{Action: "ec2:DescribeInstances", Resource: "*"},
+			want:    policyEvaluationDecisionImplicitDeny,
+		},
+		"resource mismatch": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::bucket/*"}]}`},
+			},
+			request: policyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::other/key"},
+			want:    policyEvaluationDecisionImplicitDeny,
+		},
+		"NotAction": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","NotAction":"iam:*","Resource":"*"}]}`},
+			},
+			request: policyEvaluationRequest{Action: "iam:CreateUser", Resource: "*"},
+			want:    policyEvaluationDecisionImplicitDeny,
+		},
+		"NotResource": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","NotResource":"arn:aws:s3:::secret/*"}]}`},
+			},
+			request: policyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::public/key"},
+			want:    policyEvaluationDecisionAllowed,
+		},
+		"explicit deny wins": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`},
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:DeleteBucket","Resource":"*"}]}`},
+			},
+			request: policyEvaluationRequest{Action: "s3:DeleteBucket", Resource: "arn:aws:s3:::bucket"},
+			want:    policyEvaluationDecisionExplicitDeny,
+		},
+		"explicit deny in resource policy": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`},
+				{policyEvaluationPolicyTypeResource, `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:*"}]}`},
+			},
+			request: policyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::bucket/key"},
+			want:    policyEvaluationDecisionExplicitDeny,
+		},
+		"resource policy allows principal": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeResource, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`},
+			},
+			request: policyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::bucket/key", Principal: "arn:aws:iam::123456789012:role/test"},
+			want:    policyEvaluationDecisionAllowed,
+		},
+		"resource policy other principal": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeResource, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`},
+			},
+			request: policyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::bucket/key", Principal: "arn:aws:iam::210987654321:role/test"},
+			want:    policyEvaluationDecisionImplicitDeny,
+		},
+		"resource policy NotPrincipal": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`},
+				{policyEvaluationPolicyTypeResource, `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotPrincipal":{"AWS":"arn:aws:iam::123456789012:role/admin"},"Action":"s3:*","Resource":"*"}]}`},
+			},
+			request: policyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::bucket/key", Principal: "arn:aws:iam::123456789012:role/admin"},
+			want:    policyEvaluationDecisionAllowed,
+		},
+		"permissions boundary limits identity policy": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`},
+				{policyEvaluationPolicyTypePermissionsBoundary, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`},
+			},
+			request: policyEvaluationRequest{Action: "ec2:RunInstances", Resource: "*"},
+			want:    policyEvaluationDecisionImplicitDeny,
+		},
+		"permissions boundary does not grant": {
+			policies: []policy{
+				{policyEvaluationPolicyTypePermissionsBoundary, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`},
+			},
+			request: policyEvaluationRequest{Action: "s3:GetObject", Resource: "*"},
+			want:    policyEvaluationDecisionImplicitDeny,
+		},
+		"service control policy limits resource policy": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeServiceControl, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:*","Resource":"*"}]}`},
+				{policyEvaluationPolicyTypeResource, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:*","Resource":"*"}]}`},
+			},
+			request: policyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::bucket/key"},
+			want:    policyEvaluationDecisionImplicitDeny,
+		},
+		"policy variable": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::bucket/${aws:username}/*"}]}`},
+			},
+			request: policyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::bucket/alice/key", Context: map[string][]string{"aws:username": {"alice"}}},
+			want:    policyEvaluationDecisionAllowed,
+		},
+		"policy variable missing": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::bucket/${aws:username}/*"}]}`},
+			},
+			request:     policyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::bucket/alice/key"},
+			want:        policyEvaluationDecisionImplicitDeny,
+			wantMissing: []string{"aws:username"},
+		},
+		"policy variable literal in old version": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2008-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::bucket/${aws:username}"}]}`},
+			},
+			request: policyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::bucket/${aws:username}", Context: map[string][]string{"aws:username": {"alice"}}},
+			want:    policyEvaluationDecisionAllowed,
+		},
+		"escaped wildcard": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::bucket/${*}"}]}`},
+			},
+			request: policyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::bucket/key"},
+			want:    policyEvaluationDecisionImplicitDeny,
+		},
+		"StringEquals": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:*","Resource":"*","Condition":{"StringEquals":{"aws:RequestedRegion":["us-east-1","us-west-2"]}}}]}`},
+			},
+			request: policyEvaluationRequest{Action: "ec2:RunInstances", Resource: "*", Context: map[string][]string{"aws:requestedregion": {"us-west-2"}}},
+			want:    policyEvaluationDecisionAllowed,
+		},
+		"StringEquals missing key": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:*","Resource":"*","Condition":{"StringEquals":{"aws:RequestedRegion":"us-east-1"}}}]}`},
+			},
+			request:     policyEvaluationRequest{Action: "ec2:RunInstances", Resource: "*"},
+			want:        policyEvaluationDecisionImplicitDeny,
+			wantMissing: []string{"aws:RequestedRegion"},
+		},
+		"StringEqualsIfExists missing key": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:*","Resource":"*","Condition":{"StringEqualsIfExists":{"aws:RequestedRegion":"us-east-1"}}}]}`},
+			},
+			request: policyEvaluationRequest{Action: "ec2:RunInstances", Resource: "*"},
+			want:    policyEvaluationDecisionAllowed,
+		},
+		"StringNotEquals deny": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`},
+				{policyEvaluationPolicyTypeServiceControl, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"},{"Effect":"Deny","NotAction":"iam:*","Resource":"*","Condition":{"StringNotEquals":{"aws:RequestedRegion":["us-east-1"]}}}]}`},
+			},
+			request: policyEvaluationRequest{Action: "ec2:RunInstances", Resource: "*", Context: map[string][]string{"aws:requestedregion": {"eu-west-1"}}},
+			want:    policyEvaluationDecisionExplicitDeny,
+		},
+		"StringLike with policy variable": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Condition":{"StringLike":{"s3:prefix":"home/${aws:username}/*"}}}]}`},
+			},
+			request: policyEvaluationRequest{Action: "s3:ListBucket", Resource: "*", Context: map[string][]string{"aws:username": {"bob"}, "s3:prefix": {"home/bob/docs"}}},
+			want:    policyEvaluationDecisionAllowed,
+		},
+		"NumericLessThanEquals": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Condition":{"NumericLessThanEquals":{"s3:max-keys":10}}}]}`},
+			},
+			request: policyEvaluationRequest{Action: "s3:ListBucket", Resource: "*", Context: map[string][]string{"s3:max-keys": {"11"}}},
+			want:    policyEvaluationDecisionImplicitDeny,
+		},
+		"NumericLessThanEquals match": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Condition":{"NumericLessThanEquals":{"s3:max-keys":[10]}}}]}`},
+			},
+			request: policyEvaluationRequest{Action: "s3:ListBucket", Resource: "*", Context: map[string][]string{"s3:max-keys": {"9"}}},
+			want:    policyEvaluationDecisionAllowed,
+		},
+		"DateGreaterThan": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"DateGreaterThan":{"aws:CurrentTime":"2020-01-01T00:00:00Z"}}}]}`},
+			},
+			request: policyEvaluationRequest{Action: "s3:GetObject", Resource: "*", Context: map[string][]string{"aws:currenttime": {"2023-06-01T12:00:00Z"}}},
+			want:    policyEvaluationDecisionAllowed,
+		},
+		"Bool": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"},{"Effect":"Deny","Action":"*","Resource":"*","Condition":{"Bool":{"aws:MultiFactorAuthPresent":false}}}]}`},
+			},
+			request: policyEvaluationRequest{Action: "iam:DeleteUser", Resource: "*", Context: map[string][]string{"aws:multifactorauthpresent": {"false"}}},
+			want:    policyEvaluationDecisionExplicitDeny,
+		},
+		"IpAddress": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"IpAddress":{"aws:SourceIp":["203.0.113.0/24","2001:db8::/32"]}}}]}`},
+			},
+			request: policyEvaluationRequest{Action: "s3:GetObject", Resource: "*", Context: map[string][]string{"aws:sourceip": {"2001:db8::1"}}},
+			want:    policyEvaluationDecisionAllowed,
+		},
+		"NotIpAddress": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"NotIpAddress":{"aws:SourceIp":"203.0.113.0/24"}}}]}`},
+			},
+			request: policyEvaluationRequest{Action: "s3:GetObject", Resource: "*", Context: map[string][]string{"aws:sourceip": {"203.0.113.10"}}},
+			want:    policyEvaluationDecisionImplicitDeny,
+		},
+		"ArnLike": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeResource, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Action":"sqs:SendMessage","Condition":{"ArnLike":{"aws:SourceArn":"arn:aws:sns:*:123456789012:*"}}}]}`},
+			},
+			request: policyEvaluationRequest{Action: "sqs:SendMessage", Resource: "arn:aws:sqs:us-east-1:123456789012:queue", Principal: "sns.amazonaws.com", Context: map[string][]string{"aws:sourcearn": {"arn:aws:sns:us-west-2:123456789012:topic"}}},
+			want:    policyEvaluationDecisionAllowed,
+		},
+		"ArnLike does not span components": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ArnLike":{"aws:SourceArn":"arn:aws:sns:*"}}}]}`},
+			},
+			request: policyEvaluationRequest{Action: "sqs:SendMessage", Resource: "*", Context: map[string][]string{"aws:sourcearn": {"arn:aws:sns:us-west-2:123456789012:topic"}}},
+			want:    policyEvaluationDecisionImplicitDeny,
+		},
+		"Null": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"},{"Effect":"Deny","Action":"ec2:RunInstances","Resource":"*","Condition":{"Null":{"aws:RequestTag/owner":"true"}}}]}`},
+			},
+			request: policyEvaluationRequest{Action: "ec2:RunInstances", Resource: "*"},
+			want:    policyEvaluationDecisionExplicitDeny,
+		},
+		"ForAllValues": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAllValues:StringEquals":{"aws:TagKeys":["owner","env"]}}}]}`},
+			},
+			request: policyEvaluationRequest{Action: "ec2:CreateTags", Resource: "*", Context: map[string][]string{"aws:tagkeys": {"owner", "cost-center"}}},
+			want:    policyEvaluationDecisionImplicitDeny,
+		},
+		"ForAllValues missing key": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAllValues:StringEquals":{"aws:TagKeys":["owner","env"]}}}]}`},
+			},
+			request:     policyEvaluationRequest{Action: "ec2:CreateTags", Resource: "*"},
+			want:        policyEvaluationDecisionAllowed,
+			wantMissing: []string{"aws:TagKeys"},
+		},
+		"ForAnyValue": {
+			policies: []policy{
+				{policyEvaluationPolicyTypeIdentity, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAnyValue:StringLike":{"aws:TagKeys":"cost-*"}}}]}`},
+			},
+			request: policyEvaluationRequest{Action: "ec2:CreateTags", Resource: "*", Context: map[string][]string{"aws:tagkeys": {"owner", "cost-center"}}},
+			want:    policyEvaluationDecisionAllowed,
+		},
+	}
+
+	for name, testcase := range testcases {
+		name, testcase := name, testcase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var policies []policyEvaluationPolicy
+			for _, v := range testcase.policies {
+				policy, err := parsePolicyEvaluationPolicy(name, v.policyType, v.document)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				policies = append(policies, policy)
+			}
+
+			got := newPolicyEvaluator(policies...).evaluate(testcase.request)
+
+			if got.Decision != testcase.want {
+				t.Errorf("decision = %q, want %q", got.Decision, testcase.want)
+			}
+
+			if !reflect.DeepEqual(got.MissingContextKeys, testcase.wantMissing) {
+				t.Errorf("missing context keys = %v, want %v", got.MissingContextKeys, testcase.wantMissing)
+			}
+		})
+	}
+}
+
+func TestParsePolicyEvaluationPolicy(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		document string
+		wantErr  bool
+	}{
+		"valid": {
+			document: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+		},
+		"single statement": {
+			document: `{"Version":"2012-10-17","Statement":{"Effect":"Deny","Action":"*","Resource":"*"}}`,
+		},
+		"invalid JSON": {
+			document: `{"Version":`,
+			wantErr:  true,
+		},
+		"invalid effect": {
+			document: `{"Version":"2012-10-17","Statement":[{"Effect":"Maybe","Action":"*","Resource":"*"}]}`,
+			wantErr:  true,
+		},
+		"unknown condition operator": {
+			document: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringSortOf":{"aws:username":"alice"}}}]}`,
+			wantErr:  true,
+		},
+		"qualified negated condition operator": {
+			document: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAnyValue:StringNotLikeIfExists":{"aws:TagKeys":"a*"}}}]}`,
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parsePolicyEvaluationPolicy("test", policyEvaluationPolicyTypeIdentity, testcase.document)
+
+			if got, want := err != nil, testcase.wantErr; got != want {
+				t.Errorf("err = %v, want error %t", err, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/YakDriver/regexache"
+)
+
+// Policy types understood by the policy linter.
+const (
+	policyLintPolicyTypeBucket      = "bucket"
+	policyLintPolicyTypeInlineGroup = "inline_group"
+	policyLintPolicyTypeInlineRole  = "inline_role"
+	policyLintPolicyTypeInlineUser  = "inline_user"
+	policyLintPolicyTypeManaged     = "managed"
+	policyLintPolicyTypeSCP         = "scp"
+)
+
+func policyLintPolicyType_Values() []string {
+	return []string{
+		policyLintPolicyTypeBucket,
+		policyLintPolicyTypeInlineGroup,
+		policyLintPolicyTypeInlineRole,
+		policyLintPolicyTypeInlineUser,
+		policyLintPolicyTypeManaged,
+		policyLintPolicyTypeSCP,
+	}
+}
+
+// policyLintSizeLimits are the maximum policy sizes, in characters excluding whitespace.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html,
+// https://docs.aws.amazon.com/organizations/latest/userguide/orgs_reference_limits.html and
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/access-policy-language-overview.html.
+var policyLintSizeLimits = map[string]int{
+	policyLintPolicyTypeBucket:      20480,
+	policyLintPolicyTypeInlineGroup: 5120,
+	policyLintPolicyTypeInlineRole:  10240,
+	policyLintPolicyTypeInlineUser:  2048,
+	policyLintPolicyTypeManaged:     6144,
+	policyLintPolicyTypeSCP:         5120,
+}
+
+type policyLintFinding struct {
+	Error   bool
+	Summary string
+	Detail  string
+}
+
+//go:embed policy_lint_catalog.json
+var policyLintCatalogJSON []byte
+
+// policyLintCatalog is an IAM action and condition key catalog generated from the AWS Service Authorization Reference
+// by internal/generate/iampolicylintcatalog. Actions for services that are not in the catalog are only checked for syntax.
+type policyLintCatalog struct {
+	GlobalConditionKeys []string                            `json:"global_condition_keys"`
+	Services            map[string]policyLintCatalogService `json:"services"`
+}
+
+type policyLintCatalogService struct {
+	Actions       []string `json:"actions"`
+	ConditionKeys []string `json:"condition_keys"`
+}
+
+var (
+	policyLintCatalogOnce sync.Once
+	policyLintCatalogData *policyLintCatalog
+)
+
+func loadPolicyLintCatalog() *policyLintCatalog {
+	policyLintCatalogOnce.Do(func() {
+		policyLintCatalogData = &policyLintCatalog{}
+
+		if err := json.Unmarshal(policyLintCatalogJSON, policyLintCatalogData); err != nil {
+			panic(fmt.Sprintf("parsing IAM policy lint catalog: %s", err))
+		}
+	})
+
+	return policyLintCatalogData
+}
+
+// hasAction returns whether the specified action pattern matches any action in the catalog.
+// The second return value is false if the action's service is not in the catalog.
+func (c *policyLintCatalog) hasAction(service, pattern string) (bool, bool) {
+	s, ok := c.Services[strings.ToLower(service)]
+
+	if !ok {
+		return false, false
+	}
+
+	tokens, _ := expandPolicyPattern(pattern, false, policyEvaluationRequest{}, map[string]struct{}{})
+
+	for _, action := range s.Actions {
+		if matchPolicyPattern(tokens, []rune(action), true) {
+			return true, true
+		}
+	}
+
+	return false, true
+}
+
+// hasConditionKey returns whether the specified condition key is in the catalog.
+// The second return value is false if the condition key's service is not in the catalog.
+func (c *policyLintCatalog) hasConditionKey(key string) (bool, bool) {
+	service, _, ok := strings.Cut(key, ":")
+
+	if !ok {
+		return false, false
+	}
+
+	var keys []string
+	if strings.EqualFold(service, "aws") {
+		keys = c.GlobalConditionKeys
+	} else if s, ok := c.Services[strings.ToLower(service)]; ok {
+		keys = s.ConditionKeys
+	} else {
+		return false, false
+	}
+
+	for _, k := range keys {
+		// Keys such as "aws:RequestTag/${TagKey}" match any key with the same prefix.
+		if prefix, _, ok := strings.Cut(k, "${"); ok {
+			if len(key) > len(prefix) && strings.EqualFold(key[:len(prefix)], prefix) {
+				return true, true
+			}
+
+			continue
+		}
+
+		if strings.EqualFold(k, key) {
+			return true, true
+		}
+	}
+
+	return false, true
+}
+
+// lintPolicyDocument statically checks a policy document for common mistakes.
+// If policyType is specified, the checks specific to that type of policy are also performed.
+func lintPolicyDocument(doc *IAMPolicyDoc, policyType string) ([]policyLintFinding, error) {
+	var findings []policyLintFinding
+	catalog := loadPolicyLintCatalog()
+
+	addError := func(summary, format string, a ...any) {
+		findings = append(findings, policyLintFinding{Error: true, Summary: summary, Detail: fmt.Sprintf(format, a...)})
+	}
+	addWarning := func(summary, format string, a ...any) {
+		findings = append(findings, policyLintFinding{Summary: summary, Detail: fmt.Sprintf(format, a...)})
+	}
+
+	isIdentityPolicy := policyType == policyLintPolicyTypeManaged || policyType == policyLintPolicyTypeSCP || strings.HasPrefix(policyType, "inline_")
+
+	for i, statement := range doc.Statements {
+		name := fmt.Sprintf("statement %d", i)
+		if statement.Sid != "" {
+			name = fmt.Sprintf("statement %d (%s)", i, statement.Sid)
+		}
+
+		actions := policyStringSlice(statement.Actions)
+		notActions := policyStringSlice(statement.NotActions)
+
+		for _, action := range append(append([]string{}, actions...), notActions...) {
+			if action == "*" {
+				continue
+			}
+
+			if !regexache.MustCompile(`^(\*|[0-9A-Za-z-]+):[0-9A-Za-z*?]+$`).MatchString(action) {
+				addError("Malformed IAM policy action", "In %s, action %q must be \"*\" or have the form \"service:Action\", where Action may include the wildcards \"*\" and \"?\".", name, action)
+				continue
+			}
+
+			service, pattern, _ := strings.Cut(action, ":")
+
+			if service == "*" {
+				continue
+			}
+
+			if found, known := catalog.hasAction(service, pattern); known && !found {
+				addWarning("Unknown IAM policy action", "In %s, action %q does not match any %s action in the IAM action catalog.", name, action, service)
+			}
+		}
+
+		if statement.Effect == "Allow" {
+			for _, action := range actions {
+				if action == "*" || action == "*:*" {
+					addWarning("Overly permissive IAM policy statement", "In %s, Allow with action %q grants access to every action of every service.", name, action)
+				}
+			}
+		}
+
+		if statement.NotPrincipals != nil {
+			if statement.Principals != nil {
+				addError("Conflicting IAM policy principals", "In %s, Principal and NotPrincipal cannot be used in the same statement.", name)
+			}
+
+			if statement.Effect == "Allow" {
+				addError("Conflicting IAM policy principals", "In %s, NotPrincipal must only be used with Effect Deny. Allow with NotPrincipal grants access to every principal other than those listed, including anonymous users.", name)
+			}
+
+			for _, p := range statement.NotPrincipals {
+				for _, identifier := range policyStringSlice(p.Identifiers) {
+					if identifier == "*" {
+						addError("Conflicting IAM policy principals", "In %s, NotPrincipal %q matches no principals.", name, identifier)
+					}
+				}
+			}
+		}
+
+		if isIdentityPolicy && (statement.Principals != nil || statement.NotPrincipals != nil) {
+			addError("Invalid IAM policy principal", "In %s, Principal and NotPrincipal are not supported in %s policies.", name, policyType)
+		}
+
+		if policyType == policyLintPolicyTypeBucket && statement.Principals == nil && statement.NotPrincipals == nil {
+			addError("Missing IAM policy principal", "In %s, bucket policy statements must specify Principal or NotPrincipal.", name)
+		}
+
+		for _, condition := range statement.Conditions {
+			if _, err := parsePolicyConditionOperator(condition.Test); err != nil {
+				addError("Unknown IAM policy condition operator", "In %s, %s.", name, err)
+			}
+
+			if found, known := catalog.hasConditionKey(condition.Variable); known && !found {
+				addWarning("Unknown IAM policy condition key", "In %s, condition key %q is not in the IAM condition key catalog.", name, condition.Variable)
+			}
+		}
+	}
+
+	if limit, ok := policyLintSizeLimits[policyType]; ok {
+		// Don't escape characters such as '<', '>' and '&' as that would inflate the size.
+		var b bytes.Buffer
+		encoder := json.NewEncoder(&b)
+		encoder.SetEscapeHTML(false)
+
+		if err := encoder.Encode(doc); err != nil {
+			return nil, err
+		}
+
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, b.Bytes()); err != nil {
+			return nil, err
+		}
+
+		if size := utf8.RuneCount(bytes.TrimSpace(buf.Bytes())); size > limit {
+			addError("IAM policy too large", "The policy is %d characters long (excluding whitespace), which exceeds the %d character limit for %s policies.", size, limit, policyType)
+		}
+	}
+
+	return findings, nil
+}
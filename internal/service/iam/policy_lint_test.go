@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLintPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		json       string
+		policyType string
+		want       []string
+	}{
+		"valid": {
+			json: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["sqs:SendMessage","kms:Generate*","ec2:Describe*"],"Resource":"*","Condition":{"StringEquals":{"aws:ResourceTag/env":"prod","kms:EncryptionContext:app":"example"}}}]}`,
+		},
+		"malformed action": {
+			json: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3GetObject","s3:Get Object"],"Resource":"*"}]}`,
+			want: []string{"error: Malformed IAM policy action", "error: Malformed IAM policy action"},
+		},
+		"unknown action": {
+			json: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","NotAction":["sqs:SendMesage","sts:Assume*Session"],"Resource":"*"}]}`,
+			want: []string{"warning: Unknown IAM policy action", "warning: Unknown IAM policy action"},
+		},
+		"unknown service": {
+			json: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"example:DoSomething","Resource":"*"}]}`,
+		},
+		"allow everything": {
+			json: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*:*","Resource":"*"},{"Effect":"Deny","Action":"*","Resource":"*"}]}`,
+			want: []string{"warning: Overly permissive IAM policy statement"},
+		},
+		"NotPrincipal with Allow": {
+			json: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","NotPrincipal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"sqs:SendMessage","Resource":"*"}]}`,
+			want: []string{"error: Conflicting IAM policy principals"},
+		},
+		"NotPrincipal with Principal": {
+			json: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":{"AWS":"123456789012"},"NotPrincipal":{"AWS":"*"},"Action":"sqs:SendMessage","Resource":"*"}]}`,
+			want: []string{"error: Conflicting IAM policy principals", "error: Conflicting IAM policy principals"},
+		},
+		"unknown condition operator": {
+			json: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*","Condition":{"StringEqual":{"aws:SourceAccount":"123456789012"}}}]}`,
+			want: []string{"error: Unknown IAM policy condition operator"},
+		},
+		"unknown condition key": {
+			json: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*","Condition":{"StringEquals":{"aws:SourceAcount":"123456789012","sns:Protocol":"https","example:Key":"value"}}}]}`,
+			want: []string{"warning: Unknown IAM policy condition key"},
+		},
+		"principal in managed policy": {
+			json:       `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"}]}`,
+			policyType: policyLintPolicyTypeManaged,
+			want:       []string{"error: Invalid IAM policy principal"},
+		},
+		"bucket policy without principal": {
+			json:       `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*"}]}`,
+			policyType: policyLintPolicyTypeBucket,
+			want:       []string{"error: Missing IAM policy principal"},
+		},
+		"inline user policy too large": {
+			json:       fmt.Sprintf(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:SendMessage","Resource":[%s]}]}`, testPolicyLintResources(60)),
+			policyType: policyLintPolicyTypeInlineUser,
+			want:       []string{"error: IAM policy too large"},
+		},
+		"inline role policy not too large": {
+			json:       fmt.Sprintf(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:SendMessage","Resource":[%s]}]}`, testPolicyLintResources(60)),
+			policyType: policyLintPolicyTypeInlineRole,
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			doc := &IAMPolicyDoc{}
+			if err := json.Unmarshal([]byte(testcase.json), doc); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			findings, err := lintPolicyDocument(doc, testcase.policyType)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got []string
+			for _, finding := range findings {
+				severity := "warning"
+				if finding.Error {
+					severity = "error"
+				}
+				got = append(got, fmt.Sprintf("%s: %s", severity, finding.Summary))
+			}
+
+			if !reflect.DeepEqual(got, testcase.want) {
+				t.Errorf("findings = %v, want %v", findings, testcase.want)
+			}
+		})
+	}
+}
+
+func testPolicyLintResources(n int) string {
+	resources := make([]string, n)
+	for i := range resources {
+		resources[i] = fmt.Sprintf(`"arn:aws:sqs:us-west-2:123456789012:queue-%03d"`, i)
+	}
+
+	return strings.Join(resources, ",")
+}
@@ -7,14 +7,17 @@
 package internetmonitor
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/internetmonitor"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -22,6 +25,7 @@ func init() {
 		Name: "aws_internetmonitor_monitor",
 		F:    sweepMonitors,
 	})
+	discovery.Register("aws_internetmonitor_monitor", listMonitors)
 }
 
 func sweepMonitors(region string) error {
@@ -30,6 +34,28 @@ func sweepMonitors(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listMonitors(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Internet Monitor Monitor sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Internet Monitor Monitors (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Internet Monitor Monitors (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listMonitors(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &internetmonitor.ListMonitorsInput{}
 	conn := client.InternetMonitorClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
@@ -38,13 +64,8 @@ func sweepMonitors(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Internet Monitor Monitor sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error listing Internet Monitor Monitors (%s): %w", region, err)
+			return nil, err
 		}
 
 		for _, v := range page.Monitors {
@@ -56,11 +77,5 @@ func sweepMonitors(region string) error {
 		}
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping Internet Monitor Monitors (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
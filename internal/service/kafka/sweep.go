@@ -7,13 +7,16 @@
 package kafka
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kafka"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -24,6 +27,7 @@ func init() {
 			"aws_mskconnect_connector",
 		},
 	})
+	discovery.Register("aws_msk_cluster", listClusters)
 
 	resource.AddTestSweepers("aws_msk_configuration", &resource.Sweeper{
 		Name: "aws_msk_configuration",
@@ -32,6 +36,7 @@ func init() {
 			"aws_msk_cluster",
 		},
 	})
+	discovery.Register("aws_msk_configuration", listConfigurations)
 }
 
 func sweepClusters(region string) error {
@@ -40,11 +45,33 @@ func sweepClusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listClusters(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping MSK Cluster sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing MSK Clusters (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping MSK Clusters (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listClusters(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &kafka.ListClustersV2Input{}
 	conn := client.KafkaConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListClustersV2PagesWithContext(ctx, input, func(page *kafka.ListClustersV2Output, lastPage bool) bool {
+	err := conn.ListClustersV2PagesWithContext(ctx, input, func(page *kafka.ListClustersV2Output, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -60,36 +87,46 @@ func sweepClusters(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepConfigurations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	sweepResources, err := listConfigurations(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping MSK Cluster sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping MSK Configuration sweep for %s: %s", region, err)
 		return nil
 	}
-
 	if err != nil {
-		return fmt.Errorf("error listing MSK Clusters (%s): %w", region, err)
+		return fmt.Errorf("error listing MSK Configurations (%s): %w", region, err)
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping MSK Clusters (%s): %w", region, err)
+		return fmt.Errorf("error sweeping MSK Configurations (%s): %w", region, err)
 	}
 
 	return nil
 }
 
-func sweepConfigurations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func listConfigurations(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.KafkaConn(ctx)
 
 	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &kafka.ListConfigurationsInput{}
-	err = conn.ListConfigurationsPagesWithContext(ctx, input, func(page *kafka.ListConfigurationsOutput, lastPage bool) bool {
+	err := conn.ListConfigurationsPagesWithContext(ctx, input, func(page *kafka.ListConfigurationsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -105,19 +142,9 @@ func sweepConfigurations(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping MSK Configuration sweep for %s: %s", region, err)
-		return nil
-	}
-	if err != nil {
-		return fmt.Errorf("error listing MSK Configurations (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
 	if err != nil {
-		return fmt.Errorf("error sweeping MSK Configurations (%s): %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
@@ -7,13 +7,16 @@
 package kafkaconnect
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kafkaconnect"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -21,6 +24,7 @@ func init() {
 		Name: "aws_mskconnect_connector",
 		F:    sweepConnectors,
 	})
+	discovery.Register("aws_mskconnect_connector", listConnectors)
 
 	resource.AddTestSweepers("aws_mskconnect_custom_plugin", &resource.Sweeper{
 		Name: "aws_mskconnect_custom_plugin",
@@ -29,6 +33,7 @@ func init() {
 			"aws_mskconnect_connector",
 		},
 	})
+	discovery.Register("aws_mskconnect_custom_plugin", listCustomPlugins)
 }
 
 func sweepConnectors(region string) error {
@@ -37,11 +42,33 @@ func sweepConnectors(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listConnectors(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping MSK Connect Connector sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing MSK Connect Connectors (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping MSK Connect Connectors (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listConnectors(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.KafkaConnectConn(ctx)
 	input := &kafkaconnect.ListConnectorsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListConnectorsPagesWithContext(ctx, input, func(page *kafkaconnect.ListConnectorsOutput, lastPage bool) bool {
+	err := conn.ListConnectorsPagesWithContext(ctx, input, func(page *kafkaconnect.ListConnectorsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -57,35 +84,46 @@ func sweepConnectors(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepCustomPlugins(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	sweepResources, err := listCustomPlugins(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping MSK Connect Connector sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping MSK Connect Custom Plugin sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing MSK Connect Connectors (%s): %w", region, err)
+		return fmt.Errorf("error listing MSK Connect Custom Plugins (%s): %w", region, err)
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping MSK Connect Connectors (%s): %w", region, err)
+		return fmt.Errorf("error sweeping MSK Connect Custom Plugins (%s): %w", region, err)
 	}
 
 	return nil
 }
 
-func sweepCustomPlugins(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func listCustomPlugins(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.KafkaConnectConn(ctx)
 	input := &kafkaconnect.ListCustomPluginsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListCustomPluginsPagesWithContext(ctx, input, func(page *kafkaconnect.ListCustomPluginsOutput, lastPage bool) bool {
+	err := conn.ListCustomPluginsPagesWithContext(ctx, input, func(page *kafkaconnect.ListCustomPluginsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -101,20 +139,9 @@ func sweepCustomPlugins(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping MSK Connect Custom Plugin sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("error listing MSK Connect Custom Plugins (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
 	if err != nil {
-		return fmt.Errorf("error sweeping MSK Connect Custom Plugins (%s): %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
@@ -7,6 +7,7 @@
 package kendra
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,8 +15,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/kendra"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -23,6 +26,7 @@ func init() {
 		Name: "aws_kendra_index",
 		F:    sweepIndex,
 	})
+	discovery.Register("aws_kendra_index", listIndex)
 }
 
 func sweepIndex(region string) error {
@@ -32,23 +36,38 @@ func sweepIndex(region string) error {
 		return fmt.Errorf("getting client: %w", err)
 	}
 
+	var errs *multierror.Error
+
+	sweepResources, err := listIndex(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Kendra Indices sweep for %s: %s", region, err)
+		return errs.ErrorOrNil()
+	}
+
+	if err != nil {
+		return multierror.Append(errs, fmt.Errorf("retrieving Kendra Indices: %w", err))
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping Kendra Indices for %s: %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func listIndex(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.KendraClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 	in := &kendra.ListIndicesInput{}
-	var errs *multierror.Error
 
 	pages := kendra.NewListIndicesPaginator(conn, in)
 
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Kendra Indices sweep for %s: %s", region, err)
-			return errs.ErrorOrNil()
-		}
-
 		if err != nil {
-			return multierror.Append(errs, fmt.Errorf("retrieving Kendra Indices: %w", err))
+			return nil, err
 		}
 
 		for _, index := range page.IndexConfigurationSummaryItems {
@@ -60,9 +79,5 @@ func sweepIndex(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("sweeping Kendra Indices for %s: %w", region, err))
-	}
-
-	return errs.ErrorOrNil()
+	return sweepResources, nil
 }
@@ -7,14 +7,17 @@
 package keyspaces
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/keyspaces"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -23,6 +26,7 @@ func init() {
 		Name: "aws_keyspaces_keyspace",
 		F:    sweepKeyspaces,
 	})
+	discovery.Register("aws_keyspaces_keyspace", listKeyspaces)
 }
 
 func sweepKeyspaces(region string) error { // nosemgrep:ci.keyspaces-in-func-name
@@ -31,6 +35,28 @@ func sweepKeyspaces(region string) error { // nosemgrep:ci.keyspaces-in-func-nam
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
+
+	sweepResources, err := listKeyspaces(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Keyspaces Keyspace sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Keyspaces Keyspaces (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Keyspaces Keyspaces (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listKeyspaces(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.KeyspacesClient(ctx)
 	input := &keyspaces.ListKeyspacesInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
@@ -39,13 +65,8 @@ func sweepKeyspaces(region string) error { // nosemgrep:ci.keyspaces-in-func-nam
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Keyspaces Keyspace sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error listing Keyspaces Keyspaces (%s): %w", region, err)
+			return nil, err
 		}
 
 		for _, v := range page.Keyspaces {
@@ -65,11 +86,5 @@ func sweepKeyspaces(region string) error { // nosemgrep:ci.keyspaces-in-func-nam
 		}
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping Keyspaces Keyspaces (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
@@ -7,13 +7,16 @@
 package kinesis
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kinesis"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -21,6 +24,7 @@ func init() {
 		Name: "aws_kinesis_stream",
 		F:    sweepStreams,
 	})
+	discovery.Register("aws_kinesis_stream", listStreams)
 }
 
 func sweepStreams(region string) error {
@@ -29,11 +33,32 @@ func sweepStreams(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listStreams(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Kinesis Stream sweep for %s: %s", region, err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error listing Kinesis Streams (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Kinesis Streams (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listStreams(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.KinesisConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &kinesis.ListStreamsInput{}
-	err = conn.ListStreamsPagesWithContext(ctx, input, func(page *kinesis.ListStreamsOutput, lastPage bool) bool {
+	err := conn.ListStreamsPagesWithContext(ctx, input, func(page *kinesis.ListStreamsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -51,19 +76,9 @@ func sweepStreams(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Kinesis Stream sweep for %s: %s", region, err)
-		return nil
-	}
 	if err != nil {
-		return fmt.Errorf("error listing Kinesis Streams (%s): %w", region, err)
+		return nil, err
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping Kinesis Streams (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
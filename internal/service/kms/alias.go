@@ -76,7 +76,7 @@ func resourceAliasCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	if namePrefix == "" {
 		namePrefix = AliasNamePrefix
 	}
-	name := create.Name(d.Get("name").(string), namePrefix)
+	name := create.Name(ctx, d.Get("name").(string), namePrefix)
 
 	input := &kms.CreateAliasInput{
 		AliasName:   aws.String(name),
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+const (
+	envelopeAlgorithmAES256GCM = "AES_256_GCM"
+	envelopeVersion            = 1
+)
+
+// envelope is the self-describing result of client-side envelope encryption.
+// The content is encrypted locally with a KMS data key and the data key, encrypted under a KMS key, is stored alongside it.
+// Binary values are base64-encoded when marshaled to JSON.
+type envelope struct {
+	Version           int               `json:"version"`
+	Algorithm         string            `json:"algorithm"`
+	EncryptedDataKey  []byte            `json:"encrypted_data_key"`
+	IV                []byte            `json:"iv"`
+	EncryptionContext map[string]string `json:"encryption_context,omitempty"`
+	Ciphertext        []byte            `json:"ciphertext"`
+}
+
+// sealEnvelope encrypts plaintext with the plaintext data key using AES-GCM.
+// The encryption context is authenticated, but not encrypted, as additional data.
+func sealEnvelope(dataKey, encryptedDataKey, plaintext []byte, encryptionContext map[string]string) (*envelope, error) {
+	aead, err := newEnvelopeAEAD(dataKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	iv := make([]byte, aead.NonceSize())
+
+	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+		return nil, fmt.Errorf("generating IV: %w", err)
+	}
+
+	aad, err := envelopeAdditionalData(encryptionContext)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &envelope{
+		Version:           envelopeVersion,
+		Algorithm:         envelopeAlgorithmAES256GCM,
+		EncryptedDataKey:  encryptedDataKey,
+		IV:                iv,
+		EncryptionContext: encryptionContext,
+		Ciphertext:        aead.Seal(nil, iv, plaintext, aad),
+	}, nil
+}
+
+// openEnvelope decrypts an envelope's content with the plaintext data key.
+func openEnvelope(env *envelope, dataKey []byte) ([]byte, error) {
+	aead, err := newEnvelopeAEAD(dataKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(env.IV) != aead.NonceSize() {
+		return nil, fmt.Errorf("invalid IV length (%d), expected %d", len(env.IV), aead.NonceSize())
+	}
+
+	aad, err := envelopeAdditionalData(env.EncryptionContext)
+
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := aead.Open(nil, env.IV, env.Ciphertext, aad)
+
+	if err != nil {
+		return nil, fmt.Errorf("decrypting content: %w", err)
+	}
+
+	return plaintext, nil
+}
+
+// parseEnvelope parses and validates a JSON envelope.
+func parseEnvelope(s string) (*envelope, error) {
+	var env envelope
+
+	if err := json.Unmarshal([]byte(s), &env); err != nil {
+		return nil, fmt.Errorf("parsing envelope: %w", err)
+	}
+
+	if env.Version != envelopeVersion {
+		return nil, fmt.Errorf("unsupported envelope version (%d)", env.Version)
+	}
+
+	if env.Algorithm != envelopeAlgorithmAES256GCM {
+		return nil, fmt.Errorf("unsupported envelope algorithm (%s)", env.Algorithm)
+	}
+
+	if len(env.EncryptedDataKey) == 0 {
+		return nil, fmt.Errorf("envelope has no encrypted data key")
+	}
+
+	return &env, nil
+}
+
+func newEnvelopeAEAD(dataKey []byte) (cipher.AEAD, error) {
+	if len(dataKey) != 32 {
+		return nil, fmt.Errorf("invalid data key length (%d), expected 32", len(dataKey))
+	}
+
+	block, err := aes.NewCipher(dataKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// envelopeAdditionalData returns the additional authenticated data for an encryption context.
+// Maps are marshaled with sorted keys so the result is deterministic.
+func envelopeAdditionalData(encryptionContext map[string]string) ([]byte, error) {
+	if len(encryptionContext) == 0 {
+		return nil, nil
+	}
+
+	return json.Marshal(encryptionContext)
+}
+
+// zeroBytes overwrites a plaintext data key once it's no longer needed.
+func zeroBytes(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
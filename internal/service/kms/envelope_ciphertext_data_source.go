@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_kms_envelope_ciphertext")
+func DataSourceEnvelopeCiphertext() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEnvelopeCiphertextRead,
+
+		Schema: map[string]*schema.Schema{
+			"algorithm": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ciphertext": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"encrypted_data_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"envelope": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"grant_tokens": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"iv": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"plaintext": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceEnvelopeCiphertextRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSConn(ctx)
+
+	keyID := d.Get("key_id").(string)
+	input := &kms.GenerateDataKeyInput{
+		KeyId:   aws.String(keyID),
+		KeySpec: aws.String(kms.DataKeySpecAes256),
+	}
+
+	var encryptionContext map[string]string
+	if v, ok := d.GetOk("context"); ok && len(v.(map[string]interface{})) > 0 {
+		encryptionContext = flex.ExpandStringValueMap(v.(map[string]interface{}))
+		input.EncryptionContext = aws.StringMap(encryptionContext)
+	}
+
+	if v, ok := d.GetOk("grant_tokens"); ok && len(v.([]interface{})) > 0 {
+		input.GrantTokens = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	output, err := conn.GenerateDataKeyWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "generating data key with KMS Key (%s): %s", keyID, err)
+	}
+
+	defer zeroBytes(output.Plaintext)
+
+	env, err := sealEnvelope(output.Plaintext, output.CiphertextBlob, []byte(d.Get("plaintext").(string)), encryptionContext)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "encrypting with KMS Key (%s) data key: %s", keyID, err)
+	}
+
+	v, err := json.Marshal(env)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(aws.StringValue(output.KeyId))
+	d.Set("algorithm", env.Algorithm)
+	d.Set("ciphertext", base64.StdEncoding.EncodeToString(env.Ciphertext))
+	d.Set("encrypted_data_key", base64.StdEncoding.EncodeToString(env.EncryptedDataKey))
+	d.Set("envelope", string(v))
+	d.Set("iv", base64.StdEncoding.EncodeToString(env.IV))
+
+	return diags
+}
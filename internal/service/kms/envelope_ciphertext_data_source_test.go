@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccKMSEnvelopeCiphertextDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_kms_envelope_ciphertext.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvelopeCiphertextDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "algorithm", "AES_256_GCM"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "ciphertext"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "encrypted_data_key"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "envelope"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "iv"),
+				),
+			},
+		},
+	})
+}
+
+const testAccEnvelopeCiphertextDataSourceConfig_basic = `
+resource "aws_kms_key" "test" {
+  description             = "tf-test-acc-data-source-aws-kms-envelope-ciphertext"
+  deletion_window_in_days = 7
+}
+
+data "aws_kms_envelope_ciphertext" "test" {
+  key_id    = aws_kms_key.test.key_id
+  plaintext = "Super secret data"
+}
+`
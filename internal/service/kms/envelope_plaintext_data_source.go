@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_kms_envelope_plaintext")
+func DataSourceEnvelopePlaintext() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEnvelopePlaintextRead,
+
+		Schema: map[string]*schema.Schema{
+			"context": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"envelope": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"grant_tokens": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"plaintext": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceEnvelopePlaintextRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSConn(ctx)
+
+	env, err := parseEnvelope(d.Get("envelope").(string))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &kms.DecryptInput{
+		CiphertextBlob: env.EncryptedDataKey,
+	}
+
+	if len(env.EncryptionContext) > 0 {
+		input.EncryptionContext = aws.StringMap(env.EncryptionContext)
+	}
+
+	if v, ok := d.GetOk("grant_tokens"); ok && len(v.([]interface{})) > 0 {
+		input.GrantTokens = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("key_id"); ok {
+		input.KeyId = aws.String(v.(string))
+	}
+
+	output, err := conn.DecryptWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "decrypting envelope data key: %s", err)
+	}
+
+	defer zeroBytes(output.Plaintext)
+
+	keyID := aws.StringValue(output.KeyId)
+	plaintext, err := openEnvelope(env, output.Plaintext)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "decrypting envelope with KMS Key (%s) data key: %s", keyID, err)
+	}
+
+	d.SetId(keyID)
+	d.Set("context", env.EncryptionContext)
+	d.Set("plaintext", string(plaintext))
+
+	return diags
+}
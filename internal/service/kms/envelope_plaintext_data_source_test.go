@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccKMSEnvelopePlaintextDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_kms_envelope_plaintext.test"
+	keyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvelopePlaintextDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "context.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "context.name", "value"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", keyResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "plaintext", "data.aws_kms_envelope_ciphertext.test", "plaintext"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSEnvelopePlaintextDataSource_large(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_kms_envelope_plaintext.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvelopePlaintextDataSourceConfig_large,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "plaintext", "data.aws_kms_envelope_ciphertext.test", "plaintext"),
+				),
+			},
+		},
+	})
+}
+
+const testAccEnvelopePlaintextDataSourceConfig_basic = `
+resource "aws_kms_key" "test" {
+  description             = "tf-test-acc-data-source-aws-kms-envelope-plaintext"
+  deletion_window_in_days = 7
+}
+
+data "aws_kms_envelope_ciphertext" "test" {
+  key_id    = aws_kms_key.test.key_id
+  plaintext = "Super secret data"
+
+  context = {
+    name = "value"
+  }
+}
+
+data "aws_kms_envelope_plaintext" "test" {
+  envelope = data.aws_kms_envelope_ciphertext.test.envelope
+}
+`
+
+// Larger than the 4 KB limit of the KMS Encrypt API.
+const testAccEnvelopePlaintextDataSourceConfig_large = `
+resource "aws_kms_key" "test" {
+  description             = "tf-test-acc-data-source-aws-kms-envelope-plaintext"
+  deletion_window_in_days = 7
+}
+
+data "aws_kms_envelope_ciphertext" "test" {
+  key_id    = aws_kms_key.test.key_id
+  plaintext = join("", [for i in range(1024) : "0123456789abcdef"])
+}
+
+data "aws_kms_envelope_plaintext" "test" {
+  envelope = data.aws_kms_envelope_ciphertext.test.envelope
+  key_id   = aws_kms_key.test.arn
+}
+`
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestEnvelope(t *testing.T) {
+	t.Parallel()
+
+	dataKey := bytes.Repeat([]byte{0x42}, 32)
+	encryptedDataKey := []byte("encrypted data key")
+
+	testCases := map[string]struct {
+		plaintext         []byte
+		encryptionContext map[string]string
+	}{
+		"empty": {
+			plaintext: []byte{},
+		},
+		"small": {
+			plaintext: []byte("Super secret data"),
+		},
+		"larger than Encrypt limit": {
+			plaintext: bytes.Repeat([]byte("x"), 1024*1024),
+		},
+		"with context": {
+			plaintext:         []byte("Super secret data"),
+			encryptionContext: map[string]string{"name": "value", "another": "value"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			env, err := sealEnvelope(dataKey, encryptedDataKey, testCase.plaintext, testCase.encryptionContext)
+
+			if err != nil {
+				t.Fatalf("sealing: %s", err)
+			}
+
+			v, err := json.Marshal(env)
+
+			if err != nil {
+				t.Fatalf("marshaling: %s", err)
+			}
+
+			env, err = parseEnvelope(string(v))
+
+			if err != nil {
+				t.Fatalf("parsing: %s", err)
+			}
+
+			if !bytes.Equal(env.EncryptedDataKey, encryptedDataKey) {
+				t.Errorf("got encrypted data key %q, expected %q", env.EncryptedDataKey, encryptedDataKey)
+			}
+
+			plaintext, err := openEnvelope(env, dataKey)
+
+			if err != nil {
+				t.Fatalf("opening: %s", err)
+			}
+
+			if !bytes.Equal(plaintext, testCase.plaintext) {
+				t.Errorf("got plaintext %q, expected %q", plaintext, testCase.plaintext)
+			}
+		})
+	}
+}
+
+func TestEnvelope_tampered(t *testing.T) {
+	t.Parallel()
+
+	dataKey := bytes.Repeat([]byte{0x42}, 32)
+
+	testCases := map[string]struct {
+		tamper func(*envelope, []byte) []byte
+	}{
+		"wrong data key": {
+			tamper: func(_ *envelope, dataKey []byte) []byte {
+				return bytes.Repeat([]byte{0x24}, len(dataKey))
+			},
+		},
+		"modified ciphertext": {
+			tamper: func(env *envelope, dataKey []byte) []byte {
+				env.Ciphertext[0] ^= 0xff
+				return dataKey
+			},
+		},
+		"modified context": {
+			tamper: func(env *envelope, dataKey []byte) []byte {
+				env.EncryptionContext["name"] = "other"
+				return dataKey
+			},
+		},
+		"removed context": {
+			tamper: func(env *envelope, dataKey []byte) []byte {
+				env.EncryptionContext = nil
+				return dataKey
+			},
+		},
+		"truncated IV": {
+			tamper: func(env *envelope, dataKey []byte) []byte {
+				env.IV = env.IV[1:]
+				return dataKey
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			env, err := sealEnvelope(dataKey, []byte("encrypted data key"), []byte("Super secret data"), map[string]string{"name": "value"})
+
+			if err != nil {
+				t.Fatalf("sealing: %s", err)
+			}
+
+			if _, err := openEnvelope(env, testCase.tamper(env, dataKey)); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestParseEnvelope(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         string
+		expectedError string
+	}{
+		"valid": {
+			input: `{"version":1,"algorithm":"AES_256_GCM","encrypted_data_key":"ZWRr","iv":"AAAAAAAAAAAAAAAA","ciphertext":""}`,
+		},
+		"invalid JSON": {
+			input:         `{`,
+			expectedError: "parsing envelope",
+		},
+		"unsupported version": {
+			input:         `{"version":2,"algorithm":"AES_256_GCM","encrypted_data_key":"ZWRr"}`,
+			expectedError: "unsupported envelope version (2)",
+		},
+		"unsupported algorithm": {
+			input:         `{"version":1,"algorithm":"AES_128_CBC","encrypted_data_key":"ZWRr"}`,
+			expectedError: "unsupported envelope algorithm (AES_128_CBC)",
+		},
+		"no data key": {
+			input:         `{"version":1,"algorithm":"AES_256_GCM"}`,
+			expectedError: "no encrypted data key",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parseEnvelope(testCase.input)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+				t.Errorf("got error %v, expected %q", err, testCase.expectedError)
+			}
+		})
+	}
+}
@@ -37,6 +37,14 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceCustomKeyStore,
 			TypeName: "aws_kms_custom_key_store",
 		},
+		{
+			Factory:  DataSourceEnvelopeCiphertext,
+			TypeName: "aws_kms_envelope_ciphertext",
+		},
+		{
+			Factory:  DataSourceEnvelopePlaintext,
+			TypeName: "aws_kms_envelope_plaintext",
+		},
 		{
 			Factory:  DataSourceKey,
 			TypeName: "aws_kms_key",
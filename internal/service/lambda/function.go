@@ -169,7 +169,7 @@ func ResourceFunction() *schema.Resource {
 			"filename": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir", "source_file"},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -208,7 +208,7 @@ func ResourceFunction() *schema.Resource {
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir", "source_file"},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -291,7 +291,7 @@ func ResourceFunction() *schema.Resource {
 			"s3_bucket": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir", "source_file"},
 				RequiredWith: []string{"s3_key"},
 			},
 			"s3_key": {
@@ -302,7 +302,7 @@ func ResourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir", "source_file"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -344,6 +344,28 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ExactlyOneOf:  []string{"filename", "image_uri", "s3_bucket", "source_dir", "source_file"},
+				ConflictsWith: []string{"source_code_hash"},
+			},
+			"source_excludes": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"source_file"},
+			},
+			"source_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ExactlyOneOf:  []string{"filename", "image_uri", "s3_bucket", "source_dir", "source_file"},
+				ConflictsWith: []string{"source_code_hash"},
+			},
+			"source_s3_bucket": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"timeout": {
@@ -416,6 +438,7 @@ func ResourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			sourceCodeHashCustomizeDiff,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -457,6 +480,28 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		input.Code.ZipFile = zipFile
+	} else if hasSourcePackage(d) {
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		pkg, err := expandSourcePackage(d)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "building Lambda Function (%s) deployment package: %s", functionName, err)
+		}
+
+		if len(pkg.Zip) > sourcePackageDirectUploadMaxSize {
+			key, err := uploadSourcePackage(ctx, d, meta, functionName, pkg)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating Lambda Function (%s): %s", functionName, err)
+			}
+
+			input.Code.S3Bucket = aws.String(d.Get("source_s3_bucket").(string))
+			input.Code.S3Key = aws.String(key)
+		} else {
+			input.Code.ZipFile = pkg.Zip
+		}
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
 	} else {
@@ -906,6 +951,28 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			}
 
 			input.ZipFile = zipFile
+		} else if hasSourcePackage(d) {
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+			pkg, err := expandSourcePackage(d)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "building Lambda Function (%s) deployment package: %s", d.Id(), err)
+			}
+
+			if len(pkg.Zip) > sourcePackageDirectUploadMaxSize {
+				key, err := uploadSourcePackage(ctx, d, meta, d.Id(), pkg)
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) code: %s", d.Id(), err)
+				}
+
+				input.S3Bucket = aws.String(d.Get("source_s3_bucket").(string))
+				input.S3Key = aws.String(key)
+			} else {
+				input.ZipFile = pkg.Zip
+			}
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
 		} else {
@@ -1233,6 +1300,8 @@ func needsFunctionCodeUpdate(d verify.ResourceDiffer) bool {
 		d.HasChange("s3_bucket") ||
 		d.HasChange("s3_key") ||
 		d.HasChange("s3_object_version") ||
+		d.HasChange("source_dir") ||
+		d.HasChange("source_file") ||
 		d.HasChange("image_uri") ||
 		d.HasChange("architectures")
 }
@@ -1915,6 +1915,78 @@ func TestAccLambdaFunction_LocalUpdate_nameOnly(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_sourceDir(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+	dir := t.TempDir()
+
+	writeSource := func(fixture string) {
+		content, err := os.ReadFile(fixture)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "lambda.js"), content, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					writeSource("test-fixtures/lambda_func.js")
+					if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("excluded"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccFunctionConfig_sourceDir(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrWith(resourceName, "source_code_hash", func(value string) error {
+						if value != aws.ToString(conf.Configuration.CodeSha256) {
+							return fmt.Errorf("source_code_hash (%s) doesn't match CodeSha256 (%s)", value, aws.ToString(conf.Configuration.CodeSha256))
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish", "source_dir", "source_excludes"},
+			},
+			{
+				PreConfig: func() {
+					writeSource("test-fixtures/lambda_func_modified.js")
+				},
+				Config:   testAccFunctionConfig_sourceDir(rName, dir),
+				PlanOnly: true,
+				// The modified source is planned as a code update.
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccFunctionConfig_sourceDir(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrWith(resourceName, "source_code_hash", func(value string) error {
+						if value != aws.ToString(conf.Configuration.CodeSha256) {
+							return fmt.Errorf("source_code_hash (%s) doesn't match CodeSha256 (%s)", value, aws.ToString(conf.Configuration.CodeSha256))
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_S3Update_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	path, zipFile, err := createTempFile("lambda_s3Update")
@@ -3696,6 +3768,21 @@ resource "aws_lambda_function" "test" {
 `, filePath, rName)
 }
 
+func testAccFunctionConfig_sourceDir(rName, dir string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  source_dir      = %[2]q
+  source_excludes = ["*.md"]
+  function_name   = %[1]q
+  role            = aws_iam_role.iam_for_lambda.arn
+  handler         = "lambda.handler"
+  runtime         = "nodejs16.x"
+}
+`, rName, dir))
+}
+
 func testAccFunctionConfig_localNameOnly(filePath, rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "iam_for_lambda" {
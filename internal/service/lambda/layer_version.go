@@ -37,6 +37,8 @@ func ResourceLayerVersion() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: sourceCodeHashCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -76,7 +78,7 @@ func ResourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version"},
+				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version", "source_dir", "source_file"},
 			},
 			"layer_arn": {
 				Type:     schema.TypeString,
@@ -97,19 +99,19 @@ func ResourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir", "source_file"},
 			},
 			"s3_key": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir", "source_file"},
 			},
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir", "source_file"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -135,6 +137,30 @@ func ResourceLayerVersion() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_code_hash", "source_file"},
+			},
+			"source_excludes": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"source_file"},
+			},
+			"source_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_code_hash", "source_dir"},
+			},
+			"source_s3_bucket": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			"version": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -153,8 +179,8 @@ func resourceLayerVersionPublish(ctx context.Context, d *schema.ResourceData, me
 	s3Key, keyOk := d.GetOk("s3_key")
 	s3ObjectVersion, versionOk := d.GetOk("s3_object_version")
 
-	if !hasFilename && !bucketOk && !keyOk && !versionOk {
-		return sdkdiag.AppendErrorf(diags, "filename or s3_* attributes must be set")
+	if !hasFilename && !bucketOk && !keyOk && !versionOk && !hasSourcePackage(d) {
+		return sdkdiag.AppendErrorf(diags, "filename, source_dir, source_file or s3_* attributes must be set")
 	}
 
 	var layerContent *lambda.LayerVersionContentInput
@@ -168,6 +194,27 @@ func resourceLayerVersionPublish(ctx context.Context, d *schema.ResourceData, me
 		layerContent = &lambda.LayerVersionContentInput{
 			ZipFile: file,
 		}
+	} else if hasSourcePackage(d) {
+		conns.GlobalMutexKV.Lock(mutexLayerKey)
+		defer conns.GlobalMutexKV.Unlock(mutexLayerKey)
+		pkg, err := expandSourcePackage(d)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "building Lambda Layer (%s) deployment package: %s", layerName, err)
+		}
+		if len(pkg.Zip) > sourcePackageDirectUploadMaxSize {
+			key, err := uploadSourcePackage(ctx, d, meta, layerName, pkg)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating Lambda Layer (%s): %s", layerName, err)
+			}
+			layerContent = &lambda.LayerVersionContentInput{
+				S3Bucket: aws.String(d.Get("source_s3_bucket").(string)),
+				S3Key:    aws.String(key),
+			}
+		} else {
+			layerContent = &lambda.LayerVersionContentInput{
+				ZipFile: pkg.Zip,
+			}
+		}
 	} else {
 		if !bucketOk || !keyOk {
 			return sdkdiag.AppendErrorf(diags, "s3_bucket and s3_key must all be set while using s3 code source")
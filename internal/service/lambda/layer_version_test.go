@@ -55,6 +55,42 @@ func TestAccLambdaLayerVersion_basic(t *testing.T) {
 	})
 }
 
+func TestAccLambdaLayerVersion_sourceFile(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lambda_layer_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLayerVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionConfig_sourceFile(rName, "test-fixtures/lambda_func.js"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "source_code_hash"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_file", "skip_destroy"},
+			},
+			{
+				Config: testAccLayerVersionConfig_sourceFile(rName, "test-fixtures/lambda_func_modified.js"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaLayerVersion_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_lambda_layer_version.test"
@@ -385,6 +421,15 @@ resource "aws_lambda_layer_version" "test" {
 `, rName)
 }
 
+func testAccLayerVersionConfig_sourceFile(rName, sourceFile string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "test" {
+  source_file = %[2]q
+  layer_name  = %[1]q
+}
+`, rName, sourceFile)
+}
+
 func testAccLayerVersionConfig_s3(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "lambda_bucket" {
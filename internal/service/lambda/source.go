@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	// sourcePackageDirectUploadMaxSize is the maximum size of a deployment package uploaded directly to Lambda.
+	// Larger packages must be uploaded to S3.
+	// See https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html.
+	sourcePackageDirectUploadMaxSize = 50 * 1024 * 1024
+)
+
+// sourcePackageModified is the modification time of every file in a deployment package built from source.
+var sourcePackageModified = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// sourcePackage is a deployment package built from a local source directory or file.
+type sourcePackage struct {
+	// Zip is the content of the ZIP archive.
+	Zip []byte
+	// Hash is the base64-encoded SHA-256 digest of the ZIP archive, as reported by Lambda's CodeSha256.
+	Hash string
+}
+
+// sourcePackageFile is a single file added to a deployment package.
+type sourcePackageFile struct {
+	// Name is the slash-separated name of the file in the archive.
+	Name string
+	// Path is the file's local filesystem path.
+	Path string
+	// Mode is the file's local filesystem mode.
+	Mode fs.FileMode
+}
+
+// buildSourcePackage builds a deterministic ZIP archive from either a source directory or a single source file.
+// Entries are sorted by name and have fixed timestamps and permissions, so identical sources produce identical archives on any machine.
+func buildSourcePackage(sourceDir, sourceFile string, excludes []string) (*sourcePackage, error) {
+	var files []sourcePackageFile
+	var err error
+
+	if sourceDir != "" {
+		files, err = sourcePackageDirFiles(sourceDir, excludes)
+	} else {
+		files, err = sourcePackageSingleFile(sourceFile)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	for _, file := range files {
+		if err := writeSourcePackageFile(w, file); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	h := sha256.Sum256(buf.Bytes())
+
+	return &sourcePackage{
+		Zip:  buf.Bytes(),
+		Hash: base64.StdEncoding.EncodeToString(h[:]),
+	}, nil
+}
+
+func sourcePackageDirFiles(sourceDir string, excludes []string) ([]sourcePackageFile, error) {
+	root, err := homedir.Expand(sourceDir)
+
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source_dir (%s): %w", sourceDir, err)
+	}
+
+	var files []sourcePackageFile
+
+	err = filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, p)
+
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		if d.IsDir() {
+			if rel != "." && sourcePackageExcluded(rel, excludes) {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if sourcePackageExcluded(rel, excludes) {
+			return nil
+		}
+
+		// Follow symbolic links to files, skip anything else that isn't a regular file.
+		fi, err := os.Stat(p)
+
+		if err != nil {
+			return err
+		}
+
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+
+		files = append(files, sourcePackageFile{
+			Name: rel,
+			Path: p,
+			Mode: fi.Mode(),
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source_dir (%s): %w", sourceDir, err)
+	}
+
+	return files, nil
+}
+
+func sourcePackageSingleFile(sourceFile string) ([]sourcePackageFile, error) {
+	p, err := homedir.Expand(sourceFile)
+
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source_file (%s): %w", sourceFile, err)
+	}
+
+	fi, err := os.Stat(p)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source_file (%s): %w", sourceFile, err)
+	}
+
+	if !fi.Mode().IsRegular() {
+		return nil, fmt.Errorf("reading source_file (%s): not a regular file", sourceFile)
+	}
+
+	return []sourcePackageFile{{
+		Name: filepath.Base(p),
+		Path: p,
+		Mode: fi.Mode(),
+	}}, nil
+}
+
+func writeSourcePackageFile(w *zip.Writer, file sourcePackageFile) error {
+	header := &zip.FileHeader{
+		Name:     file.Name,
+		Method:   zip.Deflate,
+		Modified: sourcePackageModified,
+	}
+
+	// Only the executable bit is significant, e.g. for a custom runtime's bootstrap.
+	if file.Mode&0111 != 0 {
+		header.SetMode(0755)
+	} else {
+		header.SetMode(0644)
+	}
+
+	fw, err := w.CreateHeader(header)
+
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(file.Path)
+
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	if _, err := io.Copy(fw, f); err != nil {
+		return fmt.Errorf("adding %s to deployment package: %w", file.Path, err)
+	}
+
+	return nil
+}
+
+// sourcePackageExcluded returns whether the slash-separated relative path matches any of the exclude patterns.
+// Patterns are matched against both the full relative path and the path's base name.
+func sourcePackageExcluded(rel string, excludes []string) bool {
+	for _, pattern := range excludes {
+		if ok, _ := path.Match(pattern, rel); ok {
+			return true
+		}
+
+		if ok, _ := path.Match(pattern, path.Base(rel)); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sourcePackageS3Key returns the content-addressed S3 object key of a deployment package.
+func sourcePackageS3Key(name string, pkg *sourcePackage) string {
+	h := sha256.Sum256(pkg.Zip)
+
+	return fmt.Sprintf("%s/%s.zip", name, hex.EncodeToString(h[:]))
+}
+
+// hasSourcePackage returns whether a deployment package is configured in source_dir or source_file.
+func hasSourcePackage(d schemaGetter) bool {
+	return d.Get("source_dir").(string) != "" || d.Get("source_file").(string) != ""
+}
+
+// expandSourcePackage builds the deployment package configured in source_dir or source_file.
+func expandSourcePackage(d schemaGetter) (*sourcePackage, error) {
+	return buildSourcePackage(d.Get("source_dir").(string), d.Get("source_file").(string), flex.ExpandStringValueSet(d.Get("source_excludes").(*schema.Set)))
+}
+
+// schemaGetter is implemented by both schema.ResourceData and schema.ResourceDiff.
+type schemaGetter interface {
+	Get(string) interface{}
+}
+
+// uploadSourcePackage uploads a deployment package that is too large to be uploaded directly to Lambda to
+// source_s3_bucket, returning the S3 object key.
+func uploadSourcePackage(ctx context.Context, d *schema.ResourceData, meta interface{}, name string, pkg *sourcePackage) (string, error) {
+	bucket := d.Get("source_s3_bucket").(string)
+
+	if bucket == "" {
+		return "", fmt.Errorf("deployment package size (%d bytes) exceeds the direct upload limit (%d bytes), source_s3_bucket must be set", len(pkg.Zip), sourcePackageDirectUploadMaxSize)
+	}
+
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+	key := sourcePackageS3Key(name, pkg)
+
+	_, err := conn.PutObject(ctx, &s3.PutObjectInput{
+		Body:   bytes.NewReader(pkg.Zip),
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("uploading deployment package to S3 Bucket (%s) Object (%s): %w", bucket, key, err)
+	}
+
+	return key, nil
+}
+
+// sourceCodeHashCustomizeDiff sets source_code_hash to the hash of the deployment package configured in
+// source_dir or source_file, so that changes to the local source are planned.
+func sourceCodeHashCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"source_dir", "source_file", "source_excludes"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("source_code_hash")
+		}
+	}
+
+	if !hasSourcePackage(d) {
+		return nil
+	}
+
+	pkg, err := expandSourcePackage(d)
+
+	if err != nil {
+		return fmt.Errorf("building deployment package: %w", err)
+	}
+
+	if d.Get("source_code_hash").(string) == pkg.Hash {
+		return nil
+	}
+
+	return d.SetNew("source_code_hash", pkg.Hash)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBuildSourcePackage(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	for name, content := range map[string]string{
+		"index.js":          "exports.handler = async () => {};",
+		"lib/util.js":       "module.exports = {};",
+		"bootstrap":         "#!/bin/sh",
+		"README.md":         "excluded",
+		"node_modules/x.js": "excluded",
+	} {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := os.Chmod(filepath.Join(dir, "bootstrap"), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	excludes := []string{"*.md", "node_modules"}
+
+	pkg1, err := buildSourcePackage(dir, "", excludes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Neither modification times nor permissions other than the executable bit affect the package.
+	mtime := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "index.js"), mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chmod(filepath.Join(dir, "lib/util.js"), 0640); err != nil {
+		t.Fatal(err)
+	}
+
+	pkg2, err := buildSourcePackage(dir, "", excludes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pkg1.Hash != pkg2.Hash || !bytes.Equal(pkg1.Zip, pkg2.Zip) {
+		t.Errorf("deployment package isn't deterministic: %s != %s", pkg1.Hash, pkg2.Hash)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(pkg1.Zip), int64(len(pkg1.Zip)))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	modes := make(map[string]os.FileMode)
+
+	for _, f := range r.File {
+		names = append(names, f.Name)
+		modes[f.Name] = f.Mode()
+
+		if !f.Modified.Equal(sourcePackageModified) {
+			t.Errorf("%s modified = %s, want %s", f.Name, f.Modified, sourcePackageModified)
+		}
+	}
+
+	if diff := cmp.Diff(names, []string{"bootstrap", "index.js", "lib/util.js"}); diff != "" {
+		t.Errorf("unexpected entries diff (+want, -got): %s", diff)
+	}
+
+	if diff := cmp.Diff(modes, map[string]os.FileMode{"bootstrap": 0755, "index.js": 0644, "lib/util.js": 0644}); diff != "" {
+		t.Errorf("unexpected modes diff (+want, -got): %s", diff)
+	}
+}
+
+func TestBuildSourcePackage_sourceFile(t *testing.T) {
+	t.Parallel()
+
+	pkg, err := buildSourcePackage("", "test-fixtures/lambda_func.js", nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(pkg.Zip), int64(len(pkg.Zip)))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(r.File), 1; got != want {
+		t.Fatalf("entries = %d, want %d", got, want)
+	}
+
+	if got, want := r.File[0].Name, "lambda_func.js"; got != want {
+		t.Errorf("entry name = %q, want %q", got, want)
+	}
+
+	if _, err := buildSourcePackage("", "test-fixtures", nil); err == nil {
+		t.Error("expected error for directory source_file")
+	}
+}
@@ -7,13 +7,16 @@
 package licensemanager
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/licensemanager"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -21,6 +24,7 @@ func init() {
 		Name: "aws_licensemanager_license_configuration",
 		F:    sweepLicenseConfigurations,
 	})
+	discovery.Register("aws_licensemanager_license_configuration", listLicenseConfigurations)
 }
 
 func sweepLicenseConfigurations(region string) error {
@@ -29,11 +33,33 @@ func sweepLicenseConfigurations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listLicenseConfigurations(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping License Manager License Configuration sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing License Manager License Configurations (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping License Manager License Configurations (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listLicenseConfigurations(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.LicenseManagerConn(ctx)
 	input := &licensemanager.ListLicenseConfigurationsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = listLicenseConfigurationsPages(ctx, conn, input, func(page *licensemanager.ListLicenseConfigurationsOutput, lastPage bool) bool {
+	err := listLicenseConfigurationsPages(ctx, conn, input, func(page *licensemanager.ListLicenseConfigurationsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -49,20 +75,9 @@ func sweepLicenseConfigurations(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping License Manager License Configuration sweep for %s: %s", region, err)
-		return nil
-	}
-
 	if err != nil {
-		return fmt.Errorf("error listing License Manager License Configurations (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping License Manager License Configurations (%s): %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
@@ -139,7 +139,7 @@ func resourceFindingsFilterCreate(ctx context.Context, d *schema.ResourceData, m
 
 	input := &macie2.CreateFindingsFilterInput{
 		ClientToken: aws.String(id.UniqueId()),
-		Name:        aws.String(create.Name(d.Get("name").(string), d.Get("name_prefix").(string))),
+		Name:        aws.String(create.Name(ctx, d.Get("name").(string), d.Get("name_prefix").(string))),
 		Action:      aws.String(d.Get("action").(string)),
 		Tags:        getTagsIn(ctx),
 	}
@@ -236,10 +236,10 @@ func resourceFindingsFilterUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 	if d.HasChange("name") {
-		input.Name = aws.String(create.Name(d.Get("name").(string), d.Get("name_prefix").(string)))
+		input.Name = aws.String(create.Name(ctx, d.Get("name").(string), d.Get("name_prefix").(string)))
 	}
 	if d.HasChange("name_prefix") {
-		input.Name = aws.String(create.Name(d.Get("name").(string), d.Get("name_prefix").(string)))
+		input.Name = aws.String(create.Name(ctx, d.Get("name").(string), d.Get("name_prefix").(string)))
 	}
 	if d.HasChange("description") {
 		input.Description = aws.String(d.Get("description").(string))
@@ -7,14 +7,17 @@
 package medialive
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/medialive"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -22,11 +25,13 @@ func init() {
 		Name: "aws_medialive_channel",
 		F:    sweepChannels,
 	})
+	discovery.Register("aws_medialive_channel", listChannels)
 
 	resource.AddTestSweepers("aws_medialive_input", &resource.Sweeper{
 		Name: "aws_medialive_input",
 		F:    sweepInputs,
 	})
+	discovery.Register("aws_medialive_input", listInputs)
 
 	resource.AddTestSweepers("aws_medialive_input_security_group", &resource.Sweeper{
 		Name: "aws_medialive_input_security_group",
@@ -35,11 +40,13 @@ func init() {
 			"aws_medialive_input",
 		},
 	})
+	discovery.Register("aws_medialive_input_security_group", listInputSecurityGroups)
 
 	resource.AddTestSweepers("aws_medialive_multiplex", &resource.Sweeper{
 		Name: "aws_medialive_multiplex",
 		F:    sweepMultiplexes,
 	})
+	discovery.Register("aws_medialive_multiplex", listMultiplexes)
 }
 
 func sweepChannels(region string) error {
@@ -49,6 +56,25 @@ func sweepChannels(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
+	sweepResources, err := listChannels(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Println("[WARN] Skipping MediaLive Channels sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error retrieving MediaLive Channels: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping MediaLive Channels for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listChannels(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.MediaLiveClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 	in := &medialive.ListChannelsInput{}
@@ -58,13 +84,8 @@ func sweepChannels(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if awsv2.SkipSweepError(err) {
-			log.Println("[WARN] Skipping MediaLive Channels sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error retrieving MediaLive Channels: %w", err)
+			return nil, err
 		}
 
 		for _, channel := range page.Channels {
@@ -79,11 +100,7 @@ func sweepChannels(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("error sweeping MediaLive Channels for %s: %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepInputs(region string) error {
@@ -93,6 +110,25 @@ func sweepInputs(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
+	sweepResources, err := listInputs(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Println("[WARN] Skipping MediaLive Inputs sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error retrieving MediaLive Inputs: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping MediaLive Inputs for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listInputs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.MediaLiveClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 	in := &medialive.ListInputsInput{}
@@ -102,13 +138,8 @@ func sweepInputs(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if awsv2.SkipSweepError(err) {
-			log.Println("[WARN] Skipping MediaLive Inputs sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error retrieving MediaLive Inputs: %w", err)
+			return nil, err
 		}
 
 		for _, input := range page.Inputs {
@@ -123,11 +154,7 @@ func sweepInputs(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("error sweeping MediaLive Inputs for %s: %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepInputSecurityGroups(region string) error {
@@ -137,6 +164,25 @@ func sweepInputSecurityGroups(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
+	sweepResources, err := listInputSecurityGroups(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Println("[WARN] Skipping MediaLive Input Security Groups sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error retrieving MediaLive Input Security Groups: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping MediaLive Input Security Groups for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listInputSecurityGroups(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.MediaLiveClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 	in := &medialive.ListInputSecurityGroupsInput{}
@@ -146,13 +192,8 @@ func sweepInputSecurityGroups(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if awsv2.SkipSweepError(err) {
-			log.Println("[WARN] Skipping MediaLive Input Security Groups sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error retrieving MediaLive Input Security Groups: %w", err)
+			return nil, err
 		}
 
 		for _, group := range page.InputSecurityGroups {
@@ -167,11 +208,7 @@ func sweepInputSecurityGroups(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("error sweeping MediaLive Input Security Groups for %s: %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepMultiplexes(region string) error {
@@ -181,6 +218,25 @@ func sweepMultiplexes(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
+	sweepResources, err := listMultiplexes(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Println("[WARN] Skipping MediaLive Multiplexes sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error retrieving MediaLive Multiplexes: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping MediaLive Multiplexes for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listMultiplexes(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.MediaLiveClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 	in := &medialive.ListMultiplexesInput{}
@@ -190,13 +246,8 @@ func sweepMultiplexes(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if awsv2.SkipSweepError(err) {
-			log.Println("[WARN] Skipping MediaLive Multiplexes sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error retrieving MediaLive Multiplexes: %w", err)
+			return nil, err
 		}
 
 		for _, multiplex := range page.Multiplexes {
@@ -211,9 +262,5 @@ func sweepMultiplexes(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("error sweeping MediaLive Multiplexes for %s: %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
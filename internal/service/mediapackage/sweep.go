@@ -7,14 +7,17 @@
 package mediapackage
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/mediapackage"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -22,6 +25,7 @@ func init() {
 		Name: "aws_media_package_channel",
 		F:    sweepChannels,
 	})
+	discovery.Register("aws_media_package_channel", listChannels)
 }
 
 func sweepChannels(region string) error {
@@ -31,6 +35,25 @@ func sweepChannels(region string) error {
 		return fmt.Errorf("getting client: %s", err)
 	}
 
+	sweepResources, err := listChannels(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Println("[WARN] Skipping MediaPackage Channels sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("retrieving MediaPackage Channels: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("sweeping Mediapackage Channels for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listChannels(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.MediaPackageClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 	in := &mediapackage.ListChannelsInput{}
@@ -40,13 +63,8 @@ func sweepChannels(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if awsv2.SkipSweepError(err) {
-			log.Println("[WARN] Skipping MediaPackage Channels sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("retrieving MediaPackage Channels: %w", err)
+			return nil, err
 		}
 
 		for _, channel := range page.Channels {
@@ -61,9 +79,5 @@ func sweepChannels(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("sweeping Mediapackage Channels for %s: %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
@@ -7,6 +7,7 @@
 package memorydb
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -14,7 +15,9 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/memorydb"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -25,11 +28,13 @@ func init() {
 			"aws_memorydb_cluster",
 		},
 	})
+	discovery.Register("aws_memorydb_acl", listACLs)
 
 	resource.AddTestSweepers("aws_memorydb_cluster", &resource.Sweeper{
 		Name: "aws_memorydb_cluster",
 		F:    sweepClusters,
 	})
+	discovery.Register("aws_memorydb_cluster", listClusters)
 
 	resource.AddTestSweepers("aws_memorydb_parameter_group", &resource.Sweeper{
 		Name: "aws_memorydb_parameter_group",
@@ -38,6 +43,7 @@ func init() {
 			"aws_memorydb_cluster",
 		},
 	})
+	discovery.Register("aws_memorydb_parameter_group", listParameterGroups)
 
 	resource.AddTestSweepers("aws_memorydb_snapshot", &resource.Sweeper{
 		Name: "aws_memorydb_snapshot",
@@ -46,6 +52,7 @@ func init() {
 			"aws_memorydb_cluster",
 		},
 	})
+	discovery.Register("aws_memorydb_snapshot", listSnapshots)
 
 	resource.AddTestSweepers("aws_memorydb_subnet_group", &resource.Sweeper{
 		Name: "aws_memorydb_subnet_group",
@@ -54,6 +61,7 @@ func init() {
 			"aws_memorydb_cluster",
 		},
 	})
+	discovery.Register("aws_memorydb_subnet_group", listSubnetGroups)
 
 	resource.AddTestSweepers("aws_memorydb_user", &resource.Sweeper{
 		Name: "aws_memorydb_user",
@@ -62,6 +70,7 @@ func init() {
 			"aws_memorydb_acl",
 		},
 	})
+	discovery.Register("aws_memorydb_user", listUsers)
 }
 
 func sweepACLs(region string) error {
@@ -70,11 +79,33 @@ func sweepACLs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
+
+	sweepResources, err := listACLs(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping MemoryDB ACL sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing MemoryDB ACLs (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping MemoryDB ACLs (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listACLs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.MemoryDBConn(ctx)
 	input := &memorydb.DescribeACLsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = describeACLsPages(ctx, conn, input, func(page *memorydb.DescribeACLsOutput, lastPage bool) bool {
+	err := describeACLsPages(ctx, conn, input, func(page *memorydb.DescribeACLsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -96,35 +127,46 @@ func sweepACLs(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepClusters(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	sweepResources, err := listClusters(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping MemoryDB ACL sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping MemoryDB Cluster sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing MemoryDB ACLs (%s): %w", region, err)
+		return fmt.Errorf("error listing MemoryDB Clusters (%s): %w", region, err)
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping MemoryDB ACLs (%s): %w", region, err)
+		return fmt.Errorf("error sweeping MemoryDB Clusters (%s): %w", region, err)
 	}
 
 	return nil
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
-	}
+func listClusters(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.MemoryDBConn(ctx)
 	input := &memorydb.DescribeClustersInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = describeClustersPages(ctx, conn, input, func(page *memorydb.DescribeClustersOutput, lastPage bool) bool {
+	err := describeClustersPages(ctx, conn, input, func(page *memorydb.DescribeClustersOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -140,35 +182,46 @@ func sweepClusters(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepParameterGroups(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	sweepResources, err := listParameterGroups(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping MemoryDB Cluster sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping MemoryDB Parameter Group sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing MemoryDB Clusters (%s): %w", region, err)
+		return fmt.Errorf("error listing MemoryDB Parameter Groups (%s): %w", region, err)
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping MemoryDB Clusters (%s): %w", region, err)
+		return fmt.Errorf("error sweeping MemoryDB Parameter Groups (%s): %w", region, err)
 	}
 
 	return nil
 }
 
-func sweepParameterGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
-	}
+func listParameterGroups(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.MemoryDBConn(ctx)
 	input := &memorydb.DescribeParameterGroupsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = describeParameterGroupsPages(ctx, conn, input, func(page *memorydb.DescribeParameterGroupsOutput, lastPage bool) bool {
+	err := describeParameterGroupsPages(ctx, conn, input, func(page *memorydb.DescribeParameterGroupsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -190,35 +243,46 @@ func sweepParameterGroups(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepSnapshots(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	sweepResources, err := listSnapshots(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping MemoryDB Parameter Group sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping MemoryDB Snapshot sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing MemoryDB Parameter Groups (%s): %w", region, err)
+		return fmt.Errorf("error listing MemoryDB Snapshots (%s): %w", region, err)
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping MemoryDB Parameter Groups (%s): %w", region, err)
+		return fmt.Errorf("error sweeping MemoryDB Snapshots (%s): %w", region, err)
 	}
 
 	return nil
 }
 
-func sweepSnapshots(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
-	}
+func listSnapshots(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.MemoryDBConn(ctx)
 	input := &memorydb.DescribeSnapshotsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = describeSnapshotsPages(ctx, conn, input, func(page *memorydb.DescribeSnapshotsOutput, lastPage bool) bool {
+	err := describeSnapshotsPages(ctx, conn, input, func(page *memorydb.DescribeSnapshotsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -234,35 +298,46 @@ func sweepSnapshots(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepSubnetGroups(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	sweepResources, err := listSubnetGroups(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping MemoryDB Snapshot sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping MemoryDB Subnet Group sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing MemoryDB Snapshots (%s): %w", region, err)
+		return fmt.Errorf("error listing MemoryDB Subnet Groups (%s): %w", region, err)
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping MemoryDB Snapshots (%s): %w", region, err)
+		return fmt.Errorf("error sweeping MemoryDB Subnet Groups (%s): %w", region, err)
 	}
 
 	return nil
 }
 
-func sweepSubnetGroups(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
-	}
+func listSubnetGroups(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.MemoryDBConn(ctx)
 	input := &memorydb.DescribeSubnetGroupsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = describeSubnetGroupsPages(ctx, conn, input, func(page *memorydb.DescribeSubnetGroupsOutput, lastPage bool) bool {
+	err := describeSubnetGroupsPages(ctx, conn, input, func(page *memorydb.DescribeSubnetGroupsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -284,35 +359,46 @@ func sweepSubnetGroups(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepUsers(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	sweepResources, err := listUsers(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping MemoryDB Subnet Group sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping MemoryDB User sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing MemoryDB Subnet Groups (%s): %w", region, err)
+		return fmt.Errorf("error listing MemoryDB Users (%s): %w", region, err)
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping MemoryDB Subnet Groups (%s): %w", region, err)
+		return fmt.Errorf("error sweeping MemoryDB Users (%s): %w", region, err)
 	}
 
 	return nil
 }
 
-func sweepUsers(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
-	}
+func listUsers(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.MemoryDBConn(ctx)
 	input := &memorydb.DescribeUsersInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = describeUsersPages(ctx, conn, input, func(page *memorydb.DescribeUsersOutput, lastPage bool) bool {
+	err := describeUsersPages(ctx, conn, input, func(page *memorydb.DescribeUsersOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -334,20 +420,9 @@ func sweepUsers(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping MemoryDB User sweep for %s: %s", region, err)
-		return nil
-	}
-
 	if err != nil {
-		return fmt.Errorf("error listing MemoryDB Users (%s): %w", region, err)
+		return nil, err
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping MemoryDB Users (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
@@ -7,6 +7,7 @@
 package neptune
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -15,7 +16,9 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -32,6 +35,7 @@ func init() {
 			"aws_neptune_cluster_instance",
 		},
 	})
+	discovery.Register("aws_neptune_cluster", listClusters)
 
 	resource.AddTestSweepers("aws_neptune_cluster_instance", &resource.Sweeper{
 		Name: "aws_neptune_cluster_instance",
@@ -40,6 +44,7 @@ func init() {
 			"aws_rds_global_cluster",
 		},
 	})
+	discovery.Register("aws_neptune_cluster_instance", listClusterInstances)
 }
 
 func sweepEventSubscriptions(region string) error {
@@ -104,13 +109,34 @@ func sweepClusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
+
+	var sweeperErrs *multierror.Error
+
+	sweepResources, err := listClusters(ctx, client)
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Neptune Cluster sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("listing Neptune Clusters (%s): %w", region, err))
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("sweeping Neptune Clusters (%s): %w", region, err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func listClusters(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.NeptuneConn(ctx)
 
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &neptune.DescribeDBClustersInput{}
-	err = conn.DescribeDBClustersPagesWithContext(ctx, input, func(page *neptune.DescribeDBClustersOutput, lastPage bool) bool {
+	err := conn.DescribeDBClustersPagesWithContext(ctx, input, func(page *neptune.DescribeDBClustersOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -144,20 +170,12 @@ func sweepClusters(region string) error {
 
 		return !lastPage
 	})
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Neptune Cluster sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-	}
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("listing Neptune Clusters (%s): %w", region, err))
-	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("sweeping Neptune Clusters (%s): %w", region, err))
+		sweeperErrs = multierror.Append(sweeperErrs, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepResources, sweeperErrs.ErrorOrNil()
 }
 
 func sweepClusterInstances(region string) error {
@@ -166,11 +184,33 @@ func sweepClusterInstances(region string) error {
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
+
+	sweepResources, err := listClusterInstances(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Neptune Cluster Instance sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing Neptune Cluster Instances (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping Neptune Cluster Instances (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listClusterInstances(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.NeptuneConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &neptune.DescribeDBInstancesInput{}
-	err = conn.DescribeDBInstancesPagesWithContext(ctx, input, func(page *neptune.DescribeDBInstancesOutput, lastPage bool) bool {
+	err := conn.DescribeDBInstancesPagesWithContext(ctx, input, func(page *neptune.DescribeDBInstancesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -188,20 +228,9 @@ func sweepClusterInstances(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Neptune Cluster Instance sweep for %s: %s", region, err)
-		return nil
-	}
-
 	if err != nil {
-		return fmt.Errorf("listing Neptune Cluster Instances (%s): %w", region, err)
+		return nil, err
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("sweeping Neptune Cluster Instances (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
@@ -7,6 +7,7 @@
 package opensearchserverless
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -15,8 +16,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
 )
 
@@ -25,14 +28,17 @@ func init() {
 		Name: "aws_opensearchserverless_access_policy",
 		F:    sweepAccessPolicies,
 	})
+	discovery.Register("aws_opensearchserverless_access_policy", listAccessPolicies)
 	resource.AddTestSweepers("aws_opensearchserverless_collection", &resource.Sweeper{
 		Name: "aws_opensearchserverless_collection",
 		F:    sweepCollections,
 	})
+	discovery.Register("aws_opensearchserverless_collection", listCollections)
 	resource.AddTestSweepers("aws_opensearchserverless_security_config", &resource.Sweeper{
 		Name: "aws_opensearchserverless_security_config",
 		F:    sweepSecurityConfigs,
 	})
+	discovery.Register("aws_opensearchserverless_collection", listSecurityConfigs)
 	resource.AddTestSweepers("aws_opensearchserverless_security_policy", &resource.Sweeper{
 		Name: "aws_opensearchserverless_security_policy",
 		F:    sweepSecurityPolicies,
@@ -41,6 +47,7 @@ func init() {
 		Name: "aws_opensearchserverless_vpc_endpoint",
 		F:    sweepVPCEndpoints,
 	})
+	discovery.Register("aws_opensearchserverless_collection", listVPCEndpoints)
 }
 
 func sweepAccessPolicies(region string) error {
@@ -51,6 +58,25 @@ func sweepAccessPolicies(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
+	sweepResources, err := listAccessPolicies(ctx, client)
+
+	if awsv2.SkipSweepError(err) || skipSweepErr(err) {
+		log.Printf("[WARN] Skipping OpenSearch Serverless Access Policies sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error retrieving OpenSearch Serverless Access Policies: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping OpenSearch Serverless Access Policies for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listAccessPolicies(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.OpenSearchServerlessClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 	input := &opensearchserverless.ListAccessPoliciesInput{
@@ -61,12 +87,8 @@ func sweepAccessPolicies(region string) error {
 
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
-		if awsv2.SkipSweepError(err) || skipSweepErr(err) {
-			log.Printf("[WARN] Skipping OpenSearch Serverless Access Policies sweep for %s: %s", region, err)
-			return nil
-		}
 		if err != nil {
-			return fmt.Errorf("error retrieving OpenSearch Serverless Access Policies: %w", err)
+			return nil, err
 		}
 
 		for _, ap := range page.AccessPolicySummaries {
@@ -81,11 +103,7 @@ func sweepAccessPolicies(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("error sweeping OpenSearch Serverless Access Policies for %s: %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepCollections(region string) error {
@@ -96,6 +114,25 @@ func sweepCollections(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
+	sweepResources, err := listCollections(ctx, client)
+
+	if awsv2.SkipSweepError(err) || skipSweepErr(err) {
+		log.Printf("[WARN] Skipping OpenSearch Serverless Collections sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error retrieving OpenSearch Serverless Collections: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping OpenSearch Serverless Collections for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listCollections(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.OpenSearchServerlessClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 	input := &opensearchserverless.ListCollectionsInput{}
@@ -104,12 +141,8 @@ func sweepCollections(region string) error {
 
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
-		if awsv2.SkipSweepError(err) || skipSweepErr(err) {
-			log.Printf("[WARN] Skipping OpenSearch Serverless Collections sweep for %s: %s", region, err)
-			return nil
-		}
 		if err != nil {
-			return fmt.Errorf("error retrieving OpenSearch Serverless Collections: %w", err)
+			return nil, err
 		}
 
 		for _, collection := range page.CollectionSummaries {
@@ -122,11 +155,7 @@ func sweepCollections(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("error sweeping OpenSearch Serverless Collections for %s: %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepSecurityConfigs(region string) error {
@@ -137,6 +166,25 @@ func sweepSecurityConfigs(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
+	sweepResources, err := listSecurityConfigs(ctx, client)
+
+	if awsv2.SkipSweepError(err) || skipSweepErr(err) {
+		log.Printf("[WARN] Skipping OpenSearch Serverless Security Configs sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error retrieving OpenSearch Serverless Security Configs: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping OpenSearch Serverless Security Configs for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listSecurityConfigs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.OpenSearchServerlessClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
@@ -147,12 +195,8 @@ func sweepSecurityConfigs(region string) error {
 
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
-		if awsv2.SkipSweepError(err) || skipSweepErr(err) {
-			log.Printf("[WARN] Skipping OpenSearch Serverless Security Configs sweep for %s: %s", region, err)
-			return nil
-		}
 		if err != nil {
-			return fmt.Errorf("error retrieving OpenSearch Serverless Security Configs: %w", err)
+			return nil, err
 		}
 
 		for _, sc := range page.SecurityConfigSummaries {
@@ -165,11 +209,7 @@ func sweepSecurityConfigs(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("error sweeping OpenSearch Serverless Security Configs for %s: %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepSecurityPolicies(region string) error {
@@ -252,6 +292,25 @@ func sweepVPCEndpoints(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
+	sweepResources, err := listVPCEndpoints(ctx, client)
+
+	if awsv2.SkipSweepError(err) || skipSweepErr(err) {
+		log.Printf("[WARN] Skipping OpenSearch Serverless VPC Endpoints sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error retrieving OpenSearch Serverless VPC Endpoints: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping OpenSearch Serverless VPC Endpoints for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listVPCEndpoints(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.OpenSearchServerlessClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 	input := &opensearchserverless.ListVpcEndpointsInput{}
@@ -260,12 +319,8 @@ func sweepVPCEndpoints(region string) error {
 
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
-		if awsv2.SkipSweepError(err) || skipSweepErr(err) {
-			log.Printf("[WARN] Skipping OpenSearch Serverless VPC Endpoints sweep for %s: %s", region, err)
-			return nil
-		}
 		if err != nil {
-			return fmt.Errorf("error retrieving OpenSearch Serverless VPC Endpoints: %w", err)
+			return nil, err
 		}
 
 		for _, endpoint := range page.VpcEndpointSummaries {
@@ -278,11 +333,7 @@ func sweepVPCEndpoints(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("error sweeping OpenSearch Serverless VPC Endpoints for %s: %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func skipSweepErr(err error) bool {
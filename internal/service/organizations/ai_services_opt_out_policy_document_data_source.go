@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_organizations_ai_services_opt_out_policy_document")
+func DataSourceAIServicesOptOutPolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAIServicesOptOutPolicyDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"opt_out_policy": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"optIn", "optOut"}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAIServicesOptOutPolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	doc := &aiServicesOptOutPolicyDocument{
+		Services: make(map[string]*aiServicesOptOutPolicyService),
+	}
+
+	for _, tfMapRaw := range d.Get("service").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+
+		if _, ok := doc.Services[name]; ok {
+			return sdkdiag.AppendErrorf(diags, "writing Organizations AI Services Opt-Out Policy Document: duplicate service (%s)", name)
+		}
+
+		doc.Services[name] = &aiServicesOptOutPolicyService{
+			OptOutPolicy: newPolicyAssign(tfMap["opt_out_policy"].(string)),
+		}
+	}
+
+	v, err := marshalPolicyDocument(doc, organizations.PolicyTypeAiservicesOptOutPolicy)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing Organizations AI Services Opt-Out Policy Document: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(v)))
+	d.Set("json", v)
+
+	return diags
+}
+
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_ai-opt-out_syntax.html.
+type aiServicesOptOutPolicyDocument struct {
+	Services map[string]*aiServicesOptOutPolicyService `json:"services"`
+}
+
+type aiServicesOptOutPolicyService struct {
+	OptOutPolicy *policyAssign[string] `json:"opt_out_policy"`
+}
@@ -0,0 +1,362 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_organizations_backup_policy_document")
+func DataSourceBackupPolicyDocument() *schema.Resource {
+	lifecycleSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"delete_after_days": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					"move_to_cold_storage_after_days": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceBackupPolicyDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"plan": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"regions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidRegionName,
+							},
+						},
+						"rule": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"complete_backup_window_minutes": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"copy_action": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"lifecycle": lifecycleSchema(),
+												"target_backup_vault_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+									"lifecycle": lifecycleSchema(),
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"schedule_expression": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"start_backup_window_minutes": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"target_backup_vault_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+						"selection": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"iam_role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"tag_key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"tag_values": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBackupPolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	doc := &backupPolicyDocument{
+		Plans: make(map[string]*backupPolicyPlan),
+	}
+
+	for _, tfMapRaw := range d.Get("plan").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+
+		if _, ok := doc.Plans[name]; ok {
+			return sdkdiag.AppendErrorf(diags, "writing Organizations Backup Policy Document: duplicate plan (%s)", name)
+		}
+
+		plan, err := expandBackupPolicyPlan(tfMap)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing Organizations Backup Policy Document: plan (%s): %s", name, err)
+		}
+
+		doc.Plans[name] = plan
+	}
+
+	v, err := marshalPolicyDocument(doc, organizations.PolicyTypeBackupPolicy)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing Organizations Backup Policy Document: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(v)))
+	d.Set("json", v)
+
+	return diags
+}
+
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_backup_syntax.html.
+type backupPolicyDocument struct {
+	Plans map[string]*backupPolicyPlan `json:"plans"`
+}
+
+type backupPolicyPlan struct {
+	Regions    *policyAssign[[]string]      `json:"regions"`
+	Rules      map[string]*backupPolicyRule `json:"rules"`
+	Selections *backupPolicySelections      `json:"selections,omitempty"`
+}
+
+type backupPolicyRule struct {
+	ScheduleExpression          *policyAssign[string]              `json:"schedule_expression,omitempty"`
+	StartBackupWindowMinutes    *policyAssign[string]              `json:"start_backup_window_minutes,omitempty"`
+	CompleteBackupWindowMinutes *policyAssign[string]              `json:"complete_backup_window_minutes,omitempty"`
+	TargetBackupVaultName       *policyAssign[string]              `json:"target_backup_vault_name"`
+	Lifecycle                   *backupPolicyLifecycle             `json:"lifecycle,omitempty"`
+	CopyActions                 map[string]*backupPolicyCopyAction `json:"copy_actions,omitempty"`
+}
+
+type backupPolicyLifecycle struct {
+	MoveToColdStorageAfterDays *policyAssign[string] `json:"move_to_cold_storage_after_days,omitempty"`
+	DeleteAfterDays            *policyAssign[string] `json:"delete_after_days,omitempty"`
+}
+
+type backupPolicyCopyAction struct {
+	TargetBackupVaultARN *policyAssign[string]  `json:"target_backup_vault_arn"`
+	Lifecycle            *backupPolicyLifecycle `json:"lifecycle,omitempty"`
+}
+
+type backupPolicySelections struct {
+	Tags map[string]*backupPolicyTagSelection `json:"tags"`
+}
+
+type backupPolicyTagSelection struct {
+	IAMRoleARN *policyAssign[string]   `json:"iam_role_arn"`
+	TagKey     *policyAssign[string]   `json:"tag_key"`
+	TagValue   *policyAssign[[]string] `json:"tag_value"`
+}
+
+func expandBackupPolicyPlan(tfMap map[string]interface{}) (*backupPolicyPlan, error) {
+	regions := flex.ExpandStringValueSet(tfMap["regions"].(*schema.Set))
+	sort.Strings(regions)
+
+	plan := &backupPolicyPlan{
+		Regions: newPolicyAssign(regions),
+		Rules:   make(map[string]*backupPolicyRule),
+	}
+
+	for _, tfMapRaw := range tfMap["rule"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+
+		if _, ok := plan.Rules[name]; ok {
+			return nil, fmt.Errorf("duplicate rule (%s)", name)
+		}
+
+		plan.Rules[name] = expandBackupPolicyRule(tfMap)
+	}
+
+	for _, tfMapRaw := range tfMap["selection"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if plan.Selections == nil {
+			plan.Selections = &backupPolicySelections{
+				Tags: make(map[string]*backupPolicyTagSelection),
+			}
+		}
+
+		name := tfMap["name"].(string)
+
+		if _, ok := plan.Selections.Tags[name]; ok {
+			return nil, fmt.Errorf("duplicate selection (%s)", name)
+		}
+
+		tagValues := flex.ExpandStringValueSet(tfMap["tag_values"].(*schema.Set))
+		sort.Strings(tagValues)
+
+		plan.Selections.Tags[name] = &backupPolicyTagSelection{
+			IAMRoleARN: newPolicyAssign(tfMap["iam_role_arn"].(string)),
+			TagKey:     newPolicyAssign(tfMap["tag_key"].(string)),
+			TagValue:   newPolicyAssign(tagValues),
+		}
+	}
+
+	return plan, nil
+}
+
+func expandBackupPolicyRule(tfMap map[string]interface{}) *backupPolicyRule {
+	rule := &backupPolicyRule{
+		TargetBackupVaultName: newPolicyAssign(tfMap["target_backup_vault_name"].(string)),
+	}
+
+	if v, ok := tfMap["schedule_expression"].(string); ok && v != "" {
+		rule.ScheduleExpression = newPolicyAssign(v)
+	}
+
+	// Backup policies specify numeric values as strings.
+	if v, ok := tfMap["start_backup_window_minutes"].(int); ok && v != 0 {
+		rule.StartBackupWindowMinutes = newPolicyAssign(strconv.Itoa(v))
+	}
+
+	if v, ok := tfMap["complete_backup_window_minutes"].(int); ok && v != 0 {
+		rule.CompleteBackupWindowMinutes = newPolicyAssign(strconv.Itoa(v))
+	}
+
+	if v, ok := tfMap["lifecycle"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		rule.Lifecycle = expandBackupPolicyLifecycle(v[0].(map[string]interface{}))
+	}
+
+	for _, tfMapRaw := range tfMap["copy_action"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if rule.CopyActions == nil {
+			rule.CopyActions = make(map[string]*backupPolicyCopyAction)
+		}
+
+		arn := tfMap["target_backup_vault_arn"].(string)
+		copyAction := &backupPolicyCopyAction{
+			TargetBackupVaultARN: newPolicyAssign(arn),
+		}
+
+		if v, ok := tfMap["lifecycle"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			copyAction.Lifecycle = expandBackupPolicyLifecycle(v[0].(map[string]interface{}))
+		}
+
+		rule.CopyActions[arn] = copyAction
+	}
+
+	return rule
+}
+
+func expandBackupPolicyLifecycle(tfMap map[string]interface{}) *backupPolicyLifecycle {
+	lifecycle := &backupPolicyLifecycle{}
+
+	if v, ok := tfMap["move_to_cold_storage_after_days"].(int); ok && v != 0 {
+		lifecycle.MoveToColdStorageAfterDays = newPolicyAssign(strconv.Itoa(v))
+	}
+
+	if v, ok := tfMap["delete_after_days"].(int); ok && v != 0 {
+		lifecycle.DeleteAfterDays = newPolicyAssign(strconv.Itoa(v))
+	}
+
+	if lifecycle.MoveToColdStorageAfterDays == nil && lifecycle.DeleteAfterDays == nil {
+		return nil
+	}
+
+	return lifecycle
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccOrganizationsBackupPolicyDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_organizations_backup_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, organizations.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBackupPolicyDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", `{"plans":{"PII_Backup_Plan":{"regions":{"@@assign":["ap-northeast-2","us-east-1"]},"rules":{"Hourly":{"schedule_expression":{"@@assign":"cron(0 5/1 ? * * *)"},"start_backup_window_minutes":{"@@assign":"60"},"complete_backup_window_minutes":{"@@assign":"604800"},"target_backup_vault_name":{"@@assign":"FortKnox"},"lifecycle":{"move_to_cold_storage_after_days":{"@@assign":"180"},"delete_after_days":{"@@assign":"270"}},"copy_actions":{"arn:aws:backup:us-west-2:123456789012:backup-vault:Default":{"target_backup_vault_arn":{"@@assign":"arn:aws:backup:us-west-2:123456789012:backup-vault:Default"},"lifecycle":{"delete_after_days":{"@@assign":"30"}}}}}},"selections":{"tags":{"datatype":{"iam_role_arn":{"@@assign":"arn:aws:iam::123456789012:role/MyIamRole"},"tag_key":{"@@assign":"dataType"},"tag_value":{"@@assign":["PII","RED"]}}}}}}}`),
+				),
+			},
+		},
+	})
+}
+
+const testAccBackupPolicyDocumentDataSourceConfig_basic = `
+data "aws_organizations_backup_policy_document" "test" {
+  plan {
+    name    = "PII_Backup_Plan"
+    regions = ["us-east-1", "ap-northeast-2"]
+
+    rule {
+      name                           = "Hourly"
+      schedule_expression            = "cron(0 5/1 ? * * *)"
+      start_backup_window_minutes    = 60
+      complete_backup_window_minutes = 604800
+      target_backup_vault_name       = "FortKnox"
+
+      lifecycle {
+        move_to_cold_storage_after_days = 180
+        delete_after_days               = 270
+      }
+
+      copy_action {
+        target_backup_vault_arn = "arn:aws:backup:us-west-2:123456789012:backup-vault:Default"
+
+        lifecycle {
+          delete_after_days = 30
+        }
+      }
+    }
+
+    selection {
+      name         = "datatype"
+      iam_role_arn = "arn:aws:iam::123456789012:role/MyIamRole"
+      tag_key      = "dataType"
+      tag_values   = ["RED", "PII"]
+    }
+  }
+}
+`
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+)
+
+// policyDocumentMaxSizes are the maximum sizes, in characters, of policy documents by policy type.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_reference_limits.html.
+var policyDocumentMaxSizes = map[string]int{
+	organizations.PolicyTypeAiservicesOptOutPolicy: 2500,
+	organizations.PolicyTypeBackupPolicy:           10000,
+	organizations.PolicyTypeServiceControlPolicy:   5120,
+	organizations.PolicyTypeTagPolicy:              10000,
+}
+
+// marshalPolicyDocument returns a policy document as JSON without insignificant whitespace.
+// An error is returned if the document exceeds the maximum size for the policy type.
+func marshalPolicyDocument(doc any, policyType string) (string, error) {
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(doc); err != nil {
+		return "", err
+	}
+
+	v := strings.TrimSuffix(buf.String(), "\n")
+
+	if size, maxSize := utf8.RuneCountInString(v), policyDocumentMaxSizes[policyType]; maxSize > 0 && size > maxSize {
+		return "", fmt.Errorf("%s document is %d characters, which exceeds the maximum size of %d characters", policyType, size, maxSize)
+	}
+
+	return v, nil
+}
+
+// policyAssign is the @@assign value-setting operator of management policies, i.e. backup, tag and AI services opt-out policies.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_inheritance_mgmt.html.
+type policyAssign[T any] struct {
+	Assign T `json:"@@assign"`
+}
+
+func newPolicyAssign[T any](v T) *policyAssign[T] {
+	return &policyAssign[T]{Assign: v}
+}
@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMarshalPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		doc           any
+		policyType    string
+		expected      string
+		expectedError bool
+	}{
+		"minified": {
+			doc: &aiServicesOptOutPolicyDocument{
+				Services: map[string]*aiServicesOptOutPolicyService{
+					"default": {OptOutPolicy: newPolicyAssign("optOut")},
+				},
+			},
+			policyType: organizations.PolicyTypeAiservicesOptOutPolicy,
+			expected:   `{"services":{"default":{"opt_out_policy":{"@@assign":"optOut"}}}}`,
+		},
+		"HTML not escaped": {
+			doc: &scpDocument{
+				Version: "2012-10-17",
+				Statements: []*scpStatement{{
+					Effect:     "Deny",
+					Actions:    scpStringSet{"s3:*"},
+					Resources:  scpStringSet{"*"},
+					Conditions: scpConditionSet{"StringNotLike": {"aws:PrincipalArn": scpStringSet{"arn:aws:iam::*:role/<admin>&"}}},
+				}},
+			},
+			policyType: organizations.PolicyTypeServiceControlPolicy,
+			expected:   `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"StringNotLike":{"aws:PrincipalArn":"arn:aws:iam::*:role/<admin>&"}}}]}`,
+		},
+		"too large": {
+			doc: &scpDocument{
+				Version: "2012-10-17",
+				Statements: []*scpStatement{{
+					Effect:    "Deny",
+					Actions:   scpStringSet{"s3:" + strings.Repeat("a", 5120)},
+					Resources: scpStringSet{"*"},
+				}},
+			},
+			policyType:    organizations.PolicyTypeServiceControlPolicy,
+			expectedError: true,
+		},
+		"too large for SCP but not tag policy": {
+			doc: &tagPolicyDocument{
+				Tags: map[string]*tagPolicyTag{
+					"costcenter": {TagKey: newPolicyAssign(strings.Repeat("a", 5120))},
+				},
+			},
+			policyType: organizations.PolicyTypeTagPolicy,
+			expected:   `{"tags":{"costcenter":{"tag_key":{"@@assign":"` + strings.Repeat("a", 5120) + `"}}}}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := marshalPolicyDocument(testCase.doc, testCase.policyType)
+
+			if err != nil {
+				if !testCase.expectedError {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError {
+				t.Fatal("expected error")
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestMergeSCPStatements(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		statements []*scpStatement
+		expected   []*scpStatement
+	}{
+		"same effect and resources": {
+			statements: []*scpStatement{
+				{Effect: "Deny", Actions: scpStringSet{"ec2:RunInstances"}, Resources: scpStringSet{"*"}},
+				{Effect: "Deny", Actions: scpStringSet{"s3:DeleteBucket"}, Resources: scpStringSet{"*"}},
+			},
+			expected: []*scpStatement{
+				{Effect: "Deny", Actions: scpStringSet{"ec2:RunInstances", "s3:DeleteBucket"}, Resources: scpStringSet{"*"}},
+			},
+		},
+		"different effects": {
+			statements: []*scpStatement{
+				{Effect: "Allow", Actions: scpStringSet{"*"}, Resources: scpStringSet{"*"}},
+				{Effect: "Deny", Actions: scpStringSet{"s3:DeleteBucket"}, Resources: scpStringSet{"*"}},
+			},
+			expected: []*scpStatement{
+				{Effect: "Allow", Actions: scpStringSet{"*"}, Resources: scpStringSet{"*"}},
+				{Effect: "Deny", Actions: scpStringSet{"s3:DeleteBucket"}, Resources: scpStringSet{"*"}},
+			},
+		},
+		"different conditions": {
+			statements: []*scpStatement{
+				{Effect: "Deny", Actions: scpStringSet{"ec2:RunInstances"}, Resources: scpStringSet{"*"}, Conditions: scpConditionSet{"StringEquals": {"aws:RequestedRegion": scpStringSet{"us-east-1"}}}},
+				{Effect: "Deny", Actions: scpStringSet{"s3:DeleteBucket"}, Resources: scpStringSet{"*"}},
+				{Effect: "Deny", Actions: scpStringSet{"ec2:TerminateInstances"}, Resources: scpStringSet{"*"}, Conditions: scpConditionSet{"StringEquals": {"aws:RequestedRegion": scpStringSet{"us-east-1"}}}},
+			},
+			expected: []*scpStatement{
+				{Effect: "Deny", Actions: scpStringSet{"ec2:RunInstances", "ec2:TerminateInstances"}, Resources: scpStringSet{"*"}, Conditions: scpConditionSet{"StringEquals": {"aws:RequestedRegion": scpStringSet{"us-east-1"}}}},
+				{Effect: "Deny", Actions: scpStringSet{"s3:DeleteBucket"}, Resources: scpStringSet{"*"}},
+			},
+		},
+		"Sid not merged": {
+			statements: []*scpStatement{
+				{Sid: "DenyEC2", Effect: "Deny", Actions: scpStringSet{"ec2:RunInstances"}, Resources: scpStringSet{"*"}},
+				{Effect: "Deny", Actions: scpStringSet{"s3:DeleteBucket"}, Resources: scpStringSet{"*"}},
+			},
+			expected: []*scpStatement{
+				{Sid: "DenyEC2", Effect: "Deny", Actions: scpStringSet{"ec2:RunInstances"}, Resources: scpStringSet{"*"}},
+				{Effect: "Deny", Actions: scpStringSet{"s3:DeleteBucket"}, Resources: scpStringSet{"*"}},
+			},
+		},
+		"NotAction not merged": {
+			statements: []*scpStatement{
+				{Effect: "Deny", NotActions: scpStringSet{"iam:*"}, Resources: scpStringSet{"*"}},
+				{Effect: "Deny", NotActions: scpStringSet{"sts:*"}, Resources: scpStringSet{"*"}},
+			},
+			expected: []*scpStatement{
+				{Effect: "Deny", NotActions: scpStringSet{"iam:*"}, Resources: scpStringSet{"*"}},
+				{Effect: "Deny", NotActions: scpStringSet{"sts:*"}, Resources: scpStringSet{"*"}},
+			},
+		},
+		"merged actions made redundant": {
+			statements: []*scpStatement{
+				{Effect: "Deny", Actions: scpStringSet{"s3:DeleteBucket", "s3:PutObject"}, Resources: scpStringSet{"*"}},
+				{Effect: "Deny", Actions: scpStringSet{"s3:*"}, Resources: scpStringSet{"*"}},
+			},
+			expected: []*scpStatement{
+				{Effect: "Deny", Actions: scpStringSet{"s3:*"}, Resources: scpStringSet{"*"}},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := mergeSCPStatements(testCase.statements)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRemoveRedundantSCPActions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		actions  scpStringSet
+		expected scpStringSet
+	}{
+		"no wildcards": {
+			actions:  scpStringSet{"ec2:RunInstances", "s3:GetObject"},
+			expected: scpStringSet{"ec2:RunInstances", "s3:GetObject"},
+		},
+		"service wildcard": {
+			actions:  scpStringSet{"ec2:RunInstances", "s3:*", "s3:GetObject", "s3:PutObject"},
+			expected: scpStringSet{"ec2:RunInstances", "s3:*"},
+		},
+		"prefix wildcard": {
+			actions:  scpStringSet{"s3:Get*", "s3:GetObject", "s3:PutObject"},
+			expected: scpStringSet{"s3:Get*", "s3:PutObject"},
+		},
+		"case-insensitive": {
+			actions:  scpStringSet{"S3:*", "s3:getobject"},
+			expected: scpStringSet{"S3:*"},
+		},
+		"single character wildcard": {
+			actions:  scpStringSet{"ec2:?escribeInstances", "ec2:DescribeInstances"},
+			expected: scpStringSet{"ec2:?escribeInstances"},
+		},
+		"everything": {
+			actions:  scpStringSet{"*", "s3:*"},
+			expected: scpStringSet{"*"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := removeRedundantSCPActions(testCase.actions)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
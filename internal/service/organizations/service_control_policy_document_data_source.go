@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_organizations_service_control_policy_document")
+func DataSourceServiceControlPolicyDocument() *schema.Resource {
+	setOfString := &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceServiceControlPolicyDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"statement": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": setOfString,
+						"condition": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"test": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"variable": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"effect": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "Allow",
+							ValidateFunc: validation.StringInSlice([]string{"Allow", "Deny"}, false),
+						},
+						"not_actions":   setOfString,
+						"not_resources": setOfString,
+						"resources":     setOfString,
+						"sid": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceServiceControlPolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var statements []*scpStatement
+	sids := make(map[string]struct{})
+
+	for i, tfMapRaw := range d.Get("statement").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		statement, err := expandSCPStatement(tfMap)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing Organizations Service Control Policy Document: statement %d: %s", i, err)
+		}
+
+		if sid := statement.Sid; sid != "" {
+			if _, ok := sids[sid]; ok {
+				return sdkdiag.AppendErrorf(diags, "writing Organizations Service Control Policy Document: duplicate Sid (%s)", sid)
+			}
+			sids[sid] = struct{}{}
+		}
+
+		statements = append(statements, statement)
+	}
+
+	doc := &scpDocument{
+		Version:    "2012-10-17",
+		Statements: mergeSCPStatements(statements),
+	}
+
+	v, err := marshalPolicyDocument(doc, organizations.PolicyTypeServiceControlPolicy)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing Organizations Service Control Policy Document: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(v)))
+	d.Set("json", v)
+
+	return diags
+}
+
+type scpDocument struct {
+	Version    string          `json:"Version"`
+	Statements []*scpStatement `json:"Statement"`
+}
+
+type scpStatement struct {
+	Sid          string          `json:"Sid,omitempty"`
+	Effect       string          `json:"Effect"`
+	Actions      scpStringSet    `json:"Action,omitempty"`
+	NotActions   scpStringSet    `json:"NotAction,omitempty"`
+	Resources    scpStringSet    `json:"Resource,omitempty"`
+	NotResources scpStringSet    `json:"NotResource,omitempty"`
+	Conditions   scpConditionSet `json:"Condition,omitempty"`
+}
+
+// scpStringSet is a sorted set of strings, marshaled as a single string if it has one element.
+type scpStringSet []string
+
+func (s scpStringSet) MarshalJSON() ([]byte, error) {
+	var v any = []string(s)
+	if len(s) == 1 {
+		v = s[0]
+	}
+
+	// Use an Encoder so that HTML characters aren't escaped.
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+func newSCPStringSet(v []string) scpStringSet {
+	m := make(map[string]struct{}, len(v))
+	for _, v := range v {
+		m[v] = struct{}{}
+	}
+
+	s := make(scpStringSet, 0, len(m))
+	for v := range m {
+		s = append(s, v)
+	}
+	sort.Strings(s)
+
+	return s
+}
+
+// scpConditionSet maps condition operators, e.g. "StringEquals", to condition keys and their values.
+type scpConditionSet map[string]map[string]scpStringSet
+
+func expandSCPStatement(tfMap map[string]interface{}) (*scpStatement, error) {
+	statement := &scpStatement{
+		Effect: tfMap["effect"].(string),
+		Sid:    tfMap["sid"].(string),
+	}
+
+	if v, ok := tfMap["actions"].(*schema.Set); ok && v.Len() > 0 {
+		statement.Actions = newSCPStringSet(flex.ExpandStringValueSet(v))
+	}
+
+	if v, ok := tfMap["not_actions"].(*schema.Set); ok && v.Len() > 0 {
+		statement.NotActions = newSCPStringSet(flex.ExpandStringValueSet(v))
+	}
+
+	if (len(statement.Actions) == 0) == (len(statement.NotActions) == 0) {
+		return nil, fmt.Errorf("exactly one of actions or not_actions must be specified")
+	}
+
+	if v, ok := tfMap["resources"].(*schema.Set); ok && v.Len() > 0 {
+		statement.Resources = newSCPStringSet(flex.ExpandStringValueSet(v))
+	}
+
+	if v, ok := tfMap["not_resources"].(*schema.Set); ok && v.Len() > 0 {
+		statement.NotResources = newSCPStringSet(flex.ExpandStringValueSet(v))
+	}
+
+	if len(statement.Resources) > 0 && len(statement.NotResources) > 0 {
+		return nil, fmt.Errorf("only one of resources or not_resources can be specified")
+	}
+
+	// Service control policies require a Resource or NotResource element.
+	if len(statement.Resources) == 0 && len(statement.NotResources) == 0 {
+		statement.Resources = scpStringSet{"*"}
+	}
+
+	if v, ok := tfMap["condition"].(*schema.Set); ok && v.Len() > 0 {
+		statement.Conditions = make(scpConditionSet)
+
+		for _, tfMapRaw := range v.List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+			test, variable := tfMap["test"].(string), tfMap["variable"].(string)
+
+			if statement.Conditions[test] == nil {
+				statement.Conditions[test] = make(map[string]scpStringSet)
+			}
+
+			values := append([]string(statement.Conditions[test][variable]), flex.ExpandStringValueList(tfMap["values"].([]interface{}))...)
+			statement.Conditions[test][variable] = newSCPStringSet(values)
+		}
+	}
+
+	statement.Actions = removeRedundantSCPActions(statement.Actions)
+
+	return statement, nil
+}
+
+// mergeSCPStatements reduces the size of a policy by combining the actions of statements without a Sid
+// that have the same effect, resources and conditions.
+// Statements using NotAction aren't combined as that would change their meaning.
+func mergeSCPStatements(statements []*scpStatement) []*scpStatement {
+	var merged []*scpStatement
+	byKey := make(map[string]*scpStatement)
+
+	for _, statement := range statements {
+		if statement.Sid != "" || len(statement.Actions) == 0 {
+			merged = append(merged, statement)
+			continue
+		}
+
+		key := scpStatementMergeKey(statement)
+
+		if v, ok := byKey[key]; ok {
+			v.Actions = removeRedundantSCPActions(newSCPStringSet(append([]string(v.Actions), statement.Actions...)))
+			continue
+		}
+
+		byKey[key] = statement
+		merged = append(merged, statement)
+	}
+
+	return merged
+}
+
+func scpStatementMergeKey(statement *scpStatement) string {
+	// Maps are marshaled with sorted keys.
+	v, _ := json.Marshal([]any{statement.Effect, statement.Resources, statement.NotResources, statement.Conditions})
+
+	return string(v)
+}
+
+// removeRedundantSCPActions removes actions that are matched by a wildcard action in the same set,
+// e.g. "s3:GetObject" is removed if "s3:*" is present.
+func removeRedundantSCPActions(actions scpStringSet) scpStringSet {
+	wildcards := make(map[string]*regexp.Regexp)
+
+	for _, action := range actions {
+		if strings.ContainsAny(action, "*?") {
+			wildcards[action] = scpActionPattern(action)
+		}
+	}
+
+	if len(wildcards) == 0 {
+		return actions
+	}
+
+	var v scpStringSet
+
+	for _, action := range actions {
+		redundant := false
+
+		for wildcard, pattern := range wildcards {
+			if !strings.EqualFold(wildcard, action) && pattern.MatchString(action) {
+				redundant = true
+				break
+			}
+		}
+
+		if !redundant {
+			v = append(v, action)
+		}
+	}
+
+	return v
+}
+
+// scpActionPattern returns a case-insensitive regular expression that matches the actions matched by a wildcard action.
+func scpActionPattern(action string) *regexp.Regexp {
+	pattern := regexp.QuoteMeta(action)
+	pattern = strings.ReplaceAll(pattern, `\*`, `.*`)
+	pattern = strings.ReplaceAll(pattern, `\?`, `.`)
+
+	return regexache.MustCompile(`(?i)^` + pattern + `$`)
+}
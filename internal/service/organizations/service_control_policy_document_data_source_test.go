@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccOrganizationsServiceControlPolicyDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_organizations_service_control_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, organizations.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceControlPolicyDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", `{"Version":"2012-10-17","Statement":[{"Sid":"DenyLeaveOrganization","Effect":"Deny","Action":"organizations:LeaveOrganization","Resource":"*"},{"Effect":"Deny","Action":["ec2:*","rds:DeleteDBInstance"],"Resource":"*","Condition":{"StringNotEquals":{"aws:RequestedRegion":["eu-west-1","us-east-1"]}}},{"Effect":"Deny","NotAction":"iam:*","Resource":"*","Condition":{"Bool":{"aws:MultiFactorAuthPresent":"false"}}}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsServiceControlPolicyDocumentDataSource_tooLarge(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, organizations.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccServiceControlPolicyDocumentDataSourceConfig_tooLarge,
+				ExpectError: regexache.MustCompile(`exceeds the maximum size of 5120 characters`),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsServiceControlPolicyDocumentDataSource_duplicateSid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, organizations.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccServiceControlPolicyDocumentDataSourceConfig_duplicateSid,
+				ExpectError: regexache.MustCompile(`duplicate Sid \(Deny\)`),
+			},
+		},
+	})
+}
+
+const testAccServiceControlPolicyDocumentDataSourceConfig_basic = `
+data "aws_organizations_service_control_policy_document" "test" {
+  statement {
+    sid     = "DenyLeaveOrganization"
+    effect  = "Deny"
+    actions = ["organizations:LeaveOrganization"]
+  }
+
+  statement {
+    effect  = "Deny"
+    actions = ["ec2:RunInstances", "ec2:*"]
+
+    condition {
+      test     = "StringNotEquals"
+      variable = "aws:RequestedRegion"
+      values   = ["us-east-1", "eu-west-1"]
+    }
+  }
+
+  statement {
+    effect      = "Deny"
+    not_actions = ["iam:*"]
+
+    condition {
+      test     = "Bool"
+      variable = "aws:MultiFactorAuthPresent"
+      values   = ["false"]
+    }
+  }
+
+  statement {
+    effect  = "Deny"
+    actions = ["rds:DeleteDBInstance"]
+
+    condition {
+      test     = "StringNotEquals"
+      variable = "aws:RequestedRegion"
+      values   = ["eu-west-1", "us-east-1"]
+    }
+  }
+}
+`
+
+const testAccServiceControlPolicyDocumentDataSourceConfig_tooLarge = `
+data "aws_organizations_service_control_policy_document" "test" {
+  statement {
+    effect    = "Deny"
+    actions   = ["s3:*"]
+    resources = [for i in range(200) : "arn:aws:s3:::example-bucket-with-a-long-name-${i}/*"]
+  }
+}
+`
+
+const testAccServiceControlPolicyDocumentDataSourceConfig_duplicateSid = `
+data "aws_organizations_service_control_policy_document" "test" {
+  statement {
+    sid     = "Deny"
+    effect  = "Deny"
+    actions = ["s3:*"]
+  }
+
+  statement {
+    sid     = "Deny"
+    effect  = "Deny"
+    actions = ["ec2:*"]
+  }
+}
+`
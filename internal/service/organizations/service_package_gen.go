@@ -25,6 +25,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceAIServicesOptOutPolicyDocument,
+			TypeName: "aws_organizations_ai_services_opt_out_policy_document",
+		},
+		{
+			Factory:  DataSourceBackupPolicyDocument,
+			TypeName: "aws_organizations_backup_policy_document",
+		},
 		{
 			Factory:  DataSourceDelegatedAdministrators,
 			TypeName: "aws_organizations_delegated_administrators",
@@ -65,6 +73,14 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceResourceTags,
 			TypeName: "aws_organizations_resource_tags",
 		},
+		{
+			Factory:  DataSourceServiceControlPolicyDocument,
+			TypeName: "aws_organizations_service_control_policy_document",
+		},
+		{
+			Factory:  DataSourceTagPolicyDocument,
+			TypeName: "aws_organizations_tag_policy_document",
+		},
 	}
 }
 
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_organizations_tag_policy_document")
+func DataSourceTagPolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTagPolicyDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tag": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enforced_for": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTagPolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	doc := &tagPolicyDocument{
+		Tags: make(map[string]*tagPolicyTag),
+	}
+
+	for _, tfMapRaw := range d.Get("tag").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		key := tfMap["key"].(string)
+		// Tag policy keys are case-insensitive. tag_key specifies the compliant capitalization.
+		policyKey := strings.ToLower(key)
+
+		if _, ok := doc.Tags[policyKey]; ok {
+			return sdkdiag.AppendErrorf(diags, "writing Organizations Tag Policy Document: duplicate tag key (%s)", key)
+		}
+
+		tag := &tagPolicyTag{
+			TagKey: newPolicyAssign(key),
+		}
+
+		if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+			values := flex.ExpandStringValueSet(v)
+			sort.Strings(values)
+			tag.TagValue = newPolicyAssign(values)
+		}
+
+		if v, ok := tfMap["enforced_for"].(*schema.Set); ok && v.Len() > 0 {
+			enforcedFor := flex.ExpandStringValueSet(v)
+			sort.Strings(enforcedFor)
+			tag.EnforcedFor = newPolicyAssign(enforcedFor)
+		}
+
+		doc.Tags[policyKey] = tag
+	}
+
+	v, err := marshalPolicyDocument(doc, organizations.PolicyTypeTagPolicy)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing Organizations Tag Policy Document: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(v)))
+	d.Set("json", v)
+
+	return diags
+}
+
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_example-tag-policies.html.
+type tagPolicyDocument struct {
+	Tags map[string]*tagPolicyTag `json:"tags"`
+}
+
+type tagPolicyTag struct {
+	TagKey      *policyAssign[string]   `json:"tag_key"`
+	TagValue    *policyAssign[[]string] `json:"tag_value,omitempty"`
+	EnforcedFor *policyAssign[[]string] `json:"enforced_for,omitempty"`
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccOrganizationsTagPolicyDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_organizations_tag_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, organizations.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagPolicyDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", `{"tags":{"costcenter":{"tag_key":{"@@assign":"CostCenter"},"tag_value":{"@@assign":["100","200"]},"enforced_for":{"@@assign":["ec2:instance","secretsmanager:*"]}},"project":{"tag_key":{"@@assign":"Project"}}}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsTagPolicyDocumentDataSource_duplicateKey(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, organizations.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTagPolicyDocumentDataSourceConfig_duplicateKey,
+				ExpectError: regexache.MustCompile(`duplicate tag key \(costcenter\)`),
+			},
+		},
+	})
+}
+
+const testAccTagPolicyDocumentDataSourceConfig_basic = `
+data "aws_organizations_tag_policy_document" "test" {
+  tag {
+    key          = "CostCenter"
+    values       = ["200", "100"]
+    enforced_for = ["secretsmanager:*", "ec2:instance"]
+  }
+
+  tag {
+    key = "Project"
+  }
+}
+`
+
+const testAccTagPolicyDocumentDataSourceConfig_duplicateKey = `
+data "aws_organizations_tag_policy_document" "test" {
+  tag {
+    key = "CostCenter"
+  }
+
+  tag {
+    key = "costcenter"
+  }
+}
+`
@@ -7,14 +7,17 @@
 package pipes
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/pipes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -22,6 +25,7 @@ func init() {
 		Name: "aws_pipes_pipe",
 		F:    sweepPipes,
 	})
+	discovery.Register("aws_pipes_pipe", listPipes)
 }
 
 func sweepPipes(region string) error {
@@ -30,6 +34,26 @@ func sweepPipes(region string) error {
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
+
+	sweepResources, err := listPipes(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Pipe sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Pipes (%s): %w", region, err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping Pipes (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listPipes(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.PipesClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
@@ -37,13 +61,8 @@ func sweepPipes(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Pipe sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error listing Pipes (%s): %w", region, err)
+			return nil, err
 		}
 
 		for _, v := range page.Pipes {
@@ -55,9 +74,5 @@ func sweepPipes(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("error sweeping Pipes (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
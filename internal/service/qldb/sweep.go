@@ -7,6 +7,7 @@
 package qldb
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,8 +15,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/qldb"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -26,6 +29,7 @@ func init() {
 			"aws_qldb_stream",
 		},
 	})
+	discovery.Register("aws_qldb_ledger", listLedgers)
 
 	resource.AddTestSweepers("aws_qldb_stream", &resource.Sweeper{
 		Name: "aws_qldb_stream",
@@ -40,6 +44,28 @@ func sweepLedgers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
+
+	sweepResources, err := listLedgers(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping QLDB Ledger sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing QLDB Ledgers (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping QLDB Ledgers (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listLedgers(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.QLDBClient(ctx)
 	input := &qldb.ListLedgersInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
@@ -48,13 +74,8 @@ func sweepLedgers(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping QLDB Ledger sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error listing QLDB Ledgers (%s): %w", region, err)
+			return nil, err
 		}
 
 		for _, v := range page.Ledgers {
@@ -66,13 +87,7 @@ func sweepLedgers(region string) error {
 		}
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping QLDB Ledgers (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepStreams(region string) error {
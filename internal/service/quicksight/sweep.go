@@ -7,6 +7,7 @@
 package quicksight
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -15,7 +16,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -23,14 +26,17 @@ func init() {
 		Name: "aws_quicksight_dashboard",
 		F:    sweepDashboards,
 	})
+	discovery.Register("aws_quicksight_dashboard", listDashboards)
 	resource.AddTestSweepers("aws_quicksight_data_set", &resource.Sweeper{
 		Name: "aws_quicksight_data_set",
 		F:    sweepDataSets,
 	})
+	discovery.Register("aws_quicksight_data_set", listDataSets)
 	resource.AddTestSweepers("aws_quicksight_data_source", &resource.Sweeper{
 		Name: "aws_quicksight_data_source",
 		F:    sweepDataSources,
 	})
+	discovery.Register("aws_quicksight_data_source", listDataSources)
 	resource.AddTestSweepers("aws_quicksight_folder", &resource.Sweeper{
 		Name: "aws_quicksight_folder",
 		F:    sweepFolders,
@@ -39,6 +45,7 @@ func init() {
 		Name: "aws_quicksight_template",
 		F:    sweepTemplates,
 	})
+	discovery.Register("aws_quicksight_template", listTemplates)
 	resource.AddTestSweepers("aws_quicksight_user", &resource.Sweeper{
 		Name: "aws_quicksight_user",
 		F:    sweepUsers,
@@ -58,6 +65,24 @@ func sweepDashboards(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
+	sweepResources, err := listDashboards(ctx, client)
+
+	if skipSweepError(err) {
+		log.Printf("[WARN] Skipping QuickSight Dashboard sweep for %s: %s", region, err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("listing QuickSight Dashboards: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("sweeping QuickSight Dashboards for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listDashboards(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.QuickSightConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
@@ -67,7 +92,7 @@ func sweepDashboards(region string) error {
 		AwsAccountId: aws.String(awsAccountId),
 	}
 
-	err = conn.ListDashboardsPagesWithContext(ctx, input, func(page *quicksight.ListDashboardsOutput, lastPage bool) bool {
+	err := conn.ListDashboardsPagesWithContext(ctx, input, func(page *quicksight.ListDashboardsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -87,19 +112,11 @@ func sweepDashboards(region string) error {
 		return !lastPage
 	})
 
-	if skipSweepError(err) {
-		log.Printf("[WARN] Skipping QuickSight Dashboard sweep for %s: %s", region, err)
-		return nil
-	}
 	if err != nil {
-		return fmt.Errorf("listing QuickSight Dashboards: %w", err)
-	}
-
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("sweeping QuickSight Dashboards for %s: %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
 
 func sweepDataSets(region string) error {
@@ -110,6 +127,24 @@ func sweepDataSets(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
+	sweepResources, err := listDataSets(ctx, client)
+
+	if skipSweepError(err) {
+		log.Printf("[WARN] Skipping QuickSight Data Set sweep for %s: %s", region, err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("listing QuickSight Data Sets: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("sweeping QuickSight Data Sets for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listDataSets(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.QuickSightConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
@@ -119,7 +154,7 @@ func sweepDataSets(region string) error {
 		AwsAccountId: aws.String(awsAccountId),
 	}
 
-	err = conn.ListDataSetsPagesWithContext(ctx, input, func(page *quicksight.ListDataSetsOutput, lastPage bool) bool {
+	err := conn.ListDataSetsPagesWithContext(ctx, input, func(page *quicksight.ListDataSetsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -139,19 +174,11 @@ func sweepDataSets(region string) error {
 		return !lastPage
 	})
 
-	if skipSweepError(err) {
-		log.Printf("[WARN] Skipping QuickSight Data Set sweep for %s: %s", region, err)
-		return nil
-	}
 	if err != nil {
-		return fmt.Errorf("listing QuickSight Data Sets: %w", err)
-	}
-
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("sweeping QuickSight Data Sets for %s: %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
 
 func sweepDataSources(region string) error {
@@ -162,6 +189,24 @@ func sweepDataSources(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
+	sweepResources, err := listDataSources(ctx, client)
+
+	if skipSweepError(err) {
+		log.Printf("[WARN] Skipping QuickSight Data Source sweep for %s: %s", region, err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("listing QuickSight Data Sources: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("sweeping QuickSight Data Sources for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listDataSources(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.QuickSightConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
@@ -171,7 +216,7 @@ func sweepDataSources(region string) error {
 		AwsAccountId: aws.String(awsAccountId),
 	}
 
-	err = conn.ListDataSourcesPagesWithContext(ctx, input, func(page *quicksight.ListDataSourcesOutput, lastPage bool) bool {
+	err := conn.ListDataSourcesPagesWithContext(ctx, input, func(page *quicksight.ListDataSourcesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -191,19 +236,11 @@ func sweepDataSources(region string) error {
 		return !lastPage
 	})
 
-	if skipSweepError(err) {
-		log.Printf("[WARN] Skipping QuickSight Data Source sweep for %s: %s", region, err)
-		return nil
-	}
 	if err != nil {
-		return fmt.Errorf("listing QuickSight Data Sources: %w", err)
-	}
-
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("sweeping QuickSight Data Sources for %s: %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
 
 func sweepFolders(region string) error {
@@ -259,6 +296,24 @@ func sweepTemplates(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
+	sweepResources, err := listTemplates(ctx, client)
+
+	if skipSweepError(err) {
+		log.Printf("[WARN] Skipping QuickSight Template sweep for %s: %s", region, err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("listing QuickSight Templates: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("sweeping QuickSight Templates for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listTemplates(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.QuickSightConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
@@ -268,7 +323,7 @@ func sweepTemplates(region string) error {
 		AwsAccountId: aws.String(awsAccountId),
 	}
 
-	err = conn.ListTemplatesPagesWithContext(ctx, input, func(page *quicksight.ListTemplatesOutput, lastPage bool) bool {
+	err := conn.ListTemplatesPagesWithContext(ctx, input, func(page *quicksight.ListTemplatesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -288,19 +343,11 @@ func sweepTemplates(region string) error {
 		return !lastPage
 	})
 
-	if skipSweepError(err) {
-		log.Printf("[WARN] Skipping QuickSight Template sweep for %s: %s", region, err)
-		return nil
-	}
 	if err != nil {
-		return fmt.Errorf("listing QuickSight Templates: %w", err)
-	}
-
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("sweeping QuickSight Templates for %s: %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
 
 func sweepUsers(region string) error {
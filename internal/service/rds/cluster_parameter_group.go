@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -99,7 +100,10 @@ func ResourceClusterParameterGroup() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffValidateParameters(findEngineDefaultClusterParameters, true),
+		),
 	}
 }
 
@@ -107,7 +111,7 @@ func resourceClusterParameterGroupCreate(ctx context.Context, d *schema.Resource
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSConn(ctx)
 
-	groupName := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	groupName := create.Name(ctx, d.Get("name").(string), d.Get("name_prefix").(string))
 	input := &rds.CreateDBClusterParameterGroupInput{
 		DBClusterParameterGroupName: aws.String(groupName),
 		DBParameterGroupFamily:      aws.String(d.Get("family").(string)),
@@ -209,6 +213,18 @@ func resourceClusterParameterGroupRead(ctx context.Context, d *schema.ResourceDa
 		return sdkdiag.AppendErrorf(diags, "reading RDS Cluster Parameter Group (%s) parameters: %s", d.Id(), err)
 	}
 
+	// add config parameters that AWS doesn't report as modified because they have the engine default value
+	if len(configParameters) > 0 {
+		family := aws.StringValue(dbClusterParameterGroup.DBParameterGroupFamily)
+		defaults, err := engineDefaultParameters(ctx, meta.(*conns.AWSClient), family, findEngineDefaultClusterParameters, true)
+
+		if err != nil {
+			diags = sdkdiag.AppendWarningf(diags, "unable to read RDS parameter group family (%s) engine defaults, parameters set to their default value may show differences: %s", family, err)
+		} else {
+			parameters = append(parameters, parametersMatchingEngineDefaults(configParameters, parameters, defaults)...)
+		}
+	}
+
 	if err := d.Set("parameter", flattenParameters(parameters)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting parameter: %s", err)
 	}
@@ -235,6 +251,10 @@ func resourceClusterParameterGroupUpdate(ctx context.Context, d *schema.Resource
 		os := o.(*schema.Set)
 		ns := n.(*schema.Set)
 
+		if ns.Difference(os).Len() > 0 {
+			diags = appendEngineDefaultParametersWarning(ctx, diags, meta.(*conns.AWSClient), d.Get("family").(string), findEngineDefaultClusterParameters, true)
+		}
+
 		// Expand the "parameter" set to aws-sdk-go compat []rds.Parameter.
 		for _, chunk := range slices.Chunks(expandParameters(ns.Difference(os).List()), maxParamModifyChunk) {
 			input := &rds.ModifyDBClusterParameterGroupInput{
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -512,6 +513,32 @@ func TestAccRDSClusterParameterGroup_dynamicDiffs(t *testing.T) {
 	})
 }
 
+func TestAccRDSClusterParameterGroup_invalidParameters(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterParameterGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterParameterGroupConfig_parameter(rName, "character_set_servers", "utf8", "immediate"),
+				ExpectError: regexache.MustCompile(`parameter \(character_set_servers\): not a valid parameter name`),
+			},
+			{
+				Config:      testAccClusterParameterGroupConfig_parameter(rName, "binlog_format", "SOMETIMES", "pending-reboot"),
+				ExpectError: regexache.MustCompile(`parameter \(binlog_format\): value \(SOMETIMES\) is not one of the allowed values`),
+			},
+			{
+				Config:      testAccClusterParameterGroupConfig_parameter(rName, "binlog_format", "ROW", "immediate"),
+				ExpectError: regexache.MustCompile(`parameter \(binlog_format\): static parameter requires apply_method = "pending-reboot"`),
+			},
+		},
+	})
+}
+
 func testAccCheckClusterParameterGroupDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn(ctx)
@@ -879,3 +906,18 @@ resource "aws_rds_cluster_parameter_group" "test" {
 }
 `, rName)
 }
+
+func testAccClusterParameterGroupConfig_parameter(rName, name, value, applyMethod string) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster_parameter_group" "test" {
+  name   = %[1]q
+  family = "aurora-mysql8.0"
+
+  parameter {
+    name         = %[2]q
+    value        = %[3]q
+    apply_method = %[4]q
+  }
+}
+`, rName, name, value, applyMethod)
+}
@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// engineDefaultParametersCache caches engine default parameters, keyed by lower-case parameter name,
+// by Region, parameter group type and family. Engine defaults don't change for a family so they are
+// fetched at most once per provider process.
+// The cache lock is only held while looking up an entry. Concurrent lookups of the same entry wait on
+// the entry's lock so that only one of them calls the API; lookups of other entries aren't blocked.
+var engineDefaultParametersCache = struct {
+	sync.Mutex
+	m map[string]*engineDefaultParametersCacheEntry
+}{
+	m: make(map[string]*engineDefaultParametersCacheEntry),
+}
+
+type engineDefaultParametersCacheEntry struct {
+	sync.Mutex
+	parameters map[string]*rds.Parameter
+}
+
+type findEngineDefaultParametersFunc func(context.Context, *rds.RDS, string) ([]*rds.Parameter, error)
+
+func findEngineDefaultParameters(ctx context.Context, conn *rds.RDS, family string) ([]*rds.Parameter, error) {
+	input := &rds.DescribeEngineDefaultParametersInput{
+		DBParameterGroupFamily: aws.String(family),
+	}
+	var parameters []*rds.Parameter
+
+	err := conn.DescribeEngineDefaultParametersPagesWithContext(ctx, input, func(page *rds.DescribeEngineDefaultParametersOutput, lastPage bool) bool {
+		if page == nil || page.EngineDefaults == nil {
+			return !lastPage
+		}
+
+		parameters = append(parameters, page.EngineDefaults.Parameters...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(parameters) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return parameters, nil
+}
+
+func findEngineDefaultClusterParameters(ctx context.Context, conn *rds.RDS, family string) ([]*rds.Parameter, error) {
+	input := &rds.DescribeEngineDefaultClusterParametersInput{
+		DBParameterGroupFamily: aws.String(family),
+	}
+	var parameters []*rds.Parameter
+
+	// DescribeEngineDefaultClusterParameters has no paginator.
+	for {
+		output, err := conn.DescribeEngineDefaultClusterParametersWithContext(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || output.EngineDefaults == nil {
+			break
+		}
+
+		parameters = append(parameters, output.EngineDefaults.Parameters...)
+
+		if aws.StringValue(output.EngineDefaults.Marker) == "" {
+			break
+		}
+
+		input.Marker = output.EngineDefaults.Marker
+	}
+
+	if len(parameters) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return parameters, nil
+}
+
+// engineDefaultParameters returns the cached engine default parameters for a parameter group family.
+func engineDefaultParameters(ctx context.Context, meta *conns.AWSClient, family string, find findEngineDefaultParametersFunc, cluster bool) (map[string]*rds.Parameter, error) {
+	key := fmt.Sprintf("%s/%t/%s", meta.Region, cluster, family)
+
+	engineDefaultParametersCache.Lock()
+	entry, ok := engineDefaultParametersCache.m[key]
+	if !ok {
+		entry = &engineDefaultParametersCacheEntry{}
+		engineDefaultParametersCache.m[key] = entry
+	}
+	engineDefaultParametersCache.Unlock()
+
+	entry.Lock()
+	defer entry.Unlock()
+
+	// Failures aren't cached, the next lookup retries.
+	if entry.parameters != nil {
+		return entry.parameters, nil
+	}
+
+	parameters, err := find(ctx, meta.RDSConn(ctx), family)
+
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]*rds.Parameter, len(parameters))
+	for _, v := range parameters {
+		if v != nil && v.ParameterName != nil {
+			m[strings.ToLower(aws.StringValue(v.ParameterName))] = v
+		}
+	}
+
+	entry.parameters = m
+
+	return m, nil
+}
+
+// appendEngineDefaultParametersWarning appends a warning diagnostic if a parameter group family's engine defaults
+// can't be read and so parameters weren't validated when planning.
+func appendEngineDefaultParametersWarning(ctx context.Context, diags diag.Diagnostics, meta *conns.AWSClient, family string, find findEngineDefaultParametersFunc, cluster bool) diag.Diagnostics {
+	if family == "" {
+		return diags
+	}
+
+	if _, err := engineDefaultParameters(ctx, meta, family, find, cluster); err != nil {
+		return sdkdiag.AppendWarningf(diags, "parameters of RDS parameter group family (%s) were not validated, unable to read engine defaults: %s", family, err)
+	}
+
+	return diags
+}
+
+// customizeDiffValidateParameters validates added or changed parameters against the family's engine defaults.
+// Validation is skipped if the engine defaults can't be read, e.g. due to missing IAM permissions.
+// CustomizeDiff can't return warnings so this is logged here and reported as a warning diagnostic on apply.
+func customizeDiffValidateParameters(find findEngineDefaultParametersFunc, cluster bool) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.HasChange("parameter") || !diff.NewValueKnown("parameter") || !diff.NewValueKnown("family") {
+			return nil
+		}
+
+		family := diff.Get("family").(string)
+		o, n := diff.GetChange("parameter")
+		parameters := expandParameters(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+
+		if family == "" || len(parameters) == 0 {
+			return nil
+		}
+
+		defaults, err := engineDefaultParameters(ctx, meta.(*conns.AWSClient), family, find, cluster)
+
+		if err != nil {
+			log.Printf("[WARN] Skipping validation of RDS parameter group family (%s) parameters, unable to read engine defaults: %s", family, err)
+			return nil
+		}
+
+		var errs []error
+
+		for _, v := range parameters {
+			if err := validateParameter(v, defaults[aws.StringValue(v.ParameterName)]); err != nil {
+				errs = append(errs, fmt.Errorf("family (%s) parameter (%s): %w", family, aws.StringValue(v.ParameterName), err))
+			}
+		}
+
+		return errors.Join(errs...)
+	}
+}
+
+// validateParameter validates a configured parameter against its engine default.
+func validateParameter(parameter, engineDefault *rds.Parameter) error {
+	if engineDefault == nil {
+		return errors.New("not a valid parameter name")
+	}
+
+	if !aws.BoolValue(engineDefault.IsModifiable) {
+		return errors.New("parameter is not modifiable")
+	}
+
+	if strings.EqualFold(aws.StringValue(engineDefault.ApplyType), "static") && aws.StringValue(parameter.ApplyMethod) == rds.ApplyMethodImmediate {
+		return fmt.Errorf("static parameter requires apply_method = %q", rds.ApplyMethodPendingReboot)
+	}
+
+	if !parameterValueAllowed(aws.StringValue(parameter.ParameterValue), aws.StringValue(engineDefault.DataType), aws.StringValue(engineDefault.AllowedValues)) {
+		return fmt.Errorf("value (%s) is not one of the allowed values (%s)", aws.StringValue(parameter.ParameterValue), aws.StringValue(engineDefault.AllowedValues))
+	}
+
+	return nil
+}
+
+var (
+	// Allowed values containing anything other than these characters are treated as patterns and not validated.
+	parameterAllowedValuesRegexp = regexache.MustCompile(`^[0-9A-Za-z_.,:/+ -]+$`)
+	parameterAllowedRangeRegexp  = regexache.MustCompile(`^(-?[0-9.]+)-(-?[0-9.]+)$`)
+)
+
+// parameterValueAllowed returns whether a parameter value is allowed.
+// Allowed values are a comma-separated list of literals and numeric ranges, e.g. "0,1", "ON,OFF" or "1-65535".
+// Values that use formulas, e.g. "{DBInstanceClassMemory/12582880}", and non-numeric values of
+// parameters with numeric ranges aren't validated.
+func parameterValueAllowed(value, dataType, allowedValues string) bool {
+	if allowedValues == "" || !parameterAllowedValuesRegexp.MatchString(allowedValues) || strings.ContainsAny(value, "{}") {
+		return true
+	}
+
+	values := []string{value}
+	if dataType == "list" {
+		values = strings.Split(value, ",")
+	}
+
+	allowed := strings.Split(allowedValues, ",")
+
+	for _, value := range values {
+		if !parameterValueInAllowedValues(strings.TrimSpace(value), allowed) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func parameterValueInAllowedValues(value string, allowedValues []string) bool {
+	number, err := strconv.ParseFloat(value, 64)
+	numeric := err == nil
+
+	for _, allowed := range allowedValues {
+		allowed = strings.TrimSpace(allowed)
+
+		if strings.EqualFold(value, allowed) {
+			return true
+		}
+
+		if match := parameterAllowedRangeRegexp.FindStringSubmatch(allowed); match != nil {
+			min, errMin := strconv.ParseFloat(match[1], 64)
+			max, errMax := strconv.ParseFloat(match[2], 64)
+
+			if errMin != nil || errMax != nil {
+				continue
+			}
+
+			// Let AWS validate values with units, e.g. "64MB".
+			if !numeric {
+				return true
+			}
+
+			if number >= min && number <= max {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// parametersMatchingEngineDefaults returns the configured parameters that aren't in the specified parameters
+// but whose values equal the engine default. AWS may not report parameters explicitly set to their engine default
+// as user-modified, so these are persisted to avoid perpetual differences.
+func parametersMatchingEngineDefaults(configured, parameters []*rds.Parameter, defaults map[string]*rds.Parameter) []*rds.Parameter {
+	found := make(map[string]bool, len(parameters))
+	for _, v := range parameters {
+		found[strings.ToLower(aws.StringValue(v.ParameterName))] = true
+	}
+
+	var matching []*rds.Parameter
+
+	for _, v := range configured {
+		name := aws.StringValue(v.ParameterName)
+
+		if found[name] {
+			continue
+		}
+
+		if engineDefault, ok := defaults[name]; ok && engineDefault.ParameterValue != nil && aws.StringValue(engineDefault.ParameterValue) == aws.StringValue(v.ParameterValue) {
+			matching = append(matching, v)
+		}
+	}
+
+	return matching
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+func TestValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	dynamic := &rds.Parameter{
+		AllowedValues: aws.String("0-1"),
+		ApplyType:     aws.String("dynamic"),
+		DataType:      aws.String("boolean"),
+		IsModifiable:  aws.Bool(true),
+		ParameterName: aws.String("slow_query_log"),
+	}
+	static := &rds.Parameter{
+		AllowedValues: aws.String("0,1"),
+		ApplyType:     aws.String("static"),
+		DataType:      aws.String("boolean"),
+		IsModifiable:  aws.Bool(true),
+		ParameterName: aws.String("performance_schema"),
+	}
+	notModifiable := &rds.Parameter{
+		ApplyType:     aws.String("static"),
+		DataType:      aws.String("string"),
+		IsModifiable:  aws.Bool(false),
+		ParameterName: aws.String("basedir"),
+	}
+
+	testCases := map[string]struct {
+		parameter     *rds.Parameter
+		engineDefault *rds.Parameter
+		expectedError bool
+	}{
+		"valid": {
+			parameter:     &rds.Parameter{ParameterName: aws.String("slow_query_log"), ParameterValue: aws.String("1"), ApplyMethod: aws.String("immediate")},
+			engineDefault: dynamic,
+		},
+		"unknown name": {
+			parameter:     &rds.Parameter{ParameterName: aws.String("slow_query_logs"), ParameterValue: aws.String("1"), ApplyMethod: aws.String("immediate")},
+			expectedError: true,
+		},
+		"not modifiable": {
+			parameter:     &rds.Parameter{ParameterName: aws.String("basedir"), ParameterValue: aws.String("/tmp"), ApplyMethod: aws.String("pending-reboot")},
+			engineDefault: notModifiable,
+			expectedError: true,
+		},
+		"value out of range": {
+			parameter:     &rds.Parameter{ParameterName: aws.String("slow_query_log"), ParameterValue: aws.String("2"), ApplyMethod: aws.String("immediate")},
+			engineDefault: dynamic,
+			expectedError: true,
+		},
+		"static immediate": {
+			parameter:     &rds.Parameter{ParameterName: aws.String("performance_schema"), ParameterValue: aws.String("1"), ApplyMethod: aws.String("immediate")},
+			engineDefault: static,
+			expectedError: true,
+		},
+		"static pending-reboot": {
+			parameter:     &rds.Parameter{ParameterName: aws.String("performance_schema"), ParameterValue: aws.String("1"), ApplyMethod: aws.String("pending-reboot")},
+			engineDefault: static,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateParameter(testCase.parameter, testCase.engineDefault)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Errorf("got error %v, expected error: %t", err, want)
+			}
+		})
+	}
+}
+
+func TestParameterValueAllowed(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		value         string
+		dataType      string
+		allowedValues string
+		expected      bool
+	}{
+		{value: "anything", dataType: "string", allowedValues: "", expected: true},
+		{value: "1", dataType: "boolean", allowedValues: "0,1", expected: true},
+		{value: "2", dataType: "boolean", allowedValues: "0,1", expected: false},
+		{value: "off", dataType: "string", allowedValues: "ON,OFF", expected: true},
+		{value: "MAYBE", dataType: "string", allowedValues: "ON,OFF", expected: false},
+		{value: "5432", dataType: "integer", allowedValues: "1-65535", expected: true},
+		{value: "0", dataType: "integer", allowedValues: "1-65535", expected: false},
+		{value: "-1", dataType: "integer", allowedValues: "-1-2147483647", expected: true},
+		{value: "-2", dataType: "integer", allowedValues: "-1-2147483647", expected: false},
+		{value: "0.5", dataType: "float", allowedValues: "0-1", expected: true},
+		{value: "18446744073709551615", dataType: "integer", allowedValues: "0-18446744073709551615", expected: true},
+		{value: "5", dataType: "integer", allowedValues: "0-1,5", expected: true},
+		{value: "64MB", dataType: "integer", allowedValues: "64-2147483647", expected: true},
+		{value: "{DBInstanceClassMemory*3/4}", dataType: "integer", allowedValues: "1-9223372036854775807", expected: true},
+		{value: "utf8,latin1", dataType: "list", allowedValues: "utf8,latin1,ascii", expected: true},
+		{value: "utf8,ebcdic", dataType: "list", allowedValues: "utf8,latin1,ascii", expected: false},
+		{value: "anything", dataType: "string", allowedValues: "[a-z]+", expected: true},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.value+"/"+testCase.allowedValues, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := parameterValueAllowed(testCase.value, testCase.dataType, testCase.allowedValues), testCase.expected; got != want {
+				t.Errorf("got %t, expected %t", got, want)
+			}
+		})
+	}
+}
+
+func TestParametersMatchingEngineDefaults(t *testing.T) {
+	t.Parallel()
+
+	defaults := map[string]*rds.Parameter{
+		"character_set_server": {ParameterName: aws.String("character_set_server"), ParameterValue: aws.String("latin1")},
+		"max_connections":      {ParameterName: aws.String("max_connections"), ParameterValue: aws.String("{DBInstanceClassMemory/12582880}")},
+		"sync_binlog":          {ParameterName: aws.String("sync_binlog")},
+	}
+	configured := []*rds.Parameter{
+		{ParameterName: aws.String("character_set_server"), ParameterValue: aws.String("latin1"), ApplyMethod: aws.String("immediate")},
+		{ParameterName: aws.String("character_set_client"), ParameterValue: aws.String("utf8"), ApplyMethod: aws.String("immediate")},
+		{ParameterName: aws.String("max_connections"), ParameterValue: aws.String("100"), ApplyMethod: aws.String("immediate")},
+		{ParameterName: aws.String("sync_binlog"), ParameterValue: aws.String(""), ApplyMethod: aws.String("immediate")},
+		{ParameterName: aws.String("tx_isolation"), ParameterValue: aws.String("READ-COMMITTED"), ApplyMethod: aws.String("immediate")},
+	}
+	parameters := []*rds.Parameter{
+		{ParameterName: aws.String("TX_ISOLATION"), ParameterValue: aws.String("READ-COMMITTED")},
+	}
+
+	got := parametersMatchingEngineDefaults(configured, parameters, defaults)
+	expected := []*rds.Parameter{configured[0]}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
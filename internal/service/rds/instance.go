@@ -659,7 +659,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 	var requiresRebootDbInstance bool
 
 	// See discussion of IDs at the top of file - this is NOT d.Id()
-	identifier := create.Name(d.Get("identifier").(string), d.Get("identifier_prefix").(string))
+	identifier := create.Name(ctx, d.Get("identifier").(string), d.Get("identifier_prefix").(string))
 
 	var resourceID string // will be assigned depending on how it is created
 
@@ -17,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -100,7 +101,10 @@ func ResourceParameterGroup() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffValidateParameters(findEngineDefaultParameters, false),
+		),
 	}
 }
 
@@ -108,7 +112,7 @@ func resourceParameterGroupCreate(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSConn(ctx)
 
-	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	name := create.Name(ctx, d.Get("name").(string), d.Get("name_prefix").(string))
 	input := &rds.CreateDBParameterGroupInput{
 		DBParameterGroupFamily: aws.String(d.Get("family").(string)),
 		DBParameterGroupName:   aws.String(name),
@@ -245,6 +249,10 @@ func resourceParameterGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 		os := o.(*schema.Set)
 		ns := n.(*schema.Set)
 
+		if ns.Difference(os).Len() > 0 {
+			diags = appendEngineDefaultParametersWarning(ctx, diags, meta.(*conns.AWSClient), d.Get("family").(string), findEngineDefaultParameters, false)
+		}
+
 		// Expand the "parameter" set to aws-sdk-go compat []rds.Parameter
 		parameters := expandParameters(ns.Difference(os).List())
 
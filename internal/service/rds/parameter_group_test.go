@@ -715,6 +715,36 @@ func TestAccRDSParameterGroup_matchDefault(t *testing.T) {
 	})
 }
 
+func TestAccRDSParameterGroup_invalidParameters(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccParameterGroupConfig_parameter(rName, "character_set_servers", "utf8", "immediate"),
+				ExpectError: regexache.MustCompile(`parameter \(character_set_servers\): not a valid parameter name`),
+			},
+			{
+				Config:      testAccParameterGroupConfig_parameter(rName, "sync_binlog", "-1", "immediate"),
+				ExpectError: regexache.MustCompile(`parameter \(sync_binlog\): value \(-1\) is not one of the allowed values`),
+			},
+			{
+				Config:      testAccParameterGroupConfig_parameter(rName, "performance_schema", "1", "immediate"),
+				ExpectError: regexache.MustCompile(`parameter \(performance_schema\): static parameter requires apply_method = "pending-reboot"`),
+			},
+			{
+				Config:      testAccParameterGroupConfig_parameter(rName, "basedir", "/tmp", "pending-reboot"),
+				ExpectError: regexache.MustCompile(`parameter \(basedir\): parameter is not modifiable`),
+			},
+		},
+	})
+}
+
 func TestAccRDSParameterGroup_updateParameters(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v rds.DBParameterGroup
@@ -1877,3 +1907,18 @@ resource "aws_db_parameter_group" "test" {
   }
 }
 `
+
+func testAccParameterGroupConfig_parameter(rName, name, value, applyMethod string) string {
+	return fmt.Sprintf(`
+resource "aws_db_parameter_group" "test" {
+  name   = %[1]q
+  family = "mysql8.0"
+
+  parameter {
+    name         = %[2]q
+    value        = %[3]q
+    apply_method = %[4]q
+  }
+}
+`, rName, name, value, applyMethod)
+}
@@ -7,6 +7,7 @@
 package redshift
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -15,7 +16,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -26,21 +29,25 @@ func init() {
 			"aws_redshift_cluster",
 		},
 	})
+	discovery.Register("aws_redshift_cluster_snapshot", listClusterSnapshots)
 
 	resource.AddTestSweepers("aws_redshift_cluster", &resource.Sweeper{
 		Name: "aws_redshift_cluster",
 		F:    sweepClusters,
 	})
+	discovery.Register("aws_redshift_cluster", listClusters)
 
 	resource.AddTestSweepers("aws_redshift_hsm_client_certificate", &resource.Sweeper{
 		Name: "aws_redshift_hsm_client_certificate",
 		F:    sweepHSMClientCertificates,
 	})
+	discovery.Register("aws_redshift_hsm_client_certificate", listHSMClientCertificates)
 
 	resource.AddTestSweepers("aws_redshift_hsm_configuration", &resource.Sweeper{
 		Name: "aws_redshift_hsm_configuration",
 		F:    sweepHSMConfigurations,
 	})
+	discovery.Register("aws_redshift_hsm_configuration", listHSMConfigurations)
 
 	resource.AddTestSweepers("aws_redshift_authentication_profile", &resource.Sweeper{
 		Name: "aws_redshift_authentication_profile",
@@ -51,16 +58,19 @@ func init() {
 		Name: "aws_redshift_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
+	discovery.Register("aws_redshift_event_subscription", listEventSubscriptions)
 
 	resource.AddTestSweepers("aws_redshift_scheduled_action", &resource.Sweeper{
 		Name: "aws_redshift_scheduled_action",
 		F:    sweepScheduledActions,
 	})
+	discovery.Register("aws_redshift_scheduled_action", listScheduledActions)
 
 	resource.AddTestSweepers("aws_redshift_snapshot_schedule", &resource.Sweeper{
 		Name: "aws_redshift_snapshot_schedule",
 		F:    sweepSnapshotSchedules,
 	})
+	discovery.Register("aws_redshift_snapshot_schedule", listSnapshotSchedules)
 
 	resource.AddTestSweepers("aws_redshift_subnet_group", &resource.Sweeper{
 		Name: "aws_redshift_subnet_group",
@@ -69,6 +79,7 @@ func init() {
 			"aws_redshift_cluster",
 		},
 	})
+	discovery.Register("aws_redshift_subnet_group", listSubnetGroups)
 }
 
 func sweepClusterSnapshots(region string) error {
@@ -77,11 +88,33 @@ func sweepClusterSnapshots(region string) error {
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
 	}
+
+	var errs *multierror.Error
+
+	sweepResources, err := listClusterSnapshots(ctx, client)
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("describing Redshift Snapshots: %w", err))
+		// in case work can be done, don't jump out yet
+	}
+
+	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping Redshift Snapshots for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Redshift Snapshots sweep for %s: %s", region, err)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func listClusterSnapshots(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.RedshiftConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
-	var errs *multierror.Error
 
-	err = conn.DescribeClusterSnapshotsPagesWithContext(ctx, &redshift.DescribeClusterSnapshotsInput{}, func(resp *redshift.DescribeClusterSnapshotsOutput, lastPage bool) bool {
+	err := conn.DescribeClusterSnapshotsPagesWithContext(ctx, &redshift.DescribeClusterSnapshotsInput{}, func(resp *redshift.DescribeClusterSnapshotsOutput, lastPage bool) bool {
 		if len(resp.Snapshots) == 0 {
 			log.Print("[DEBUG] No Redshift cluster snapshots to sweep")
 			return false
@@ -99,35 +132,46 @@ func sweepClusterSnapshots(region string) error {
 	})
 
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("describing Redshift Snapshots: %w", err))
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepClusters(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+
+	var errs *multierror.Error
+
+	sweepResources, err := listClusters(ctx, client)
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("describing Redshift Clusters: %w", err))
 		// in case work can be done, don't jump out yet
 	}
 
 	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("sweeping Redshift Snapshots for %s: %w", region, err))
+		errs = multierror.Append(errs, fmt.Errorf("sweeping Redshift Clusters for %s: %w", region, err))
 	}
 
 	if sweep.SkipSweepError(errs.ErrorOrNil()) {
-		log.Printf("[WARN] Skipping Redshift Snapshots sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping Redshift Cluster sweep for %s: %s", region, err)
 		return nil
 	}
 
 	return errs.ErrorOrNil()
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-
-	if err != nil {
-		return fmt.Errorf("getting client: %s", err)
-	}
-
+func listClusters(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.RedshiftConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
-	var errs *multierror.Error
 
-	err = conn.DescribeClustersPagesWithContext(ctx, &redshift.DescribeClustersInput{}, func(resp *redshift.DescribeClustersOutput, lastPage bool) bool {
+	err := conn.DescribeClustersPagesWithContext(ctx, &redshift.DescribeClustersInput{}, func(resp *redshift.DescribeClustersOutput, lastPage bool) bool {
 		if len(resp.Clusters) == 0 {
 			log.Print("[DEBUG] No Redshift clusters to sweep")
 			return !lastPage
@@ -146,20 +190,10 @@ func sweepClusters(region string) error {
 	})
 
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("describing Redshift Clusters: %w", err))
-		// in case work can be done, don't jump out yet
-	}
-
-	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("sweeping Redshift Clusters for %s: %w", region, err))
-	}
-
-	if sweep.SkipSweepError(errs.ErrorOrNil()) {
-		log.Printf("[WARN] Skipping Redshift Cluster sweep for %s: %s", region, err)
-		return nil
+		return nil, err
 	}
 
-	return errs.ErrorOrNil()
+	return sweepResources, nil
 }
 
 func sweepEventSubscriptions(region string) error {
@@ -170,11 +204,31 @@ func sweepEventSubscriptions(region string) error {
 		return fmt.Errorf("getting client: %w", err)
 	}
 
+	var errs *multierror.Error
+
+	sweepResources, err := listEventSubscriptions(ctx, client)
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("describing Redshift Event Subscriptions: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping Redshift Event Subscriptions for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Redshift Event Subscriptions sweep for %s: %s", region, err)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func listEventSubscriptions(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.RedshiftConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
-	var errs *multierror.Error
 
-	err = conn.DescribeEventSubscriptionsPagesWithContext(ctx, &redshift.DescribeEventSubscriptionsInput{}, func(page *redshift.DescribeEventSubscriptionsOutput, lastPage bool) bool {
+	err := conn.DescribeEventSubscriptionsPagesWithContext(ctx, &redshift.DescribeEventSubscriptionsInput{}, func(page *redshift.DescribeEventSubscriptionsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -191,19 +245,10 @@ func sweepEventSubscriptions(region string) error {
 	})
 
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("describing Redshift Event Subscriptions: %w", err))
-	}
-
-	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("sweeping Redshift Event Subscriptions for %s: %w", region, err))
-	}
-
-	if sweep.SkipSweepError(errs.ErrorOrNil()) {
-		log.Printf("[WARN] Skipping Redshift Event Subscriptions sweep for %s: %s", region, err)
-		return nil
+		return nil, err
 	}
 
-	return errs.ErrorOrNil()
+	return sweepResources, nil
 }
 
 func sweepScheduledActions(region string) error {
@@ -212,11 +257,33 @@ func sweepScheduledActions(region string) error {
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
+
+	sweepResources, err := listScheduledActions(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Redshift Scheduled Action sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing Redshift Scheduled Actions (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping Redshift Scheduled Actions (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listScheduledActions(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.RedshiftConn(ctx)
 	input := &redshift.DescribeScheduledActionsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.DescribeScheduledActionsPagesWithContext(ctx, input, func(page *redshift.DescribeScheduledActionsOutput, lastPage bool) bool {
+	err := conn.DescribeScheduledActionsPagesWithContext(ctx, input, func(page *redshift.DescribeScheduledActionsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -232,22 +299,11 @@ func sweepScheduledActions(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Redshift Scheduled Action sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("listing Redshift Scheduled Actions (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
 	if err != nil {
-		return fmt.Errorf("sweeping Redshift Scheduled Actions (%s): %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
 
 func sweepSnapshotSchedules(region string) error {
@@ -258,14 +314,34 @@ func sweepSnapshotSchedules(region string) error {
 		return fmt.Errorf("getting client: %s", err)
 	}
 
+	var errs *multierror.Error
+
+	sweepResources, err := listSnapshotSchedules(ctx, client)
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("describing Redshift Snapshot Schedules: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping Redshift Snapshot Schedules for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Redshift Snapshot Schedules sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func listSnapshotSchedules(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.RedshiftConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
-	var errs *multierror.Error
 
 	input := &redshift.DescribeSnapshotSchedulesInput{}
 	prefixesToSweep := []string{sweep.ResourcePrefix}
 
-	err = conn.DescribeSnapshotSchedulesPagesWithContext(ctx, input, func(page *redshift.DescribeSnapshotSchedulesOutput, lastPage bool) bool {
+	err := conn.DescribeSnapshotSchedulesPagesWithContext(ctx, input, func(page *redshift.DescribeSnapshotSchedulesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -290,19 +366,10 @@ func sweepSnapshotSchedules(region string) error {
 	})
 
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("describing Redshift Snapshot Schedules: %w", err))
-	}
-
-	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("sweeping Redshift Snapshot Schedules for %s: %w", region, err))
-	}
-
-	if sweep.SkipSweepError(errs.ErrorOrNil()) {
-		log.Printf("[WARN] Skipping Redshift Snapshot Schedules sweep for %s: %s", region, errs)
-		return nil
+		return nil, err
 	}
 
-	return errs.ErrorOrNil()
+	return sweepResources, nil
 }
 
 func sweepSubnetGroups(region string) error {
@@ -313,13 +380,33 @@ func sweepSubnetGroups(region string) error {
 		return fmt.Errorf("getting client: %w", err)
 	}
 
+	var errs *multierror.Error
+
+	sweepResources, err := listSubnetGroups(ctx, client)
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("describing Redshift Subnet Groups: %w", err))
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping Redshift Subnet Groups for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Redshift Subnet Group sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func listSubnetGroups(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.RedshiftConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
-	var errs *multierror.Error
 
 	input := &redshift.DescribeClusterSubnetGroupsInput{}
 
-	err = conn.DescribeClusterSubnetGroupsPagesWithContext(ctx, input, func(page *redshift.DescribeClusterSubnetGroupsOutput, lastPage bool) bool {
+	err := conn.DescribeClusterSubnetGroupsPagesWithContext(ctx, input, func(page *redshift.DescribeClusterSubnetGroupsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -346,19 +433,10 @@ func sweepSubnetGroups(region string) error {
 	})
 
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("describing Redshift Subnet Groups: %w", err))
-	}
-
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("sweeping Redshift Subnet Groups for %s: %w", region, err))
-	}
-
-	if sweep.SkipSweepError(errs.ErrorOrNil()) {
-		log.Printf("[WARN] Skipping Redshift Subnet Group sweep for %s: %s", region, errs)
-		return nil
+		return nil, err
 	}
 
-	return errs.ErrorOrNil()
+	return sweepResources, nil
 }
 
 func sweepHSMClientCertificates(region string) error {
@@ -369,11 +447,32 @@ func sweepHSMClientCertificates(region string) error {
 		return fmt.Errorf("getting client: %s", err)
 	}
 
+	var errs *multierror.Error
+
+	sweepResources, err := listHSMClientCertificates(ctx, client)
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("describing Redshift Hsm Client Certificates: %w", err))
+		// in case work can be done, don't jump out yet
+	}
+
+	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping Redshift Hsm Client Certificates for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Redshift Hsm Client Certificate sweep for %s: %s", region, err)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func listHSMClientCertificates(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.RedshiftConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
-	var errs *multierror.Error
 
-	err = conn.DescribeHsmClientCertificatesPagesWithContext(ctx, &redshift.DescribeHsmClientCertificatesInput{}, func(resp *redshift.DescribeHsmClientCertificatesOutput, lastPage bool) bool {
+	err := conn.DescribeHsmClientCertificatesPagesWithContext(ctx, &redshift.DescribeHsmClientCertificatesInput{}, func(resp *redshift.DescribeHsmClientCertificatesOutput, lastPage bool) bool {
 		if len(resp.HsmClientCertificates) == 0 {
 			log.Print("[DEBUG] No Redshift Hsm Client Certificates to sweep")
 			return !lastPage
@@ -391,35 +490,46 @@ func sweepHSMClientCertificates(region string) error {
 	})
 
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("describing Redshift Hsm Client Certificates: %w", err))
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepHSMConfigurations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+
+	var errs *multierror.Error
+
+	sweepResources, err := listHSMConfigurations(ctx, client)
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("describing Redshift Hsm Configurations: %w", err))
 		// in case work can be done, don't jump out yet
 	}
 
 	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("sweeping Redshift Hsm Client Certificates for %s: %w", region, err))
+		errs = multierror.Append(errs, fmt.Errorf("sweeping Redshift Hsm Configurations for %s: %w", region, err))
 	}
 
 	if sweep.SkipSweepError(errs.ErrorOrNil()) {
-		log.Printf("[WARN] Skipping Redshift Hsm Client Certificate sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping Redshift Hsm Configuration sweep for %s: %s", region, err)
 		return nil
 	}
 
 	return errs.ErrorOrNil()
 }
 
-func sweepHSMConfigurations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-
-	if err != nil {
-		return fmt.Errorf("getting client: %s", err)
-	}
-
+func listHSMConfigurations(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.RedshiftConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
-	var errs *multierror.Error
 
-	err = conn.DescribeHsmConfigurationsPagesWithContext(ctx, &redshift.DescribeHsmConfigurationsInput{}, func(resp *redshift.DescribeHsmConfigurationsOutput, lastPage bool) bool {
+	err := conn.DescribeHsmConfigurationsPagesWithContext(ctx, &redshift.DescribeHsmConfigurationsInput{}, func(resp *redshift.DescribeHsmConfigurationsOutput, lastPage bool) bool {
 		if len(resp.HsmConfigurations) == 0 {
 			log.Print("[DEBUG] No Redshift Hsm Configurations to sweep")
 			return !lastPage
@@ -437,20 +547,10 @@ func sweepHSMConfigurations(region string) error {
 	})
 
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("describing Redshift Hsm Configurations: %w", err))
-		// in case work can be done, don't jump out yet
+		return nil, err
 	}
 
-	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("sweeping Redshift Hsm Configurations for %s: %w", region, err))
-	}
-
-	if sweep.SkipSweepError(errs.ErrorOrNil()) {
-		log.Printf("[WARN] Skipping Redshift Hsm Configuration sweep for %s: %s", region, err)
-		return nil
-	}
-
-	return errs.ErrorOrNil()
+	return sweepResources, nil
 }
 
 func sweepAuthenticationProfiles(region string) error {
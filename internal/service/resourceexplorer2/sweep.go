@@ -7,14 +7,17 @@
 package resourceexplorer2
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
 )
 
@@ -23,6 +26,7 @@ func init() {
 		Name: "aws_resourceexplorer2_index",
 		F:    sweepIndexes,
 	})
+	discovery.Register("aws_resourceexplorer2_index", listIndexes)
 }
 
 func sweepIndexes(region string) error {
@@ -31,6 +35,28 @@ func sweepIndexes(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listIndexes(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Resource Explorer Index sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Resource Explorer Indexes (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Resource Explorer Indexes (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listIndexes(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.ResourceExplorer2Client(ctx)
 	input := &resourceexplorer2.ListIndexesInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
@@ -39,13 +65,8 @@ func sweepIndexes(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Resource Explorer Index sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error listing Resource Explorer Indexes (%s): %w", region, err)
+			return nil, err
 		}
 
 		for _, v := range page.Indexes {
@@ -55,11 +76,5 @@ func sweepIndexes(region string) error {
 		}
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping Resource Explorer Indexes (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
@@ -8,6 +8,7 @@ var (
 	CIDRLocationParseResourceID  = cidrLocationParseResourceID
 	FindCIDRCollectionByID       = findCIDRCollectionByID
 	FindCIDRLocationByTwoPartKey = findCIDRLocationByTwoPartKey
+	FindZoneRecordSets           = findZoneRecordSets
 	ResourceCIDRCollection       = newResourceCIDRCollection
 	ResourceCIDRLocation         = newResourceCIDRLocation
 )
@@ -96,6 +96,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				ResourceType:        "hostedzone",
 			},
 		},
+		{
+			Factory:  ResourceZoneRecords,
+			TypeName: "aws_route53_zone_records",
+		},
 		{
 			Factory:  ResourceZoneAssociation,
 			TypeName: "aws_route53_zone_association",
@@ -0,0 +1,384 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"bufio"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+// zoneFileRecordSet is a set of records with the same name and type.
+type zoneFileRecordSet struct {
+	// Name is the fully qualified, lower-case name of the record set, with a trailing dot.
+	Name string
+	Type string
+	TTL  int64
+	// Values are the record set's record data, in presentation format.
+	Values []string
+}
+
+// Key returns the string that identifies the record set within a hosted zone.
+func (rs *zoneFileRecordSet) Key() string {
+	return rs.Name + " " + rs.Type
+}
+
+// String returns the record set in zone file format, one record per line, with records sorted by value.
+func (rs *zoneFileRecordSet) String() string {
+	values := make([]string, len(rs.Values))
+	copy(values, rs.Values)
+	sort.Strings(values)
+
+	lines := make([]string, len(values))
+	for i, v := range values {
+		lines[i] = fmt.Sprintf("%s %d IN %s %s", rs.Name, rs.TTL, rs.Type, v)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// zoneFileLine is a logical line of a zone file, with parentheses and comments removed.
+type zoneFileLine struct {
+	// Number is the number of the physical line on which the logical line starts.
+	Number int
+	// Blank is whether the line starts with whitespace, i.e. has no owner name.
+	Blank  bool
+	Tokens []string
+}
+
+// parseZoneFile parses an RFC 1035 master file into record sets keyed by zoneFileRecordSet.Key.
+// Relative names are qualified with origin. SOA records and NS records at the origin are ignored,
+// as they are managed by Route 53. The $INCLUDE directive isn't supported.
+func parseZoneFile(content, origin string) (map[string]*zoneFileRecordSet, error) {
+	lines, err := zoneFileLines(content)
+
+	if err != nil {
+		return nil, err
+	}
+
+	origin = strings.ToLower(FQDN(origin))
+	recordSets := make(map[string]*zoneFileRecordSet)
+
+	var owner string
+	var defaultTTL, lastTTL int64 = -1, -1
+
+	for _, line := range lines {
+		tokens := line.Tokens
+
+		if !line.Blank && strings.HasPrefix(tokens[0], "$") {
+			switch directive := strings.ToUpper(tokens[0]); directive {
+			case "$ORIGIN":
+				if len(tokens) < 2 {
+					return nil, fmt.Errorf("line %d: $ORIGIN requires a domain name", line.Number)
+				}
+
+				origin = zoneFileQualify(tokens[1], origin)
+			case "$TTL":
+				if len(tokens) < 2 {
+					return nil, fmt.Errorf("line %d: $TTL requires a TTL", line.Number)
+				}
+
+				ttl, err := parseZoneFileTTL(tokens[1])
+
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", line.Number, err)
+				}
+
+				defaultTTL = ttl
+			default:
+				return nil, fmt.Errorf("line %d: unsupported directive (%s)", line.Number, tokens[0])
+			}
+
+			continue
+		}
+
+		if !line.Blank {
+			owner = zoneFileQualify(tokens[0], origin)
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, fmt.Errorf("line %d: record has no owner name", line.Number)
+		}
+
+		ttl := int64(-1)
+
+		// The TTL and class are both optional and can appear in either order.
+		for i := 0; i < 2 && len(tokens) > 0; i++ {
+			if strings.EqualFold(tokens[0], "IN") {
+				tokens = tokens[1:]
+			} else if v, err := parseZoneFileTTL(tokens[0]); err == nil && ttl == -1 {
+				ttl = v
+				tokens = tokens[1:]
+			} else {
+				break
+			}
+		}
+
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: record has no type", line.Number)
+		}
+
+		typ := strings.ToUpper(tokens[0])
+		rdata := tokens[1:]
+
+		if !validRecordType(typ) {
+			return nil, fmt.Errorf("line %d: unsupported record type (%s)", line.Number, tokens[0])
+		}
+
+		if len(rdata) == 0 {
+			return nil, fmt.Errorf("line %d: %s record has no data", line.Number, typ)
+		}
+
+		explicitTTL := ttl != -1
+
+		switch {
+		case explicitTTL:
+		case defaultTTL != -1:
+			ttl = defaultTTL
+		case lastTTL != -1:
+			ttl = lastTTL
+		default:
+			return nil, fmt.Errorf("line %d: record has no TTL and there is no $TTL directive", line.Number)
+		}
+
+		lastTTL = ttl
+
+		if typ == route53.RRTypeSoa || (typ == route53.RRTypeNs && owner == origin) {
+			continue
+		}
+
+		if owner != origin && !strings.HasSuffix(owner, "."+origin) {
+			return nil, fmt.Errorf("line %d: name (%s) is outside the zone (%s)", line.Number, owner, origin)
+		}
+
+		rs := &zoneFileRecordSet{
+			Name: owner,
+			Type: typ,
+			TTL:  ttl,
+		}
+
+		if v, ok := recordSets[rs.Key()]; ok {
+			// A record without a TTL takes the TTL of the other records in its set.
+			if !explicitTTL {
+				ttl = v.TTL
+			}
+
+			if v.TTL != ttl {
+				return nil, fmt.Errorf("line %d: TTL (%d) differs from the TTL (%d) of other %s records for %s", line.Number, ttl, v.TTL, typ, owner)
+			}
+
+			rs = v
+		} else {
+			recordSets[rs.Key()] = rs
+		}
+
+		rs.Values = append(rs.Values, zoneFileRecordData(typ, rdata, origin))
+	}
+
+	return recordSets, nil
+}
+
+// zoneFileLines splits a zone file into logical lines.
+func zoneFileLines(content string) ([]zoneFileLine, error) {
+	var lines []zoneFileLine
+	var current *zoneFileLine
+	depth := 0
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for n := 1; scanner.Scan(); n++ {
+		text := scanner.Text()
+
+		if depth == 0 {
+			current = &zoneFileLine{
+				Number: n,
+				Blank:  text != "" && unicode.IsSpace(rune(text[0])),
+			}
+		}
+
+		tokens, d, err := zoneFileTokens(text, depth)
+
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+
+		depth = d
+		current.Tokens = append(current.Tokens, tokens...)
+
+		if depth == 0 && len(current.Tokens) > 0 {
+			lines = append(lines, *current)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", current.Number)
+	}
+
+	return lines, nil
+}
+
+// zoneFileTokens splits a physical line into tokens, tracking the parenthesis depth.
+// Quoted strings are returned as single tokens including their quotes.
+func zoneFileTokens(text string, depth int) ([]string, int, error) {
+	var tokens []string
+	var token strings.Builder
+	inQuotes := false
+
+	flush := func() {
+		if token.Len() > 0 {
+			tokens = append(tokens, token.String())
+			token.Reset()
+		}
+	}
+
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+
+		switch {
+		case c == '\\' && i+1 < len(text):
+			token.WriteByte(c)
+			token.WriteByte(text[i+1])
+			i++
+		case c == '"':
+			token.WriteByte(c)
+			inQuotes = !inQuotes
+
+			if !inQuotes {
+				flush()
+			}
+		case inQuotes:
+			token.WriteByte(c)
+		case c == ';':
+			flush()
+			return tokens, depth, nil
+		case c == '(':
+			flush()
+			depth++
+		case c == ')':
+			flush()
+			depth--
+
+			if depth < 0 {
+				return nil, 0, fmt.Errorf("unbalanced parentheses")
+			}
+		case c == ' ' || c == '\t' || c == '\r':
+			flush()
+		default:
+			token.WriteByte(c)
+		}
+	}
+
+	if inQuotes {
+		return nil, 0, fmt.Errorf("unterminated quoted string")
+	}
+
+	flush()
+
+	return tokens, depth, nil
+}
+
+// zoneFileQualify returns the fully qualified, lower-case form of a domain name.
+func zoneFileQualify(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(name)
+	default:
+		return strings.ToLower(name) + "." + origin
+	}
+}
+
+// zoneFileRecordData returns the presentation format of a record's data, qualifying any domain names.
+func zoneFileRecordData(typ string, rdata []string, origin string) string {
+	fields := make([]string, len(rdata))
+	copy(fields, rdata)
+
+	// The index of the field containing a domain name.
+	var index int
+
+	switch typ {
+	case route53.RRTypeCname, route53.RRTypeNs, route53.RRTypePtr:
+		index = 0
+	case route53.RRTypeMx:
+		index = 1
+	case route53.RRTypeSrv:
+		index = 3
+	default:
+		index = -1
+	}
+
+	if index >= 0 && index < len(fields) {
+		fields[index] = zoneFileQualify(fields[index], origin)
+	}
+
+	if typ == route53.RRTypeTxt || typ == route53.RRTypeSpf {
+		for i, v := range fields {
+			if !strings.HasPrefix(v, `"`) {
+				fields[i] = strconv.Quote(v)
+			}
+		}
+	}
+
+	return strings.Join(fields, " ")
+}
+
+// parseZoneFileTTL parses a TTL in seconds, optionally using BIND's unit suffixes, e.g. 1h30m.
+func parseZoneFileTTL(s string) (int64, error) {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if v < 0 {
+			return 0, fmt.Errorf("invalid TTL (%s)", s)
+		}
+
+		return v, nil
+	}
+
+	var ttl, n int64
+	digits := false
+
+	for _, c := range strings.ToLower(s) {
+		if c >= '0' && c <= '9' {
+			n = n*10 + int64(c-'0')
+			digits = true
+			continue
+		}
+
+		if !digits {
+			return 0, fmt.Errorf("invalid TTL (%s)", s)
+		}
+
+		switch c {
+		case 's':
+			ttl += n
+		case 'm':
+			ttl += n * 60
+		case 'h':
+			ttl += n * 60 * 60
+		case 'd':
+			ttl += n * 60 * 60 * 24
+		case 'w':
+			ttl += n * 60 * 60 * 24 * 7
+		default:
+			return 0, fmt.Errorf("invalid TTL (%s)", s)
+		}
+
+		n = 0
+		digits = false
+	}
+
+	if digits {
+		return 0, fmt.Errorf("invalid TTL (%s)", s)
+	}
+
+	return ttl, nil
+}
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		Content  string
+		Expected map[string]string
+		Error    bool
+	}{
+		"empty": {
+			Content:  "; nothing here\n",
+			Expected: map[string]string{},
+		},
+		"relative and absolute names": {
+			Content: `$TTL 3600
+@       IN SOA ns1.example.com. hostmaster.example.com. (
+            2023010101 ; serial
+            7200       ; refresh
+            3600       ; retry
+            1209600    ; expire
+            300 )      ; minimum
+@       IN NS  ns1.example.com.
+@          A   192.0.2.1
+www     300 IN A 192.0.2.2
+            IN A 192.0.2.3
+mail.example.com. IN MX 10 mx
+Blog    IN 1h CNAME www
+sub     NS  ns1.other.net.
+`,
+			Expected: map[string]string{
+				"example.com. A":          "example.com. 3600 IN A 192.0.2.1",
+				"www.example.com. A":      "www.example.com. 300 IN A 192.0.2.2\nwww.example.com. 300 IN A 192.0.2.3",
+				"mail.example.com. MX":    "mail.example.com. 3600 IN MX 10 mx.example.com.",
+				"blog.example.com. CNAME": "blog.example.com. 3600 IN CNAME www.example.com.",
+				"sub.example.com. NS":     "sub.example.com. 3600 IN NS ns1.other.net.",
+			},
+		},
+		"origin and TXT": {
+			Content: `$ORIGIN dev.example.com.
+$TTL 1d
+@    TXT "v=spf1 include:example.net ~all"
+txt  TXT single "two words" ; comment
+_sip._tcp SRV 10 60 5060 sip
+`,
+			Expected: map[string]string{
+				"dev.example.com. TXT":           `dev.example.com. 86400 IN TXT "v=spf1 include:example.net ~all"`,
+				"txt.dev.example.com. TXT":       `txt.dev.example.com. 86400 IN TXT "single" "two words"`,
+				"_sip._tcp.dev.example.com. SRV": "_sip._tcp.dev.example.com. 86400 IN SRV 10 60 5060 sip.dev.example.com.",
+			},
+		},
+		"previous TTL": {
+			Content: `a 60 A 192.0.2.1
+b A 192.0.2.2
+`,
+			Expected: map[string]string{
+				"a.example.com. A": "a.example.com. 60 IN A 192.0.2.1",
+				"b.example.com. A": "b.example.com. 60 IN A 192.0.2.2",
+			},
+		},
+		"no TTL": {
+			Content: "a A 192.0.2.1\n",
+			Error:   true,
+		},
+		"different TTLs": {
+			Content: "a 60 A 192.0.2.1\na 120 A 192.0.2.2\n",
+			Error:   true,
+		},
+		"outside zone": {
+			Content: "www.example.net. 60 A 192.0.2.1\n",
+			Error:   true,
+		},
+		"unsupported type": {
+			Content: "a 60 HINFO cpu os\n",
+			Error:   true,
+		},
+		"include": {
+			Content: "$INCLUDE other.zone\n",
+			Error:   true,
+		},
+		"unbalanced parentheses": {
+			Content: "a 60 TXT ( \"x\"\n",
+			Error:   true,
+		},
+		"unterminated quote": {
+			Content: "a 60 TXT \"x\n",
+			Error:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			recordSets, err := parseZoneFile(testCase.Content, "example.com")
+
+			if testCase.Error {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := make(map[string]string)
+			for k, v := range recordSets {
+				got[k] = v.String()
+			}
+
+			if diff := cmp.Diff(got, testCase.Expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestParseZoneFileTTL(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		Input    string
+		Expected int64
+		Error    bool
+	}{
+		"seconds": {Input: "300", Expected: 300},
+		"units":   {Input: "1h30m", Expected: 5400},
+		"week":    {Input: "1W", Expected: 604800},
+		"no unit": {Input: "1h30", Error: true},
+		"unknown": {Input: "1y", Error: true},
+		"type":    {Input: "A", Error: true},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseZoneFileTTL(testCase.Input)
+
+			if testCase.Error {
+				if err == nil {
+					t.Fatalf("expected error, got %d", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %d, expected %d", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,554 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// See https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html#limits-api-requests-changeresourcerecordsets.
+	changeBatchMaxRecords    = 1000
+	changeBatchMaxValueChars = 32000
+)
+
+// @SDKResource("aws_route53_zone_records")
+func ResourceZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceZoneRecordsCreate,
+		ReadWithoutTimeout:   resourceZoneRecordsRead,
+		UpdateWithoutTimeout: resourceZoneRecordsUpdate,
+		DeleteWithoutTimeout: resourceZoneRecordsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceZoneRecordsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"delete_unmanaged": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"record": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"records": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ttl": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+						},
+					},
+				},
+				AtLeastOneOf: []string{"record", "zone_file"},
+			},
+			"record_sets": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"zone_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"record", "zone_file"},
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					return CleanZoneID(v.(string))
+				},
+			},
+		},
+	}
+}
+
+func resourceZoneRecordsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+	recordSets, err := applyZoneRecords(ctx, conn, d, zoneID, nil)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Route 53 Hosted Zone (%s) Records: %s", zoneID, err)
+	}
+
+	d.SetId(zoneID)
+	d.Set("record_sets", recordSets)
+
+	return append(diags, resourceZoneRecordsRead(ctx, d, meta)...)
+}
+
+func resourceZoneRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	output, err := FindHostedZoneByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Hosted Zone (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", d.Id(), err)
+	}
+
+	live, err := findZoneRecordSets(ctx, conn, d.Id(), aws.StringValue(output.HostedZone.Name))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s) Records: %s", d.Id(), err)
+	}
+
+	recordSets := make(map[string]string)
+
+	// Record sets deleted outside of Terraform are dropped so that they are created again.
+	for k := range d.Get("record_sets").(map[string]interface{}) {
+		if v, ok := live[k]; ok {
+			recordSets[k] = zoneRecordSetString(v)
+		}
+	}
+
+	// Unmanaged record sets are added so that they are deleted.
+	if d.Get("delete_unmanaged").(bool) {
+		for k, v := range live {
+			recordSets[k] = zoneRecordSetString(v)
+		}
+	}
+
+	d.Set("record_sets", recordSets)
+	d.Set("zone_id", d.Id())
+
+	return diags
+}
+
+func resourceZoneRecordsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	o, _ := d.GetChange("record_sets")
+	recordSets, err := applyZoneRecords(ctx, conn, d, d.Id(), o.(map[string]interface{}))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Route 53 Hosted Zone (%s) Records: %s", d.Id(), err)
+	}
+
+	d.Set("record_sets", recordSets)
+
+	return append(diags, resourceZoneRecordsRead(ctx, d, meta)...)
+}
+
+func resourceZoneRecordsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	output, err := FindHostedZoneByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", d.Id(), err)
+	}
+
+	live, err := findZoneRecordSets(ctx, conn, d.Id(), aws.StringValue(output.HostedZone.Name))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s) Records: %s", d.Id(), err)
+	}
+
+	changes := zoneRecordsChanges(nil, live, d.Get("record_sets").(map[string]interface{}), false)
+
+	log.Printf("[DEBUG] Deleting Route 53 Hosted Zone (%s) Records: %d changes", d.Id(), len(changes))
+	if err := changeZoneRecords(ctx, conn, d.Id(), changes); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Route 53 Hosted Zone (%s) Records: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// resourceZoneRecordsCustomizeDiff plans per-record set changes by parsing the configured records offline.
+func resourceZoneRecordsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"zone_id", "zone_file", "record"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("record_sets")
+		}
+	}
+
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+
+	output, err := FindHostedZoneByID(ctx, conn, zoneID)
+
+	if tfresource.NotFound(err) {
+		// The hosted zone is being created or was deleted outside of Terraform.
+		return d.SetNewComputed("record_sets")
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading Route 53 Hosted Zone (%s): %w", zoneID, err)
+	}
+
+	recordSets, err := expandZoneRecordSets(d, aws.StringValue(output.HostedZone.Name))
+
+	if err != nil {
+		return err
+	}
+
+	new := flattenZoneRecordSets(recordSets)
+	old := d.Get("record_sets").(map[string]interface{})
+
+	if d.Id() != "" && len(old) == len(new) {
+		changed := false
+
+		for k, v := range old {
+			if new[k] != v {
+				changed = true
+				break
+			}
+		}
+
+		if !changed {
+			return nil
+		}
+	}
+
+	return d.SetNew("record_sets", new)
+}
+
+// zoneRecordsGetter is implemented by both schema.ResourceData and schema.ResourceDiff.
+type zoneRecordsGetter interface {
+	Get(string) interface{}
+}
+
+// applyZoneRecords makes the hosted zone's record sets match the configuration and returns the flattened
+// configured record sets. Record sets that aren't configured are deleted if they were previously managed
+// or if delete_unmanaged is set.
+func applyZoneRecords(ctx context.Context, conn *route53.Route53, d *schema.ResourceData, zoneID string, managed map[string]interface{}) (map[string]string, error) {
+	output, err := FindHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Route 53 Hosted Zone (%s): %w", zoneID, err)
+	}
+
+	zoneName := aws.StringValue(output.HostedZone.Name)
+	recordSets, err := expandZoneRecordSets(d, zoneName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	live, err := findZoneRecordSets(ctx, conn, zoneID, zoneName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	changes := zoneRecordsChanges(recordSets, live, managed, d.Get("delete_unmanaged").(bool))
+
+	if err := changeZoneRecords(ctx, conn, zoneID, changes); err != nil {
+		return nil, err
+	}
+
+	return flattenZoneRecordSets(recordSets), nil
+}
+
+// zoneRecordsChanges returns the changes that create or update the desired record sets and delete
+// the live record sets that aren't desired, if they're managed or deleteUnmanaged is set.
+// Deletions come first so that, for example, a CNAME record can replace an A record with the same name.
+func zoneRecordsChanges(desired map[string]*zoneFileRecordSet, live map[string]*route53.ResourceRecordSet, managed map[string]interface{}, deleteUnmanaged bool) []*route53.Change {
+	var deletes, upserts []*route53.Change
+
+	keys := maps.Keys(live)
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		if _, ok := desired[k]; ok {
+			continue
+		}
+
+		if _, ok := managed[k]; !ok && !deleteUnmanaged {
+			continue
+		}
+
+		deletes = append(deletes, &route53.Change{
+			Action:            aws.String(route53.ChangeActionDelete),
+			ResourceRecordSet: live[k],
+		})
+	}
+
+	keys = maps.Keys(desired)
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		rs := desired[k]
+
+		if v, ok := live[k]; ok && zoneRecordSetString(v) == rs.String() {
+			continue
+		}
+
+		upserts = append(upserts, &route53.Change{
+			Action:            aws.String(route53.ChangeActionUpsert),
+			ResourceRecordSet: expandZoneRecordSet(rs),
+		})
+	}
+
+	return append(deletes, upserts...)
+}
+
+// changeZoneRecords applies changes in batches, waiting for each batch to be INSYNC.
+func changeZoneRecords(ctx context.Context, conn *route53.Route53, zoneID string, changes []*route53.Change) error {
+	batches := chunkZoneRecordsChanges(changes)
+
+	for i, batch := range batches {
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &route53.ChangeBatch{
+				Comment: aws.String("Managed by Terraform"),
+				Changes: batch,
+			},
+			HostedZoneId: aws.String(zoneID),
+		}
+
+		log.Printf("[DEBUG] Changing Route 53 Hosted Zone (%s) Records: batch %d of %d (%d changes)", zoneID, i+1, len(batches), len(batch))
+		changeInfo, err := ChangeResourceRecordSets(ctx, conn, input)
+
+		if err != nil {
+			return fmt.Errorf("changing records (batch %d of %d): %w", i+1, len(batches), err)
+		}
+
+		if _, err := waitChangeInfoStatusInsync(ctx, conn, CleanChangeID(aws.StringValue(changeInfo.Id))); err != nil {
+			return fmt.Errorf("waiting for Route 53 Change (%s) INSYNC: %w", aws.StringValue(changeInfo.Id), err)
+		}
+	}
+
+	return nil
+}
+
+// chunkZoneRecordsChanges splits changes into batches that are within ChangeResourceRecordSets's limits
+// on the number of records and the number of characters in record values. UPSERTs count twice.
+func chunkZoneRecordsChanges(changes []*route53.Change) [][]*route53.Change {
+	var batches [][]*route53.Change
+	var batch []*route53.Change
+	var records, chars int
+
+	for _, change := range changes {
+		n, c := zoneRecordsChangeSize(change)
+
+		if len(batch) > 0 && (records+n > changeBatchMaxRecords || chars+c > changeBatchMaxValueChars) {
+			batches = append(batches, batch)
+			batch, records, chars = nil, 0, 0
+		}
+
+		batch = append(batch, change)
+		records += n
+		chars += c
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
+
+// zoneRecordsChangeSize returns the number of records and characters in record values that count towards
+// a change batch's limits.
+func zoneRecordsChangeSize(change *route53.Change) (int, int) {
+	records, chars := 1, 0
+
+	if rs := change.ResourceRecordSet; rs != nil && len(rs.ResourceRecords) > 0 {
+		records = len(rs.ResourceRecords)
+
+		for _, v := range rs.ResourceRecords {
+			chars += len(aws.StringValue(v.Value))
+		}
+	}
+
+	if aws.StringValue(change.Action) == route53.ChangeActionUpsert {
+		records, chars = records*2, chars*2
+	}
+
+	return records, chars
+}
+
+// findZoneRecordSets returns the record sets in a hosted zone that can be managed by this resource, keyed
+// by zoneFileRecordSet.Key. The zone's SOA and NS records and record sets with routing policies are omitted.
+func findZoneRecordSets(ctx context.Context, conn *route53.Route53, zoneID, zoneName string) (map[string]*route53.ResourceRecordSet, error) {
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+	apex := strings.ToLower(FQDN(zoneName))
+	output := make(map[string]*route53.ResourceRecordSet)
+
+	err := conn.ListResourceRecordSetsPagesWithContext(ctx, input, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceRecordSets {
+			if v == nil || v.SetIdentifier != nil {
+				continue
+			}
+
+			name := zoneRecordSetName(v)
+			typ := aws.StringValue(v.Type)
+
+			if typ == route53.RRTypeSoa || (typ == route53.RRTypeNs && name == apex) {
+				continue
+			}
+
+			output[name+" "+typ] = v
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// expandZoneRecordSets returns the record sets configured in zone_file and record, keyed by zoneFileRecordSet.Key.
+func expandZoneRecordSets(d zoneRecordsGetter, zoneName string) (map[string]*zoneFileRecordSet, error) {
+	recordSets := make(map[string]*zoneFileRecordSet)
+
+	if v := d.Get("zone_file").(string); v != "" {
+		parsed, err := parseZoneFile(v, zoneName)
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing zone_file: %w", err)
+		}
+
+		recordSets = parsed
+	}
+
+	for _, tfMapRaw := range d.Get("record").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		values := flex.ExpandStringValueSet(tfMap["records"].(*schema.Set))
+		slices.Sort(values)
+
+		rs := &zoneFileRecordSet{
+			Name:   FQDN(ExpandRecordName(tfMap["name"].(string), zoneName)),
+			Type:   tfMap["type"].(string),
+			TTL:    int64(tfMap["ttl"].(int)),
+			Values: values,
+		}
+
+		if _, ok := recordSets[rs.Key()]; ok {
+			return nil, fmt.Errorf("duplicate record set (%s)", rs.Key())
+		}
+
+		recordSets[rs.Key()] = rs
+	}
+
+	return recordSets, nil
+}
+
+func expandZoneRecordSet(rs *zoneFileRecordSet) *route53.ResourceRecordSet {
+	apiObject := &route53.ResourceRecordSet{
+		Name: aws.String(rs.Name),
+		TTL:  aws.Int64(rs.TTL),
+		Type: aws.String(rs.Type),
+	}
+
+	for _, v := range rs.Values {
+		apiObject.ResourceRecords = append(apiObject.ResourceRecords, &route53.ResourceRecord{
+			Value: aws.String(v),
+		})
+	}
+
+	return apiObject
+}
+
+func flattenZoneRecordSets(recordSets map[string]*zoneFileRecordSet) map[string]string {
+	tfMap := make(map[string]string, len(recordSets))
+
+	for k, v := range recordSets {
+		tfMap[k] = v.String()
+	}
+
+	return tfMap
+}
+
+// zoneRecordSetName returns the fully qualified, lower-case name of a record set, with a trailing dot.
+func zoneRecordSetName(apiObject *route53.ResourceRecordSet) string {
+	return strings.ToLower(FQDN(CleanRecordName(aws.StringValue(apiObject.Name))))
+}
+
+// zoneRecordSetString returns a record set in the same format as zoneFileRecordSet.String.
+// Alias records, which can't be configured, are returned in a pseudo-record format.
+func zoneRecordSetString(apiObject *route53.ResourceRecordSet) string {
+	name := zoneRecordSetName(apiObject)
+	typ := aws.StringValue(apiObject.Type)
+
+	if v := apiObject.AliasTarget; v != nil {
+		return fmt.Sprintf("%s ALIAS %s %s %s", name, typ, aws.StringValue(v.HostedZoneId), NormalizeAliasName(aws.StringValue(v.DNSName)))
+	}
+
+	rs := &zoneFileRecordSet{
+		Name: name,
+		Type: typ,
+		TTL:  aws.Int64Value(apiObject.TTL),
+	}
+
+	for _, v := range apiObject.ResourceRecords {
+		rs.Values = append(rs.Values, aws.StringValue(v.Value))
+	}
+
+	return rs.String()
+}
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+)
+
+func TestAccRoute53ZoneRecords_zoneFile(t *testing.T) {
+	ctx := acctest.Context(t)
+	var zone route53.GetHostedZoneOutput
+	resourceName := "aws_route53_zone_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordsConfig_zoneFile(zoneName.String(), `$TTL 300
+@      IN A     192.0.2.1
+www    IN A     192.0.2.2
+       IN A     192.0.2.3
+mail   IN MX    10 mx1
+       IN MX    20 mx2
+blog   IN CNAME www
+@      IN TXT   "v=spf1 -all"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneExists(ctx, "aws_route53_zone.test", &zone),
+					resource.TestCheckResourceAttr(resourceName, "record_sets.%", "5"),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("record_sets.www.%s. A", zoneName), fmt.Sprintf("www.%[1]s. 300 IN A 192.0.2.2\nwww.%[1]s. 300 IN A 192.0.2.3", zoneName)),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("record_sets.blog.%s. CNAME", zoneName), fmt.Sprintf("blog.%[1]s. 300 IN CNAME www.%[1]s.", zoneName)),
+					testAccCheckZoneRecordsCount(ctx, &zone, 5),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_unmanaged", "record_sets", "zone_file"},
+			},
+			{
+				Config: testAccZoneRecordsConfig_zoneFile(zoneName.String(), `$TTL 300
+@      IN A     192.0.2.1
+www    60 IN A  192.0.2.2
+mail   IN MX    10 mx1
+blog   IN CNAME www
+api    IN AAAA  2001:db8::1
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "record_sets.%", "5"),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("record_sets.www.%s. A", zoneName), fmt.Sprintf("www.%s. 60 IN A 192.0.2.2", zoneName)),
+					resource.TestCheckNoResourceAttr(resourceName, fmt.Sprintf("record_sets.%s. TXT", zoneName)),
+					resource.TestCheckResourceAttrSet(resourceName, fmt.Sprintf("record_sets.api.%s. AAAA", zoneName)),
+					testAccCheckZoneRecordsCount(ctx, &zone, 5),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ZoneRecords_record(t *testing.T) {
+	ctx := acctest.Context(t)
+	var zone route53.GetHostedZoneOutput
+	resourceName := "aws_route53_zone_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordsConfig_record(zoneName.String()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneExists(ctx, "aws_route53_zone.test", &zone),
+					resource.TestCheckResourceAttr(resourceName, "record_sets.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("record_sets.www.%s. A", zoneName), fmt.Sprintf("www.%[1]s. 300 IN A 192.0.2.1\nwww.%[1]s. 300 IN A 192.0.2.2", zoneName)),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("record_sets.txt.%s. TXT", zoneName), fmt.Sprintf(`txt.%s. 60 IN TXT "hello"`, zoneName)),
+					testAccCheckZoneRecordsCount(ctx, &zone, 2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ZoneRecords_deleteUnmanaged(t *testing.T) {
+	ctx := acctest.Context(t)
+	var zone route53.GetHostedZoneOutput
+	resourceName := "aws_route53_zone_records.test"
+	zoneName := acctest.RandomDomain()
+	zoneFile := `www 300 IN A 192.0.2.1
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordsConfig_zoneFile(zoneName.String(), zoneFile),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneExists(ctx, "aws_route53_zone.test", &zone),
+					testAccCreateRandomRecordsInZoneID(ctx, &zone, 10),
+					testAccCheckZoneRecordsCount(ctx, &zone, 11),
+				),
+			},
+			{
+				// Unmanaged records are left alone.
+				Config: testAccZoneRecordsConfig_zoneFile(zoneName.String(), zoneFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "record_sets.%", "1"),
+					testAccCheckZoneRecordsCount(ctx, &zone, 11),
+				),
+			},
+			{
+				Config: testAccZoneRecordsConfig_deleteUnmanaged(zoneName.String(), zoneFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "delete_unmanaged", "true"),
+					resource.TestCheckResourceAttr(resourceName, "record_sets.%", "1"),
+					testAccCheckZoneRecordsCount(ctx, &zone, 1),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckZoneRecordsCount checks the number of record sets in the hosted zone, excluding its SOA and NS records.
+func testAccCheckZoneRecordsCount(ctx context.Context, zone *route53.GetHostedZoneOutput, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn(ctx)
+
+		zoneID := tfroute53.CleanZoneID(aws.StringValue(zone.HostedZone.Id))
+		output, err := tfroute53.FindZoneRecordSets(ctx, conn, zoneID, aws.StringValue(zone.HostedZone.Name))
+
+		if err != nil {
+			return err
+		}
+
+		if len(output) != count {
+			return fmt.Errorf("Route 53 Hosted Zone (%s) has %d record sets, expected %d", zoneID, len(output), count)
+		}
+
+		return nil
+	}
+}
+
+func testAccZoneRecordsConfig_zoneFile(zoneName, zoneFile string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_zone_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  zone_file = <<EOT
+%[2]s
+EOT
+}
+`, zoneName, zoneFile)
+}
+
+func testAccZoneRecordsConfig_deleteUnmanaged(zoneName, zoneFile string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_zone_records" "test" {
+  zone_id          = aws_route53_zone.test.zone_id
+  delete_unmanaged = true
+
+  zone_file = <<EOT
+%[2]s
+EOT
+}
+`, zoneName, zoneFile)
+}
+
+func testAccZoneRecordsConfig_record(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_zone_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www"
+    type    = "A"
+    ttl     = 300
+    records = ["192.0.2.2", "192.0.2.1"]
+  }
+
+  record {
+    name    = "txt.%[1]s"
+    type    = "TXT"
+    ttl     = 60
+    records = ["\"hello\""]
+  }
+}
+`, zoneName)
+}
@@ -699,7 +699,7 @@ func resourceBucketCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).S3Conn(ctx)
 
-	bucket := create.Name(d.Get("bucket").(string), d.Get("bucket_prefix").(string))
+	bucket := create.Name(ctx, d.Get("bucket").(string), d.Get("bucket_prefix").(string))
 
 	awsRegion := meta.(*conns.AWSClient).Region
 
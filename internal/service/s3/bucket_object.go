@@ -592,6 +592,10 @@ func FindObjectByThreePartKeyV1(ctx context.Context, conn *s3.S3, bucket, key, e
 		input.IfMatch = aws.String(etag)
 	}
 
+	return findObjectV1(ctx, conn, input)
+}
+
+func findObjectV1(ctx context.Context, conn *s3.S3, input *s3.HeadObjectInput) (*s3.HeadObjectOutput, error) {
 	output, err := conn.HeadObjectWithContext(ctx, input)
 
 	if tfawserr.ErrStatusCodeEquals(err, http.StatusNotFound) {
@@ -75,8 +75,6 @@ func forEachObjectVersionsPage(ctx context.Context, conn *s3.S3, bucket string,
 // an attempt is made to remove any S3 Object Lock legal holds.
 // Returns the number of objects deleted.
 func deletePageOfObjectVersions(ctx context.Context, conn *s3.S3, bucket string, force bool, page *s3.ListObjectVersionsOutput) (int64, error) {
-	var nObjects int64
-
 	toDelete := make([]*s3.ObjectIdentifier, 0, len(page.Versions))
 	for _, v := range page.Versions {
 		toDelete = append(toDelete, &s3.ObjectIdentifier{
@@ -85,6 +83,16 @@ func deletePageOfObjectVersions(ctx context.Context, conn *s3.S3, bucket string,
 		})
 	}
 
+	return deleteObjects(ctx, conn, bucket, force, toDelete)
+}
+
+// deleteObjects deletes a batch (<= 1000) of S3 objects or object versions.
+// If `force` is `true` then S3 Object Lock governance mode restrictions are bypassed and
+// an attempt is made to remove any S3 Object Lock legal holds.
+// Returns the number of objects deleted.
+func deleteObjects(ctx context.Context, conn *s3.S3, bucket string, force bool, toDelete []*s3.ObjectIdentifier) (int64, error) {
+	var nObjects int64
+
 	if nObjects = int64(len(toDelete)); nObjects == 0 {
 		return nObjects, nil
 	}
@@ -0,0 +1,542 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	directorySyncDefaultConcurrency = 10
+	// directorySyncDeleteBatchSize is the maximum number of keys in a single DeleteObjects request.
+	directorySyncDeleteBatchSize = 1000
+)
+
+// @SDKResource("aws_s3_directory_sync", name="Directory Sync")
+func ResourceDirectorySync() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectorySyncCreate,
+		ReadWithoutTimeout:   resourceDirectorySyncRead,
+		UpdateWithoutTimeout: resourceDirectorySyncUpdate,
+		DeleteWithoutTimeout: resourceDirectorySyncDelete,
+
+		CustomizeDiff: resourceDirectorySyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ObjectCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"checksum_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ChecksumAlgorithm_Values(), false),
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      directorySyncDefaultConcurrency,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"content_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"delete_orphans": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"files": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"metadata": {
+				Type:         schema.TypeMap,
+				ValidateFunc: validateMetadataIsLowerCase,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+			"server_side_encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ServerSideEncryption_Values(), false),
+			},
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"storage_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ObjectStorageClass_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceDirectorySyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	excludes := flex.ExpandStringValueSet(d.Get("exclude").(*schema.Set))
+
+	manifest, err := newDirectorySyncManifest(d.Get("source_dir").(string), excludes)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Directory Sync: %s", err)
+	}
+
+	id := directorySyncCreateResourceID(bucket, keyPrefix)
+
+	if err := directorySyncUploadFiles(ctx, conn, d, manifest, directorySyncSortedKeys(manifest)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Directory Sync (%s): %s", id, err)
+	}
+
+	if d.Get("delete_orphans").(bool) {
+		if err := directorySyncDeleteOrphans(ctx, conn, bucket, keyPrefix, excludes, manifest, nil); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating S3 Directory Sync (%s): %s", id, err)
+		}
+	}
+
+	d.SetId(id)
+	d.Set("files", manifest.hashes())
+
+	return append(diags, resourceDirectorySyncRead(ctx, d, meta)...)
+}
+
+func resourceDirectorySyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	keys, err := findDirectorySyncObjectKeys(ctx, conn, bucket, keyPrefix)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Directory Sync (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	files := make(map[string]string)
+
+	// Objects deleted outside of Terraform are dropped from the manifest so that they are uploaded again.
+	for k, v := range d.Get("files").(map[string]interface{}) {
+		if _, ok := keys[k]; ok {
+			files[k] = v.(string)
+		}
+	}
+
+	// Orphaned objects are recorded without a hash so that they are deleted on the next apply.
+	if d.Get("delete_orphans").(bool) {
+		excludes := flex.ExpandStringValueSet(d.Get("exclude").(*schema.Set))
+
+		for k := range keys {
+			if _, ok := files[k]; !ok && !directorySyncExcluded(k, excludes) {
+				files[k] = ""
+			}
+		}
+	}
+
+	d.Set("files", files)
+
+	return diags
+}
+
+func resourceDirectorySyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	excludes := flex.ExpandStringValueSet(d.Get("exclude").(*schema.Set))
+
+	manifest, err := newDirectorySyncManifest(d.Get("source_dir").(string), excludes)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	o, _ := d.GetChange("files")
+	old := o.(map[string]interface{})
+
+	// Changes to object settings apply to every file, otherwise only new and modified files are uploaded.
+	uploadAll := d.HasChanges(
+		"acl",
+		"cache_control",
+		"checksum_algorithm",
+		"content_types",
+		"kms_key_id",
+		"metadata",
+		"server_side_encryption",
+		"storage_class",
+	)
+
+	var upload []string
+
+	for _, k := range directorySyncSortedKeys(manifest) {
+		if v, ok := old[k]; uploadAll || !ok || v.(string) != manifest[k].Hash {
+			upload = append(upload, k)
+		}
+	}
+
+	if err := directorySyncUploadFiles(ctx, conn, d, manifest, upload); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	var removed []string
+
+	for k := range old {
+		if _, ok := manifest[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+
+	if d.Get("delete_orphans").(bool) {
+		err = directorySyncDeleteOrphans(ctx, conn, bucket, keyPrefix, excludes, manifest, removed)
+	} else {
+		err = directorySyncDeleteKeys(ctx, conn, bucket, keyPrefix, removed)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	d.Set("files", manifest.hashes())
+
+	return append(diags, resourceDirectorySyncRead(ctx, d, meta)...)
+}
+
+func resourceDirectorySyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+
+	var keys []string
+
+	for k, v := range d.Get("files").(map[string]interface{}) {
+		// Orphaned objects were never uploaded by this resource.
+		if v.(string) != "" {
+			keys = append(keys, k)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Directory Sync: %s", d.Id())
+	if err := directorySyncDeleteKeys(ctx, conn, d.Get("bucket").(string), d.Get("key_prefix").(string), keys); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// resourceDirectorySyncCustomizeDiff plans the upload of new and modified files by comparing the
+// manifest of the source directory with the manifest in state.
+func resourceDirectorySyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("exclude") {
+		return d.SetNewComputed("files")
+	}
+
+	excludes := flex.ExpandStringValueSet(d.Get("exclude").(*schema.Set))
+	manifest, err := newDirectorySyncManifest(d.Get("source_dir").(string), excludes)
+
+	if err != nil {
+		return err
+	}
+
+	new := manifest.hashes()
+	old := d.Get("files").(map[string]interface{})
+
+	if d.Id() != "" && len(new) == len(old) {
+		changed := false
+
+		for k, v := range old {
+			if new[k] != v.(string) {
+				changed = true
+				break
+			}
+		}
+
+		if !changed {
+			return nil
+		}
+	}
+
+	return d.SetNew("files", new)
+}
+
+func directorySyncCreateResourceID(bucket, keyPrefix string) string {
+	return fmt.Sprintf("%s/%s", bucket, directorySyncKeyPrefix(keyPrefix))
+}
+
+func directorySyncSortedKeys(manifest directorySyncManifest) []string {
+	keys := make([]string, 0, len(manifest))
+
+	for k := range manifest {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// directorySyncUploadFiles uploads the specified manifest entries, `concurrency` files at a time.
+func directorySyncUploadFiles(ctx context.Context, conn *s3.S3, d *schema.ResourceData, manifest directorySyncManifest, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	uploader := s3manager.NewUploaderWithClient(conn)
+	bucket := d.Get("bucket").(string)
+	keyPrefix := directorySyncKeyPrefix(d.Get("key_prefix").(string))
+	contentTypes := flex.ExpandStringValueMap(d.Get("content_types").(map[string]interface{}))
+	checksumAlgorithm := d.Get("checksum_algorithm").(string)
+
+	template := s3manager.UploadInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if v, ok := d.GetOk("acl"); ok {
+		template.ACL = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cache_control"); ok {
+		template.CacheControl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("metadata"); ok {
+		template.Metadata = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		template.ServerSideEncryption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		template.SSEKMSKeyId = aws.String(v.(string))
+		template.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+	}
+
+	if v, ok := d.GetOk("storage_class"); ok {
+		template.StorageClass = aws.String(v.(string))
+	}
+
+	upload := func(rel string) error {
+		file := manifest[rel]
+		key := keyPrefix + rel
+
+		contentType, err := directorySyncContentType(file.Path, contentTypes)
+
+		if err != nil {
+			return fmt.Errorf("detecting content type of %s: %w", file.Path, err)
+		}
+
+		body, err := os.Open(file.Path)
+
+		if err != nil {
+			return fmt.Errorf("opening %s: %w", file.Path, err)
+		}
+
+		defer func() {
+			if err := body.Close(); err != nil {
+				log.Printf("[WARN] Error closing S3 Directory Sync source (%s): %s", file.Path, err)
+			}
+		}()
+
+		input := template
+		input.Body = body
+		input.ContentType = aws.String(contentType)
+		input.Key = aws.String(key)
+
+		var opts []func(*s3manager.Uploader)
+
+		if checksumAlgorithm != "" {
+			// Part checksums are tracked per upload.
+			opts = append(opts, func(u *s3manager.Uploader) {
+				u.RequestOptions = append(u.RequestOptions[:len(u.RequestOptions):len(u.RequestOptions)], newObjectChecksumRequestOptions(checksumAlgorithm).apply)
+			})
+		}
+
+		log.Printf("[DEBUG] Uploading S3 Directory Sync file (%s) to s3://%s/%s", file.Path, bucket, key)
+		if _, err := uploader.UploadWithContext(ctx, &input, opts...); err != nil {
+			return fmt.Errorf("uploading object (%s): %w", key, err)
+		}
+
+		return nil
+	}
+
+	var (
+		errs *multierror.Error
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+	)
+
+	sem := make(chan struct{}, d.Get("concurrency").(int))
+
+	for _, rel := range keys {
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func(rel string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := upload(rel); err != nil {
+				mu.Lock()
+				errs = multierror.Append(errs, err)
+				mu.Unlock()
+			}
+		}(rel)
+	}
+
+	wg.Wait()
+
+	return errs.ErrorOrNil()
+}
+
+// directorySyncDeleteOrphans deletes all objects under the key prefix that are neither in the manifest nor excluded,
+// along with any additional keys.
+func directorySyncDeleteOrphans(ctx context.Context, conn *s3.S3, bucket, keyPrefix string, excludes []string, manifest directorySyncManifest, additional []string) error {
+	keys, err := findDirectorySyncObjectKeys(ctx, conn, bucket, keyPrefix)
+
+	if err != nil {
+		return err
+	}
+
+	orphans := additional
+
+	for k := range keys {
+		if _, ok := manifest[k]; !ok && !directorySyncExcluded(k, excludes) {
+			orphans = append(orphans, k)
+		}
+	}
+
+	return directorySyncDeleteKeys(ctx, conn, bucket, keyPrefix, orphans)
+}
+
+// directorySyncDeleteKeys deletes the objects with the specified keys, relative to the key prefix, in batches.
+func directorySyncDeleteKeys(ctx context.Context, conn *s3.S3, bucket, keyPrefix string, keys []string) error {
+	keyPrefix = directorySyncKeyPrefix(keyPrefix)
+
+	seen := make(map[string]struct{}, len(keys))
+	toDelete := make([]*s3.ObjectIdentifier, 0, len(keys))
+
+	for _, k := range keys {
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+
+		toDelete = append(toDelete, &s3.ObjectIdentifier{
+			Key: aws.String(keyPrefix + k),
+		})
+	}
+
+	for len(toDelete) > 0 {
+		n := len(toDelete)
+		if n > directorySyncDeleteBatchSize {
+			n = directorySyncDeleteBatchSize
+		}
+
+		if _, err := deleteObjects(ctx, conn, bucket, false, toDelete[:n]); err != nil {
+			return err
+		}
+
+		toDelete = toDelete[n:]
+	}
+
+	return nil
+}
+
+// findDirectorySyncObjectKeys returns the keys, relative to the key prefix, of all objects under the key prefix.
+func findDirectorySyncObjectKeys(ctx context.Context, conn *s3.S3, bucket, keyPrefix string) (map[string]struct{}, error) {
+	keyPrefix = directorySyncKeyPrefix(keyPrefix)
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	if keyPrefix != "" {
+		input.Prefix = aws.String(keyPrefix)
+	}
+
+	objects, err := tfresource.FindPtrs(ctx, tfresource.Finder[*s3.Object]{
+		List: tfresource.ListPages(conn.ListObjectsV2PagesWithContext, input, func(page *s3.ListObjectsV2Output) []*s3.Object {
+			return page.Contents
+		}),
+		// Directory placeholder objects aren't synchronized.
+		Filter: func(v *s3.Object) bool {
+			return !strings.HasSuffix(aws.StringValue(v.Key), "/")
+		},
+		NotFound:    tfresource.NotFoundErrCodes(s3.ErrCodeNoSuchBucket),
+		LastRequest: input,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]struct{}, len(objects))
+
+	for _, v := range objects {
+		keys[strings.TrimPrefix(aws.StringValue(v.Key), keyPrefix)] = struct{}{}
+	}
+
+	return keys, nil
+}
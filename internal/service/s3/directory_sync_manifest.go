@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/mitchellh/go-homedir"
+)
+
+// directorySyncFile is a single local file synchronized to S3.
+type directorySyncFile struct {
+	// Path is the file's local filesystem path.
+	Path string
+	// Hash is the hex-encoded MD5 digest of the file's content.
+	Hash string
+}
+
+// directorySyncManifest maps the slash-separated path of each file, relative to the source directory, to the file.
+type directorySyncManifest map[string]directorySyncFile
+
+// hashes returns the manifest as stored in state, a map of relative path to content hash.
+func (m directorySyncManifest) hashes() map[string]string {
+	hashes := make(map[string]string, len(m))
+
+	for k, v := range m {
+		hashes[k] = v.Hash
+	}
+
+	return hashes
+}
+
+// newDirectorySyncManifest walks the source directory, hashing every regular file not matching an exclude pattern.
+func newDirectorySyncManifest(sourceDir string, excludes []string) (directorySyncManifest, error) {
+	root, err := homedir.Expand(sourceDir)
+
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source_dir (%s): %w", sourceDir, err)
+	}
+
+	manifest := make(directorySyncManifest)
+
+	err = filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, p)
+
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		if d.IsDir() {
+			if rel != "." && directorySyncExcluded(rel, excludes) {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if directorySyncExcluded(rel, excludes) {
+			return nil
+		}
+
+		// Follow symbolic links to files, skip anything else that isn't a regular file.
+		fi, err := os.Stat(p)
+
+		if err != nil {
+			return err
+		}
+
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+
+		hash, err := directorySyncFileHash(p)
+
+		if err != nil {
+			return err
+		}
+
+		manifest[rel] = directorySyncFile{
+			Path: p,
+			Hash: hash,
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source_dir (%s): %w", sourceDir, err)
+	}
+
+	return manifest, nil
+}
+
+func directorySyncFileHash(path string) (string, error) {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer f.Close()
+
+	h := md5.New()
+
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// directorySyncExcluded returns whether the slash-separated relative path matches any of the exclude patterns.
+// Patterns are matched against both the full relative path and the path's base name.
+func directorySyncExcluded(rel string, excludes []string) bool {
+	for _, pattern := range excludes {
+		if ok, _ := path.Match(pattern, rel); ok {
+			return true
+		}
+
+		if ok, _ := path.Match(pattern, path.Base(rel)); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// directorySyncKeyPrefix normalizes a key prefix so that it only matches whole path segments.
+func directorySyncKeyPrefix(keyPrefix string) string {
+	if keyPrefix == "" || strings.HasSuffix(keyPrefix, "/") {
+		return keyPrefix
+	}
+
+	return keyPrefix + "/"
+}
+
+// directorySyncContentType returns the MIME type of the specified file.
+// Explicit mappings from file extension are consulted first, then the system MIME type tables,
+// and finally the content type is sniffed from the file's first 512 bytes.
+func directorySyncContentType(path string, contentTypes map[string]string) (string, error) {
+	ext := strings.ToLower(filepath.Ext(path))
+
+	for k, v := range contentTypes {
+		if k = strings.ToLower(k); !strings.HasPrefix(k, ".") {
+			k = "." + k
+		}
+
+		if k == ext {
+			return v, nil
+		}
+	}
+
+	if v := mime.TypeByExtension(ext); v != "" {
+		return v, nil
+	}
+
+	f, err := os.Open(path)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer f.Close()
+
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(f, buf)
+
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+
+	return http.DetectContentType(buf[:n]), nil
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewDirectorySyncManifest(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	for name, content := range map[string]string{
+		"index.html":        "<html></html>",
+		"css/site.css":      "body {}",
+		"js/app.js":         "",
+		"js/app.js.map":     "{}",
+		"node_modules/x.js": "x",
+	} {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	manifest, err := newDirectorySyncManifest(dir, []string{"*.map", "node_modules"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"index.html":   "c83301425b2ad1d496473a5ff3d9ecca",
+		"css/site.css": "fcdce6b6d6e2175f6406869882f6f1ce",
+		"js/app.js":    "d41d8cd98f00b204e9800998ecf8427e",
+	}
+
+	if diff := cmp.Diff(manifest.hashes(), want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestDirectorySyncExcluded(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rel      string
+		excludes []string
+		want     bool
+	}{
+		"no patterns": {
+			rel:  "index.html",
+			want: false,
+		},
+		"base name": {
+			rel:      "js/app.js.map",
+			excludes: []string{"*.map"},
+			want:     true,
+		},
+		"full path": {
+			rel:      "drafts/post.html",
+			excludes: []string{"drafts/*"},
+			want:     true,
+		},
+		"no match": {
+			rel:      "posts/post.html",
+			excludes: []string{"drafts/*", "*.map"},
+			want:     false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := directorySyncExcluded(testCase.rel, testCase.excludes); got != testCase.want {
+				t.Errorf("directorySyncExcluded(%q) = %t, want %t", testCase.rel, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestDirectorySyncKeyPrefix(t *testing.T) {
+	t.Parallel()
+
+	for input, want := range map[string]string{
+		"":      "",
+		"site":  "site/",
+		"site/": "site/",
+		"a/b":   "a/b/",
+	} {
+		if got := directorySyncKeyPrefix(input); got != want {
+			t.Errorf("directorySyncKeyPrefix(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestDirectorySyncContentType(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	for name, content := range map[string]string{
+		"index.html":   "<html></html>",
+		"module.wasm":  "\x00asm",
+		"LICENSE":      "plain text",
+		"unknown.blob": "<!DOCTYPE html><html></html>",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	contentTypes := map[string]string{
+		"WASM": "application/wasm",
+	}
+
+	testCases := map[string]string{
+		"index.html":   "text/html; charset=utf-8",
+		"module.wasm":  "application/wasm",
+		"LICENSE":      "text/plain; charset=utf-8",
+		"unknown.blob": "text/html; charset=utf-8",
+	}
+
+	for name, want := range testCases {
+		got, err := directorySyncContentType(filepath.Join(dir, name), contentTypes)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got != want {
+			t.Errorf("directorySyncContentType(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := t.TempDir()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccDirectorySyncWriteFiles(t, dir, map[string]string{
+						"index.html":   "<html></html>",
+						"css/site.css": "body {}",
+					})
+				},
+				Config: testAccDirectorySyncConfig_basic(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "files.index.html", "c83301425b2ad1d496473a5ff3d9ecca"),
+					resource.TestCheckResourceAttr(resourceName, "files.css/site.css", "fcdce6b6d6e2175f6406869882f6f1ce"),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site"),
+					testAccCheckDirectorySyncObjectContentType(ctx, resourceName, "site/index.html", "text/html; charset=utf-8"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDirectorySyncWriteFiles(t, dir, map[string]string{
+						"index.html": "<html><body></body></html>",
+					})
+					if err := os.RemoveAll(filepath.Join(dir, "css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_basic(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "files.index.html", "b256d97fbb697428b7a1286ea33539c0"),
+					testAccCheckDirectorySyncObjectNotExists(ctx, resourceName, "site/css/site.css"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_deleteOrphans(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := t.TempDir()
+
+	testAccDirectorySyncWriteFiles(t, dir, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_deleteOrphans(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delete_orphans", "true"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+					testAccCheckDirectorySyncPutObject(ctx, resourceName, "site/orphan.txt"),
+					testAccCheckDirectorySyncPutObject(ctx, resourceName, "site/debug.log"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccDirectorySyncConfig_deleteOrphans(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+					testAccCheckDirectorySyncObjectNotExists(ctx, resourceName, "site/orphan.txt"),
+					// Excluded keys aren't orphans.
+					testAccCheckDirectorySyncObjectExists(ctx, resourceName, "site/debug.log"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDirectorySyncDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_directory_sync" {
+				continue
+			}
+
+			for _, key := range testAccDirectorySyncKeys(rs) {
+				_, err := tfs3.FindObjectByThreePartKeyV1(ctx, conn, rs.Primary.Attributes["bucket"], key, "")
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("S3 Directory Sync %s object (%s) still exists", rs.Primary.ID, key)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn(ctx)
+
+		for _, key := range testAccDirectorySyncKeys(rs) {
+			if _, err := tfs3.FindObjectByThreePartKeyV1(ctx, conn, rs.Primary.Attributes["bucket"], key, ""); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObjectExists(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn(ctx)
+
+		_, err := tfs3.FindObjectByThreePartKeyV1(ctx, conn, rs.Primary.Attributes["bucket"], key, "")
+
+		return err
+	}
+}
+
+func testAccCheckDirectorySyncObjectNotExists(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn(ctx)
+
+		_, err := tfs3.FindObjectByThreePartKeyV1(ctx, conn, rs.Primary.Attributes["bucket"], key, "")
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Object (%s) still exists", key)
+	}
+}
+
+func testAccCheckDirectorySyncObjectContentType(ctx context.Context, n, key, contentType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn(ctx)
+
+		output, err := tfs3.FindObjectByThreePartKeyV1(ctx, conn, rs.Primary.Attributes["bucket"], key, "")
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.StringValue(output.ContentType); got != contentType {
+			return fmt.Errorf("S3 Object (%s) content type = %q, want %q", key, got, contentType)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckDirectorySyncPutObject creates an object outside of Terraform.
+func testAccCheckDirectorySyncPutObject(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn(ctx)
+
+		_, err := conn.PutObjectWithContext(ctx, &s3.PutObjectInput{
+			Body:   strings.NewReader("orphan"),
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		return err
+	}
+}
+
+// testAccDirectorySyncKeys returns the object keys of all files in the resource's manifest.
+func testAccDirectorySyncKeys(rs *terraform.ResourceState) []string {
+	var keys []string
+
+	keyPrefix := rs.Primary.Attributes["key_prefix"]
+	if keyPrefix != "" && !strings.HasSuffix(keyPrefix, "/") {
+		keyPrefix += "/"
+	}
+
+	for k := range rs.Primary.Attributes {
+		if rel, ok := strings.CutPrefix(k, "files."); ok && rel != "%" {
+			keys = append(keys, keyPrefix+rel)
+		}
+	}
+
+	return keys
+}
+
+func testAccDirectorySyncWriteFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccDirectorySyncConfig_basic(rName, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site"
+  source_dir = %[2]q
+}
+`, rName, dir)
+}
+
+func testAccDirectorySyncConfig_deleteOrphans(rName, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  key_prefix     = "site/"
+  source_dir     = %[2]q
+  delete_orphans = true
+  exclude        = ["*.log"]
+}
+`, rName, dir)
+}
@@ -76,6 +76,32 @@ func ResourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"checksum_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ChecksumAlgorithm_Values(), false),
+			},
+			"checksum_crc32": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_crc32c": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha1": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"content": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -157,6 +183,11 @@ func ResourceObject() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IsRFC3339Time,
 			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(int(s3manager.MinUploadPartSize)),
+			},
 			"server_side_encryption": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -203,8 +234,18 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
+	input := &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	// Checksums are only returned when requested and reading them may require additional (KMS) permissions.
+	if _, ok := d.GetOk("checksum_algorithm"); ok {
+		input.ChecksumMode = aws.String(s3.ChecksumModeEnabled)
+	}
+
 	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, objectCreationTimeout, func() (interface{}, error) {
-		return FindObjectByThreePartKeyV1(ctx, conn, bucket, key, "")
+		return findObjectV1(ctx, conn, input)
 	}, d.IsNewResource())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -221,6 +262,10 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	d.Set("bucket_key_enabled", output.BucketKeyEnabled)
 	d.Set("cache_control", output.CacheControl)
+	d.Set("checksum_crc32", output.ChecksumCRC32)
+	d.Set("checksum_crc32c", output.ChecksumCRC32C)
+	d.Set("checksum_sha1", output.ChecksumSHA1)
+	d.Set("checksum_sha256", output.ChecksumSHA256)
 	d.Set("content_disposition", output.ContentDisposition)
 	d.Set("content_encoding", output.ContentEncoding)
 	d.Set("content_language", output.ContentLanguage)
@@ -512,7 +557,19 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.ObjectLockRetainUntilDate = expandObjectDate(v.(string))
 	}
 
-	if _, err := uploader.Upload(input); err != nil {
+	if v, ok := d.GetOk("concurrency"); ok {
+		uploader.Concurrency = v.(int)
+	}
+
+	if v, ok := d.GetOk("part_size"); ok {
+		uploader.PartSize = int64(v.(int))
+	}
+
+	if v, ok := d.GetOk("checksum_algorithm"); ok {
+		uploader.RequestOptions = append(uploader.RequestOptions, newObjectChecksumRequestOptions(v.(string)).apply)
+	}
+
+	if _, err := uploader.UploadWithContext(ctx, input); err != nil {
 		return sdkdiag.AppendErrorf(diags, "uploading object to S3 bucket (%s): %s", bucket, err)
 	}
 
@@ -553,7 +610,17 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 }
 
 func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := resourceObjectChecksumCustomizeDiff(d); err != nil {
+		return err
+	}
+
 	if hasObjectContentChanges(d) {
+		for _, key := range []string{"checksum_crc32", "checksum_crc32c", "checksum_sha1", "checksum_sha256"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+
 		return d.SetNewComputed("version_id")
 	}
 
@@ -565,10 +632,54 @@ func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta
 	return nil
 }
 
+// resourceObjectChecksumCustomizeDiff detects changes to the object's content, locally or in S3, by
+// comparing the checksum of the configured content with the checksum of the object in S3.
+func resourceObjectChecksumCustomizeDiff(d *schema.ResourceDiff) error {
+	if d.Id() == "" || d.HasChange("checksum_algorithm") {
+		return nil
+	}
+
+	algorithm := d.Get("checksum_algorithm").(string)
+
+	if algorithm == "" {
+		return nil
+	}
+
+	key := objectChecksumAttributes[algorithm]
+	old := d.Get(key).(string)
+
+	if old == "" {
+		return nil
+	}
+
+	checksum, err := objectContentChecksum(d, algorithm)
+
+	if err != nil {
+		return fmt.Errorf("calculating S3 object %s checksum: %w", algorithm, err)
+	}
+
+	if checksum == "" || objectChecksumEqual(old, checksum) {
+		return nil
+	}
+
+	log.Printf("[DEBUG] S3 object %s checksum changed: %s => %s", algorithm, old, checksum)
+
+	if err := d.SetNewComputed("etag"); err != nil {
+		return err
+	}
+
+	return d.SetNewComputed(key)
+}
+
 func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 	for _, key := range []string{
 		"bucket_key_enabled",
 		"cache_control",
+		"checksum_algorithm",
+		"checksum_crc32",
+		"checksum_crc32c",
+		"checksum_sha1",
+		"checksum_sha256",
 		"content_base64",
 		"content_disposition",
 		"content_encoding",
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mitchellh/go-homedir"
+)
+
+// objectChecksumAttributes maps each S3 checksum algorithm to the attribute holding the object's checksum.
+var objectChecksumAttributes = map[string]string{
+	s3.ChecksumAlgorithmCrc32:  "checksum_crc32",
+	s3.ChecksumAlgorithmCrc32c: "checksum_crc32c",
+	s3.ChecksumAlgorithmSha1:   "checksum_sha1",
+	s3.ChecksumAlgorithmSha256: "checksum_sha256",
+}
+
+func newObjectChecksumHash(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		return crc32.NewIEEE(), nil
+	case s3.ChecksumAlgorithmCrc32c:
+		return crc32.New(crc32.MakeTable(crc32.Castagnoli)), nil
+	case s3.ChecksumAlgorithmSha1:
+		return sha1.New(), nil
+	case s3.ChecksumAlgorithmSha256:
+		return sha256.New(), nil
+	default:
+		return nil, fmt.Errorf("unsupported checksum algorithm: %s", algorithm)
+	}
+}
+
+// objectUploadPartSize returns the part size used by the S3 upload manager for an object of the specified size.
+func objectUploadPartSize(size, partSize int64) int64 {
+	if partSize == 0 {
+		partSize = s3manager.DefaultUploadPartSize
+	}
+
+	// See s3manager's (*uploader).initSize.
+	if size/partSize >= s3manager.MaxUploadParts {
+		partSize = (size / s3manager.MaxUploadParts) + 1
+	}
+
+	return partSize
+}
+
+// objectChecksum returns the checksum that S3 reports for an object with the specified content uploaded using the S3 upload manager.
+// Objects uploaded in a single part have a checksum of the full content.
+// Objects uploaded in multiple parts have a checksum of the concatenated part checksums, suffixed with the number of parts.
+func objectChecksum(algorithm string, r io.Reader, size, partSize int64) (string, error) {
+	h, err := newObjectChecksumHash(algorithm)
+
+	if err != nil {
+		return "", err
+	}
+
+	partSize = objectUploadPartSize(size, partSize)
+
+	if size <= partSize {
+		if _, err := io.Copy(h, r); err != nil {
+			return "", err
+		}
+
+		return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+	}
+
+	var n int64
+	for ; n*partSize < size; n++ {
+		part, _ := newObjectChecksumHash(algorithm)
+
+		if _, err := io.CopyN(part, r, partSize); err != nil && err != io.EOF {
+			return "", err
+		}
+
+		h.Write(part.Sum(nil))
+	}
+
+	return fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(h.Sum(nil)), n), nil
+}
+
+// objectChecksumEqual returns whether two object checksums are equal, ignoring any part count suffix.
+func objectChecksumEqual(a, b string) bool {
+	a, _, _ = strings.Cut(a, "-")
+	b, _, _ = strings.Cut(b, "-")
+
+	return a == b
+}
+
+// objectContentChecksum returns the checksum of the object content configured in source, content or content_base64.
+// An empty checksum is returned if the content is not known.
+func objectContentChecksum(d *schema.ResourceDiff, algorithm string) (string, error) {
+	for _, key := range []string{"source", "content", "content_base64"} {
+		if !d.NewValueKnown(key) {
+			return "", nil
+		}
+	}
+
+	partSize := int64(d.Get("part_size").(int))
+
+	if v, ok := d.GetOk("source"); ok {
+		path, err := homedir.Expand(v.(string))
+
+		if err != nil {
+			return "", err
+		}
+
+		file, err := os.Open(path)
+
+		if os.IsNotExist(err) {
+			// The source may be created during apply.
+			return "", nil
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		defer file.Close()
+
+		info, err := file.Stat()
+
+		if err != nil {
+			return "", err
+		}
+
+		return objectChecksum(algorithm, file, info.Size(), partSize)
+	}
+
+	var content []byte
+
+	if v, ok := d.GetOk("content"); ok {
+		content = []byte(v.(string))
+	} else if v, ok := d.GetOk("content_base64"); ok {
+		var err error
+
+		content, err = base64.StdEncoding.DecodeString(v.(string))
+
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return objectChecksum(algorithm, bytes.NewReader(content), int64(len(content)), partSize)
+}
+
+// objectChecksumRequestOptions adds checksums of the specified algorithm to the S3 upload manager's requests.
+// The upload manager doesn't calculate checksums itself and doesn't include part checksums in multipart uploads.
+type objectChecksumRequestOptions struct {
+	algorithm string
+	mu        sync.Mutex
+	parts     map[int64]string
+}
+
+func newObjectChecksumRequestOptions(algorithm string) *objectChecksumRequestOptions {
+	return &objectChecksumRequestOptions{
+		algorithm: algorithm,
+		parts:     make(map[int64]string),
+	}
+}
+
+func (o *objectChecksumRequestOptions) apply(r *request.Request) {
+	switch input := r.Params.(type) {
+	case *s3.PutObjectInput:
+		checksum, err := o.checksum(input.Body)
+
+		if err != nil {
+			r.Error = err
+			return
+		}
+
+		input.ChecksumAlgorithm = aws.String(o.algorithm)
+		setObjectChecksum(o.algorithm, checksum, &input.ChecksumCRC32, &input.ChecksumCRC32C, &input.ChecksumSHA1, &input.ChecksumSHA256)
+
+	case *s3.CreateMultipartUploadInput:
+		input.ChecksumAlgorithm = aws.String(o.algorithm)
+
+	case *s3.UploadPartInput:
+		checksum, err := o.checksum(input.Body)
+
+		if err != nil {
+			r.Error = err
+			return
+		}
+
+		o.mu.Lock()
+		o.parts[aws.Int64Value(input.PartNumber)] = checksum
+		o.mu.Unlock()
+
+		input.ChecksumAlgorithm = aws.String(o.algorithm)
+		setObjectChecksum(o.algorithm, checksum, &input.ChecksumCRC32, &input.ChecksumCRC32C, &input.ChecksumSHA1, &input.ChecksumSHA256)
+
+	case *s3.CompleteMultipartUploadInput:
+		if input.MultipartUpload == nil {
+			return
+		}
+
+		o.mu.Lock()
+		defer o.mu.Unlock()
+
+		for _, part := range input.MultipartUpload.Parts {
+			setObjectChecksum(o.algorithm, o.parts[aws.Int64Value(part.PartNumber)], &part.ChecksumCRC32, &part.ChecksumCRC32C, &part.ChecksumSHA1, &part.ChecksumSHA256)
+		}
+	}
+}
+
+func (o *objectChecksumRequestOptions) checksum(body io.ReadSeeker) (string, error) {
+	h, err := newObjectChecksumHash(o.algorithm)
+
+	if err != nil {
+		return "", err
+	}
+
+	if body != nil {
+		if _, err := io.Copy(h, body); err != nil {
+			return "", err
+		}
+
+		if _, err := body.Seek(0, io.SeekStart); err != nil {
+			return "", err
+		}
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+func setObjectChecksum(algorithm, checksum string, checksumCRC32, checksumCRC32C, checksumSHA1, checksumSHA256 **string) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		*checksumCRC32 = aws.String(checksum)
+	case s3.ChecksumAlgorithmCrc32c:
+		*checksumCRC32C = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha1:
+		*checksumSHA1 = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha256:
+		*checksumSHA256 = aws.String(checksum)
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func TestObjectChecksum(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		algorithm string
+		content   string
+		partSize  int64
+		want      string
+	}{
+		"empty": {
+			algorithm: s3.ChecksumAlgorithmSha256,
+			want:      "47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=",
+		},
+		"CRC32": {
+			algorithm: s3.ChecksumAlgorithmCrc32,
+			content:   "hello",
+			want:      "NhCmhg==",
+		},
+		"CRC32C": {
+			algorithm: s3.ChecksumAlgorithmCrc32c,
+			content:   "hello",
+			want:      "mnG7TA==",
+		},
+		"SHA1": {
+			algorithm: s3.ChecksumAlgorithmSha1,
+			content:   "hello",
+			want:      "qvTGHdzF6KLavt4PO0gs2a6pQ00=",
+		},
+		"SHA256": {
+			algorithm: s3.ChecksumAlgorithmSha256,
+			content:   "hello",
+			want:      "LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ=",
+		},
+		"single part": {
+			algorithm: s3.ChecksumAlgorithmSha256,
+			content:   "hello",
+			partSize:  5,
+			want:      "LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ=",
+		},
+		"multipart CRC32": {
+			algorithm: s3.ChecksumAlgorithmCrc32,
+			content:   "hello",
+			partSize:  2,
+			want:      "ZyGEXA==-3",
+		},
+		"multipart SHA256": {
+			algorithm: s3.ChecksumAlgorithmSha256,
+			content:   "hello",
+			partSize:  2,
+			want:      "KFwuGXYdnc6xajhsSKJpNBr5BQfGpYUtTq6HcuyhlF4=-3",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := objectChecksum(testCase.algorithm, strings.NewReader(testCase.content), int64(len(testCase.content)), testCase.partSize)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %s, want %s", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestObjectUploadPartSize(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		size     int64
+		partSize int64
+		want     int64
+	}{
+		{size: 1024, want: 5 * 1024 * 1024},
+		{size: 1024, partSize: 8 * 1024 * 1024, want: 8 * 1024 * 1024},
+		{size: 10000 * 5 * 1024 * 1024, want: 5*1024*1024 + 1},
+	}
+
+	for _, testCase := range testCases {
+		if got := objectUploadPartSize(testCase.size, testCase.partSize); got != testCase.want {
+			t.Errorf("objectUploadPartSize(%d, %d) = %d, want %d", testCase.size, testCase.partSize, got, testCase.want)
+		}
+	}
+}
+
+func TestObjectChecksumEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		a, b string
+		want bool
+	}{
+		{a: "NhCmhg==", b: "NhCmhg==", want: true},
+		{a: "ZyGEXA==-3", b: "ZyGEXA==", want: true},
+		{a: "ZyGEXA==-3", b: "NhCmhg==", want: false},
+	}
+
+	for _, testCase := range testCases {
+		if got := objectChecksumEqual(testCase.a, testCase.b); got != testCase.want {
+			t.Errorf("objectChecksumEqual(%q, %q) = %t, want %t", testCase.a, testCase.b, got, testCase.want)
+		}
+	}
+}
+
+func TestObjectChecksumRequestOptions(t *testing.T) {
+	t.Parallel()
+
+	options := newObjectChecksumRequestOptions(s3.ChecksumAlgorithmCrc32)
+
+	for i, content := range []string{"he", "llo"} {
+		input := &s3.UploadPartInput{
+			Body:       strings.NewReader(content),
+			PartNumber: aws.Int64(int64(i + 1)),
+		}
+		options.apply(&request.Request{Params: input})
+
+		if got, want := aws.StringValue(input.ChecksumAlgorithm), s3.ChecksumAlgorithmCrc32; got != want {
+			t.Errorf("part %d ChecksumAlgorithm = %s, want %s", i+1, got, want)
+		}
+
+		if input.ChecksumCRC32 == nil {
+			t.Errorf("part %d ChecksumCRC32 not set", i+1)
+		}
+	}
+
+	input := &s3.CompleteMultipartUploadInput{
+		MultipartUpload: &s3.CompletedMultipartUpload{
+			Parts: []*s3.CompletedPart{
+				{PartNumber: aws.Int64(1)},
+				{PartNumber: aws.Int64(2)},
+			},
+		},
+	}
+	options.apply(&request.Request{Params: input})
+
+	for i, want := range []string{"0SVmhw==", "qsmzNA=="} {
+		if got := aws.StringValue(input.MultipartUpload.Parts[i].ChecksumCRC32); got != want {
+			t.Errorf("part %d ChecksumCRC32 = %s, want %s", i+1, got, want)
+		}
+	}
+}
@@ -7,14 +7,17 @@
 package scheduler
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -25,11 +28,13 @@ func init() {
 			"aws_scheduler_schedule",
 		},
 	})
+	discovery.Register("aws_scheduler_schedule_group", listScheduleGroups)
 
 	resource.AddTestSweepers("aws_scheduler_schedule", &resource.Sweeper{
 		Name: "aws_scheduler_schedule",
 		F:    sweepSchedules,
 	})
+	discovery.Register("aws_scheduler_schedule", listSchedules)
 }
 
 func sweepScheduleGroups(region string) error {
@@ -40,6 +45,25 @@ func sweepScheduleGroups(region string) error {
 		return fmt.Errorf("getting client: %w", err)
 	}
 
+	sweepResources, err := listScheduleGroups(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Schedule Group sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing Schedule Groups for %s: %w", region, err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("sweeping Schedule Group for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listScheduleGroups(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.SchedulerClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
@@ -47,12 +71,8 @@ func sweepScheduleGroups(region string) error {
 
 	for paginator.HasMorePages() {
 		page, err := paginator.NextPage(ctx)
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Schedule Group sweep for %s: %s", region, err)
-			return nil
-		}
 		if err != nil {
-			return fmt.Errorf("listing Schedule Groups for %s: %w", region, err)
+			return nil, err
 		}
 
 		for _, it := range page.ScheduleGroups {
@@ -71,11 +91,7 @@ func sweepScheduleGroups(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("sweeping Schedule Group for %s: %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepSchedules(region string) error {
@@ -86,6 +102,25 @@ func sweepSchedules(region string) error {
 		return fmt.Errorf("getting client: %w", err)
 	}
 
+	sweepResources, err := listSchedules(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Schedule sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing Schedules for %s: %w", region, err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("sweeping Schedule for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listSchedules(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.SchedulerClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
@@ -93,12 +128,8 @@ func sweepSchedules(region string) error {
 
 	for paginator.HasMorePages() {
 		page, err := paginator.NextPage(ctx)
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Schedule sweep for %s: %s", region, err)
-			return nil
-		}
 		if err != nil {
-			return fmt.Errorf("listing Schedules for %s: %w", region, err)
+			return nil, err
 		}
 
 		for _, it := range page.Schedules {
@@ -113,9 +144,5 @@ func sweepSchedules(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("sweeping Schedule for %s: %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Custom Log Source")
+func newResourceCustomLogSource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceCustomLogSource{}, nil
+}
+
+type resourceCustomLogSource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceCustomLogSource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_custom_log_source"
+}
+
+func (r *resourceCustomLogSource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"attributes": schema.ListAttribute{
+				ElementType: types.ObjectType{AttrTypes: flex.AttributeTypesMust[customLogSourceAttributesModel](ctx)},
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"event_classes": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"provider_details": schema.ListAttribute{
+				ElementType: types.ObjectType{AttrTypes: flex.AttributeTypesMust[customLogSourceProviderModel](ctx)},
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 20),
+				},
+			},
+			"source_version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customLogSourceConfigurationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"crawler_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[customLogSourceCrawlerConfigurationModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"role_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						"provider_identity": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[awsIdentityModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"external_id": schema.StringAttribute{
+										Required: true,
+									},
+									"principal": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceCustomLogSource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceCustomLogSourceData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.CreateCustomLogSourceInput{
+		Configuration: data.expandConfiguration(ctx),
+		EventClasses:  flex.ExpandFrameworkStringValueSet(ctx, data.EventClasses),
+		SourceName:    flex.StringFromFramework(ctx, data.SourceName),
+		SourceVersion: flex.StringFromFramework(ctx, data.SourceVersion),
+	}
+
+	output, err := conn.CreateCustomLogSource(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Lake Custom Log Source (%s)", data.SourceName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = data.SourceName
+	data.flatten(ctx, output.Source)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCustomLogSource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceCustomLogSourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	source, err := findCustomLogSourceBySourceName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Custom Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The configuration and event classes aren't returned by the API.
+	data.flatten(ctx, source)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCustomLogSource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Noop.
+}
+
+func (r *resourceCustomLogSource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceCustomLogSourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	_, err := conn.DeleteCustomLogSource(ctx, &securitylake.DeleteCustomLogSourceInput{
+		SourceName:    flex.StringFromFramework(ctx, data.ID),
+		SourceVersion: flex.StringFromFramework(ctx, data.SourceVersion),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Custom Log Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type resourceCustomLogSourceData struct {
+	Attributes      types.List                                                         `tfsdk:"attributes"`
+	Configuration   fwtypes.ListNestedObjectValueOf[customLogSourceConfigurationModel] `tfsdk:"configuration"`
+	EventClasses    types.Set                                                          `tfsdk:"event_classes"`
+	ID              types.String                                                       `tfsdk:"id"`
+	ProviderDetails types.List                                                         `tfsdk:"provider_details"`
+	SourceName      types.String                                                       `tfsdk:"source_name"`
+	SourceVersion   types.String                                                       `tfsdk:"source_version"`
+}
+
+type customLogSourceConfigurationModel struct {
+	CrawlerConfiguration fwtypes.ListNestedObjectValueOf[customLogSourceCrawlerConfigurationModel] `tfsdk:"crawler_configuration"`
+	ProviderIdentity     fwtypes.ListNestedObjectValueOf[awsIdentityModel]                         `tfsdk:"provider_identity"`
+}
+
+type customLogSourceCrawlerConfigurationModel struct {
+	RoleARN fwtypes.ARN `tfsdk:"role_arn"`
+}
+
+type awsIdentityModel struct {
+	ExternalID types.String `tfsdk:"external_id"`
+	Principal  types.String `tfsdk:"principal"`
+}
+
+type customLogSourceAttributesModel struct {
+	CrawlerARN  types.String `tfsdk:"crawler_arn"`
+	DatabaseARN types.String `tfsdk:"database_arn"`
+	TableARN    types.String `tfsdk:"table_arn"`
+}
+
+type customLogSourceProviderModel struct {
+	Location types.String `tfsdk:"location"`
+	RoleARN  types.String `tfsdk:"role_arn"`
+}
+
+func (data *resourceCustomLogSourceData) expandConfiguration(ctx context.Context) *awstypes.CustomLogSourceConfiguration {
+	configuration := nestedObjectPtr(ctx, data.Configuration)
+
+	if configuration == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.CustomLogSourceConfiguration{}
+
+	if data := nestedObjectPtr(ctx, configuration.CrawlerConfiguration); data != nil {
+		apiObject.CrawlerConfiguration = &awstypes.CustomLogSourceCrawlerConfiguration{
+			RoleArn: flex.ARNStringFromFramework(ctx, data.RoleARN),
+		}
+	}
+
+	apiObject.ProviderIdentity = expandAWSIdentity(ctx, configuration.ProviderIdentity)
+
+	return apiObject
+}
+
+func (data *resourceCustomLogSourceData) flatten(ctx context.Context, apiObject *awstypes.CustomLogSourceResource) {
+	if apiObject == nil {
+		return
+	}
+
+	var attributes []*awstypes.CustomLogSourceAttributes
+	if v := apiObject.Attributes; v != nil {
+		attributes = append(attributes, v)
+	}
+
+	data.Attributes = flex.FlattenFrameworkListNestedBlock(ctx, attributes, func(ctx context.Context, apiObject *awstypes.CustomLogSourceAttributes) customLogSourceAttributesModel {
+		return customLogSourceAttributesModel{
+			CrawlerARN:  flex.StringToFramework(ctx, apiObject.CrawlerArn),
+			DatabaseARN: flex.StringToFramework(ctx, apiObject.DatabaseArn),
+			TableARN:    flex.StringToFramework(ctx, apiObject.TableArn),
+		}
+	})
+
+	var providers []*awstypes.CustomLogSourceProvider
+	if v := apiObject.Provider; v != nil {
+		providers = append(providers, v)
+	}
+
+	data.ProviderDetails = flex.FlattenFrameworkListNestedBlock(ctx, providers, func(ctx context.Context, apiObject *awstypes.CustomLogSourceProvider) customLogSourceProviderModel {
+		return customLogSourceProviderModel{
+			Location: flex.StringToFramework(ctx, apiObject.Location),
+			RoleARN:  flex.StringToFramework(ctx, apiObject.RoleArn),
+		}
+	})
+
+	data.SourceName = flex.StringToFramework(ctx, apiObject.SourceName)
+	data.SourceVersion = flex.StringToFramework(ctx, apiObject.SourceVersion)
+}
+
+func expandAWSIdentity(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[awsIdentityModel]) *awstypes.AwsIdentity {
+	data := nestedObjectPtr(ctx, tfList)
+
+	if data == nil {
+		return nil
+	}
+
+	return &awstypes.AwsIdentity{
+		ExternalId: flex.StringFromFramework(ctx, data.ExternalID),
+		Principal:  flex.StringFromFramework(ctx, data.Principal),
+	}
+}
+
+func flattenAWSIdentity(ctx context.Context, apiObject *awstypes.AwsIdentity) fwtypes.ListNestedObjectValueOf[awsIdentityModel] {
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[awsIdentityModel](ctx)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtr(ctx, &awsIdentityModel{
+		ExternalID: types.StringValue(aws.ToString(apiObject.ExternalId)),
+		Principal:  types.StringValue(aws.ToString(apiObject.Principal)),
+	})
+}
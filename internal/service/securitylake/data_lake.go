@@ -0,0 +1,578 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Data Lake")
+// @Tags(identifierAttribute="arn")
+func newResourceDataLake(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceDataLake{}
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type resourceDataLake struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceDataLake) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_data_lake"
+}
+
+func (r *resourceDataLake) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"meta_store_manager_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"s3_bucket_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataLakeConfigurationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"region": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"encryption_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataLakeEncryptionConfigurationModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"kms_key_id": schema.StringAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						"lifecycle_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataLakeLifecycleConfigurationModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"expiration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[dataLakeLifecycleExpirationModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"days": schema.Int64Attribute{
+													Optional: true,
+												},
+											},
+										},
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+									},
+									"transition": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[dataLakeLifecycleTransitionModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"days": schema.Int64Attribute{
+													Optional: true,
+												},
+												"storage_class": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						"replication_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataLakeReplicationConfigurationModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"regions": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"role_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Optional:   true,
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceDataLake) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceDataLakeData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.CreateDataLakeInput{
+		Configurations:          expandDataLakeConfigurations(ctx, data.Configurations),
+		MetaStoreManagerRoleArn: flex.ARNStringFromFramework(ctx, data.MetaStoreManagerRoleARN),
+		Tags:                    getTagsIn(ctx),
+	}
+
+	// The meta-store manager IAM role may not yet be assumable.
+	outputRaw, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.BadRequestException](ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateDataLake(ctx, input)
+	}, "access to perform this operation")
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Security Lake Data Lake", err.Error())
+
+		return
+	}
+
+	output := outputRaw.(*securitylake.CreateDataLakeOutput)
+
+	if len(output.DataLakes) == 0 {
+		response.Diagnostics.AddError("creating Security Lake Data Lake", tfresource.NewEmptyResultError(input).Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.DataLakes[0].DataLakeArn)
+	data.ARN = types.StringValue(arn)
+	data.ID = types.StringValue(arn)
+
+	dataLake, err := waitDataLakeCreated(ctx, conn, arn, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Data Lake (%s) create", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.S3BucketARN = flex.StringToFramework(ctx, dataLake.S3BucketArn)
+	data.Configurations = flattenDataLakeConfigurations(ctx, dataLake, &response.Diagnostics)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDataLake) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceDataLakeData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	dataLake, err := findDataLakeByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Data Lake (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, dataLake.DataLakeArn)
+	data.Configurations = flattenDataLakeConfigurations(ctx, dataLake, &response.Diagnostics)
+	data.S3BucketARN = flex.StringToFramework(ctx, dataLake.S3BucketArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDataLake) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceDataLakeData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	if !new.Configurations.Equal(old.Configurations) {
+		input := &securitylake.UpdateDataLakeInput{
+			Configurations: expandDataLakeConfigurations(ctx, new.Configurations),
+		}
+
+		_, err := conn.UpdateDataLake(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Security Lake Data Lake (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		dataLake, err := waitDataLakeUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Data Lake (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.Configurations = flattenDataLakeConfigurations(ctx, dataLake, &response.Diagnostics)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceDataLake) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceDataLakeData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	// The data lake's Regions are its configurations' Regions.
+	var regions []string
+	for _, v := range expandDataLakeConfigurations(ctx, data.Configurations) {
+		regions = append(regions, aws.ToString(v.Region))
+	}
+
+	_, err := conn.DeleteDataLake(ctx, &securitylake.DeleteDataLakeInput{
+		Regions: regions,
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Data Lake (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitDataLakeDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Data Lake (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceDataLake) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func waitDataLakeCreated(ctx context.Context, conn *securitylake.Client, arn string, timeout time.Duration) (*awstypes.DataLakeResource, error) {
+	waiter := dataLakeWaiter(conn, arn, timeout, dataLakeCreateStatus)
+	waiter.Pending = []awstypes.DataLakeStatus{awstypes.DataLakeStatusInitialized, awstypes.DataLakeStatusPending}
+	waiter.Target = []awstypes.DataLakeStatus{awstypes.DataLakeStatusCompleted}
+
+	return waiter.Wait(ctx)
+}
+
+func waitDataLakeUpdated(ctx context.Context, conn *securitylake.Client, arn string, timeout time.Duration) (*awstypes.DataLakeResource, error) {
+	waiter := dataLakeWaiter(conn, arn, timeout, dataLakeUpdateStatus)
+	waiter.Pending = []awstypes.DataLakeStatus{awstypes.DataLakeStatusInitialized, awstypes.DataLakeStatusPending}
+	waiter.Target = []awstypes.DataLakeStatus{awstypes.DataLakeStatusCompleted}
+
+	return waiter.Wait(ctx)
+}
+
+func waitDataLakeDeleted(ctx context.Context, conn *securitylake.Client, arn string, timeout time.Duration) (*awstypes.DataLakeResource, error) {
+	waiter := dataLakeWaiter(conn, arn, timeout, dataLakeCreateStatus)
+	waiter.Pending = []awstypes.DataLakeStatus{awstypes.DataLakeStatusInitialized, awstypes.DataLakeStatusPending, awstypes.DataLakeStatusCompleted}
+
+	return waiter.Wait(ctx)
+}
+
+func dataLakeWaiter(conn *securitylake.Client, arn string, timeout time.Duration, status func(*awstypes.DataLakeResource) awstypes.DataLakeStatus) tfresource.Waiter[awstypes.DataLakeResource, awstypes.DataLakeStatus] {
+	return tfresource.Waiter[awstypes.DataLakeResource, awstypes.DataLakeStatus]{
+		Find: func(ctx context.Context) (*awstypes.DataLakeResource, error) {
+			return findDataLakeByARN(ctx, conn, arn)
+		},
+		Status: status,
+		FailureReason: func(v *awstypes.DataLakeResource) error {
+			if v := v.UpdateStatus; v != nil && v.Exception != nil {
+				return errors.New(aws.ToString(v.Exception.Reason))
+			}
+
+			return nil
+		},
+		Timeout: timeout,
+		Options: tfresource.Options{
+			Delay:           10 * time.Second,
+			MinPollInterval: 10 * time.Second,
+		},
+	}
+}
+
+func dataLakeCreateStatus(v *awstypes.DataLakeResource) awstypes.DataLakeStatus {
+	return v.CreateStatus
+}
+
+func dataLakeUpdateStatus(v *awstypes.DataLakeResource) awstypes.DataLakeStatus {
+	if v.UpdateStatus == nil {
+		return awstypes.DataLakeStatusCompleted
+	}
+
+	return v.UpdateStatus.Status
+}
+
+type resourceDataLakeData struct {
+	ARN                     types.String                                                `tfsdk:"arn"`
+	Configurations          fwtypes.ListNestedObjectValueOf[dataLakeConfigurationModel] `tfsdk:"configuration"`
+	ID                      types.String                                                `tfsdk:"id"`
+	MetaStoreManagerRoleARN fwtypes.ARN                                                 `tfsdk:"meta_store_manager_role_arn"`
+	S3BucketARN             types.String                                                `tfsdk:"s3_bucket_arn"`
+	Tags                    types.Map                                                   `tfsdk:"tags"`
+	TagsAll                 types.Map                                                   `tfsdk:"tags_all"`
+	Timeouts                timeouts.Value                                              `tfsdk:"timeouts"`
+}
+
+type dataLakeConfigurationModel struct {
+	EncryptionConfiguration  fwtypes.ListNestedObjectValueOf[dataLakeEncryptionConfigurationModel]  `tfsdk:"encryption_configuration"`
+	LifecycleConfiguration   fwtypes.ListNestedObjectValueOf[dataLakeLifecycleConfigurationModel]   `tfsdk:"lifecycle_configuration"`
+	Region                   types.String                                                           `tfsdk:"region"`
+	ReplicationConfiguration fwtypes.ListNestedObjectValueOf[dataLakeReplicationConfigurationModel] `tfsdk:"replication_configuration"`
+}
+
+type dataLakeEncryptionConfigurationModel struct {
+	KMSKeyID types.String `tfsdk:"kms_key_id"`
+}
+
+type dataLakeLifecycleConfigurationModel struct {
+	Expiration  fwtypes.ListNestedObjectValueOf[dataLakeLifecycleExpirationModel] `tfsdk:"expiration"`
+	Transitions fwtypes.ListNestedObjectValueOf[dataLakeLifecycleTransitionModel] `tfsdk:"transition"`
+}
+
+type dataLakeLifecycleExpirationModel struct {
+	Days types.Int64 `tfsdk:"days"`
+}
+
+type dataLakeLifecycleTransitionModel struct {
+	Days         types.Int64  `tfsdk:"days"`
+	StorageClass types.String `tfsdk:"storage_class"`
+}
+
+type dataLakeReplicationConfigurationModel struct {
+	Regions types.Set   `tfsdk:"regions"`
+	RoleARN fwtypes.ARN `tfsdk:"role_arn"`
+}
+
+func expandDataLakeConfigurations(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[dataLakeConfigurationModel]) []awstypes.DataLakeConfiguration {
+	var apiObjects []awstypes.DataLakeConfiguration
+
+	for _, data := range nestedObjectSlice(ctx, tfList) {
+		apiObject := awstypes.DataLakeConfiguration{
+			Region: flex.StringFromFramework(ctx, data.Region),
+		}
+
+		if data := nestedObjectPtr(ctx, data.EncryptionConfiguration); data != nil {
+			apiObject.EncryptionConfiguration = &awstypes.DataLakeEncryptionConfiguration{
+				KmsKeyId: flex.StringFromFramework(ctx, data.KMSKeyID),
+			}
+		}
+
+		if data := nestedObjectPtr(ctx, data.LifecycleConfiguration); data != nil {
+			lifecycleConfiguration := &awstypes.DataLakeLifecycleConfiguration{}
+
+			if data := nestedObjectPtr(ctx, data.Expiration); data != nil {
+				lifecycleConfiguration.Expiration = &awstypes.DataLakeLifecycleExpiration{
+					Days: int32FromFramework(data.Days),
+				}
+			}
+
+			for _, data := range nestedObjectSlice(ctx, data.Transitions) {
+				lifecycleConfiguration.Transitions = append(lifecycleConfiguration.Transitions, awstypes.DataLakeLifecycleTransition{
+					Days:         int32FromFramework(data.Days),
+					StorageClass: flex.StringFromFramework(ctx, data.StorageClass),
+				})
+			}
+
+			apiObject.LifecycleConfiguration = lifecycleConfiguration
+		}
+
+		if data := nestedObjectPtr(ctx, data.ReplicationConfiguration); data != nil {
+			apiObject.ReplicationConfiguration = &awstypes.DataLakeReplicationConfiguration{
+				Regions: flex.ExpandFrameworkStringValueSet(ctx, data.Regions),
+				RoleArn: flex.ARNStringFromFramework(ctx, data.RoleARN),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenDataLakeConfigurations(ctx context.Context, apiObject *awstypes.DataLakeResource, diags *diag.Diagnostics) fwtypes.ListNestedObjectValueOf[dataLakeConfigurationModel] {
+	data := &dataLakeConfigurationModel{
+		EncryptionConfiguration:  fwtypes.NewListNestedObjectValueOfNull[dataLakeEncryptionConfigurationModel](ctx),
+		LifecycleConfiguration:   fwtypes.NewListNestedObjectValueOfNull[dataLakeLifecycleConfigurationModel](ctx),
+		Region:                   flex.StringToFramework(ctx, apiObject.Region),
+		ReplicationConfiguration: fwtypes.NewListNestedObjectValueOfNull[dataLakeReplicationConfigurationModel](ctx),
+	}
+
+	// Encryption with an S3-managed key is the default.
+	if v := apiObject.EncryptionConfiguration; v != nil && aws.ToString(v.KmsKeyId) != s3ManagedKMSKeyID {
+		data.EncryptionConfiguration = fwtypes.NewListNestedObjectValueOfPtr(ctx, &dataLakeEncryptionConfigurationModel{
+			KMSKeyID: flex.StringToFramework(ctx, v.KmsKeyId),
+		})
+	}
+
+	if v := apiObject.LifecycleConfiguration; v != nil && (v.Expiration != nil || len(v.Transitions) > 0) {
+		lifecycleConfiguration := &dataLakeLifecycleConfigurationModel{
+			Expiration:  fwtypes.NewListNestedObjectValueOfNull[dataLakeLifecycleExpirationModel](ctx),
+			Transitions: fwtypes.NewListNestedObjectValueOfNull[dataLakeLifecycleTransitionModel](ctx),
+		}
+
+		if v := v.Expiration; v != nil {
+			lifecycleConfiguration.Expiration = fwtypes.NewListNestedObjectValueOfPtr(ctx, &dataLakeLifecycleExpirationModel{
+				Days: int32ToFramework(v.Days),
+			})
+		}
+
+		if len(v.Transitions) > 0 {
+			transitions := make([]dataLakeLifecycleTransitionModel, len(v.Transitions))
+
+			for i, v := range v.Transitions {
+				transitions[i] = dataLakeLifecycleTransitionModel{
+					Days:         int32ToFramework(v.Days),
+					StorageClass: flex.StringToFramework(ctx, v.StorageClass),
+				}
+			}
+
+			lifecycleConfiguration.Transitions = fwtypes.NewListNestedObjectValueOfValueSlice(ctx, transitions)
+		}
+
+		data.LifecycleConfiguration = fwtypes.NewListNestedObjectValueOfPtr(ctx, lifecycleConfiguration)
+	}
+
+	if v := apiObject.ReplicationConfiguration; v != nil && (len(v.Regions) > 0 || v.RoleArn != nil) {
+		data.ReplicationConfiguration = fwtypes.NewListNestedObjectValueOfPtr(ctx, &dataLakeReplicationConfigurationModel{
+			Regions: flex.FlattenFrameworkStringValueSet(ctx, v.Regions),
+			RoleARN: flex.StringToFrameworkARN(ctx, v.RoleArn, diags),
+		})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtr(ctx, data)
+}
+
+// nestedObjectPtr returns the single element of a nested block list, or nil if the list is empty.
+func nestedObjectPtr[T any](ctx context.Context, v fwtypes.ListNestedObjectValueOf[T]) *T {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	return fwdiag.Must(v.ToObjectPtr(ctx)).(*T)
+}
+
+// nestedObjectSlice returns the elements of a nested block list.
+func nestedObjectSlice[T any](ctx context.Context, v fwtypes.ListNestedObjectValueOf[T]) []*T {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	return fwdiag.Must(v.ToObjectSlice(ctx)).([]*T)
+}
+
+func int32FromFramework(v types.Int64) *int32 {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	return aws.Int32(int32(v.ValueInt64()))
+}
+
+func int32ToFramework(v *int32) types.Int64 {
+	if v == nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(aws.ToInt32(v)))
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func findDataLakeByARN(ctx context.Context, conn *securitylake.Client, dataLakeARN string) (*awstypes.DataLakeResource, error) {
+	arn, err := arn.Parse(dataLakeARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &securitylake.ListDataLakesInput{
+		Regions: []string{arn.Region},
+	}
+
+	return tfresource.FindSingleValue(ctx, tfresource.Finder[awstypes.DataLakeResource]{
+		List: tfresource.ListOnce(conn.ListDataLakes, input, func(page *securitylake.ListDataLakesOutput) []awstypes.DataLakeResource {
+			return page.DataLakes
+		}),
+		Filter: func(v awstypes.DataLakeResource) bool {
+			return aws.ToString(v.DataLakeArn) == dataLakeARN
+		},
+		NotFound:    errs.IsA[*awstypes.ResourceNotFoundException],
+		LastRequest: input,
+	})
+}
+
+func findLogSources(ctx context.Context, conn *securitylake.Client, input *securitylake.ListLogSourcesInput) ([]awstypes.LogSource, error) {
+	pages := securitylake.NewListLogSourcesPaginator(conn, input)
+
+	return tfresource.Find(ctx, tfresource.Finder[awstypes.LogSource]{
+		List: tfresource.ListPagesV2(pages.HasMorePages, pages.NextPage, func(page *securitylake.ListLogSourcesOutput) []awstypes.LogSource {
+			return page.Sources
+		}),
+		NotFound:    errs.IsA[*awstypes.ResourceNotFoundException],
+		LastRequest: input,
+	})
+}
+
+// findAWSLogSourceBySourceName returns the accounts and Regions from which the specified natively supported AWS service is collected.
+func findAWSLogSourceBySourceName(ctx context.Context, conn *securitylake.Client, sourceName awstypes.AwsLogSourceName) (*awstypes.AwsLogSourceConfiguration, error) {
+	input := &securitylake.ListLogSourcesInput{
+		Sources: []awstypes.LogSourceResource{
+			&awstypes.LogSourceResourceMemberAwsLogSource{
+				Value: awstypes.AwsLogSourceResource{
+					SourceName: sourceName,
+				},
+			},
+		},
+	}
+
+	logSources, err := findLogSources(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var output *awstypes.AwsLogSourceConfiguration
+
+	for _, logSource := range logSources {
+		for _, v := range logSource.Sources {
+			v, ok := v.(*awstypes.LogSourceResourceMemberAwsLogSource)
+
+			if !ok || v.Value.SourceName != sourceName {
+				continue
+			}
+
+			if output == nil {
+				output = &awstypes.AwsLogSourceConfiguration{
+					SourceName:    v.Value.SourceName,
+					SourceVersion: v.Value.SourceVersion,
+				}
+			}
+
+			if account := aws.ToString(logSource.Account); !tfslices.Any(output.Accounts, tfslices.PredicateEquals(account)) {
+				output.Accounts = append(output.Accounts, account)
+			}
+
+			if region := aws.ToString(logSource.Region); !tfslices.Any(output.Regions, tfslices.PredicateEquals(region)) {
+				output.Regions = append(output.Regions, region)
+			}
+		}
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findCustomLogSourceBySourceName(ctx context.Context, conn *securitylake.Client, sourceName string) (*awstypes.CustomLogSourceResource, error) {
+	input := &securitylake.ListLogSourcesInput{
+		Sources: []awstypes.LogSourceResource{
+			&awstypes.LogSourceResourceMemberCustomLogSource{
+				Value: awstypes.CustomLogSourceResource{
+					SourceName: aws.String(sourceName),
+				},
+			},
+		},
+	}
+
+	logSources, err := findLogSources(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, logSource := range logSources {
+		for _, v := range logSource.Sources {
+			if v, ok := v.(*awstypes.LogSourceResourceMemberCustomLogSource); ok && aws.ToString(v.Value.SourceName) == sourceName {
+				return &v.Value, nil
+			}
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+func findSubscriberByID(ctx context.Context, conn *securitylake.Client, id string) (*awstypes.SubscriberResource, error) {
+	input := &securitylake.GetSubscriberInput{
+		SubscriberId: aws.String(id),
+	}
+
+	output, err := conn.GetSubscriber(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Subscriber == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Subscriber, nil
+}
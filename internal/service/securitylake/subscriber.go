@@ -0,0 +1,483 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Subscriber")
+// @Tags(identifierAttribute="arn")
+func newResourceSubscriber(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceSubscriber{}
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type resourceSubscriber struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceSubscriber) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_subscriber"
+}
+
+func (r *resourceSubscriber) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	logSourceResourceBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[logSourceResourceModel](ctx),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"source_name": schema.StringAttribute{
+						Required: true,
+					},
+					"source_version": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.AccessType](),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"resource_share_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_share_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"s3_bucket_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscriber_description": schema.StringAttribute{
+				Optional: true,
+			},
+			"subscriber_endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscriber_name": schema.StringAttribute{
+				Required: true,
+			},
+			"subscriber_status": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"source": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[subscriberSourceModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"aws_log_source_resource":    logSourceResourceBlock(),
+						"custom_log_source_resource": logSourceResourceBlock(),
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"subscriber_identity": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[awsIdentityModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"external_id": schema.StringAttribute{
+							Required: true,
+						},
+						"principal": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceSubscriber) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceSubscriberData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.CreateSubscriberInput{
+		Sources:               expandLogSourceResources(ctx, data.Sources),
+		SubscriberDescription: flex.StringFromFramework(ctx, data.SubscriberDescription),
+		SubscriberIdentity:    expandAWSIdentity(ctx, data.SubscriberIdentity),
+		SubscriberName:        flex.StringFromFramework(ctx, data.SubscriberName),
+		Tags:                  getTagsIn(ctx),
+	}
+
+	if !data.AccessType.IsNull() && !data.AccessType.IsUnknown() {
+		input.AccessTypes = []awstypes.AccessType{awstypes.AccessType(data.AccessType.ValueString())}
+	}
+
+	output, err := conn.CreateSubscriber(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Lake Subscriber (%s)", data.SubscriberName.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ID = flex.StringToFramework(ctx, output.Subscriber.SubscriberId)
+
+	subscriber, err := waitSubscriberCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Subscriber (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.flatten(ctx, subscriber)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSubscriber) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceSubscriberData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	subscriber, err := findSubscriberByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Subscriber (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.flatten(ctx, subscriber)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSubscriber) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceSubscriberData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	if !new.Sources.Equal(old.Sources) ||
+		!new.SubscriberDescription.Equal(old.SubscriberDescription) ||
+		!new.SubscriberIdentity.Equal(old.SubscriberIdentity) ||
+		!new.SubscriberName.Equal(old.SubscriberName) {
+		input := &securitylake.UpdateSubscriberInput{
+			Sources:               expandLogSourceResources(ctx, new.Sources),
+			SubscriberDescription: flex.StringFromFramework(ctx, new.SubscriberDescription),
+			SubscriberId:          flex.StringFromFramework(ctx, new.ID),
+			SubscriberIdentity:    expandAWSIdentity(ctx, new.SubscriberIdentity),
+			SubscriberName:        flex.StringFromFramework(ctx, new.SubscriberName),
+		}
+
+		_, err := conn.UpdateSubscriber(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Security Lake Subscriber (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		subscriber, err := waitSubscriberUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Subscriber (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.flatten(ctx, subscriber)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceSubscriber) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceSubscriberData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	_, err := conn.DeleteSubscriber(ctx, &securitylake.DeleteSubscriberInput{
+		SubscriberId: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Subscriber (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitSubscriberDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Subscriber (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceSubscriber) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func waitSubscriberCreated(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
+	return subscriberWaiter(conn, id, timeout,
+		[]awstypes.SubscriberStatus{awstypes.SubscriberStatusPending},
+		[]awstypes.SubscriberStatus{awstypes.SubscriberStatusActive, awstypes.SubscriberStatusReady},
+	).Wait(ctx)
+}
+
+func waitSubscriberUpdated(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
+	return subscriberWaiter(conn, id, timeout,
+		[]awstypes.SubscriberStatus{awstypes.SubscriberStatusPending},
+		[]awstypes.SubscriberStatus{awstypes.SubscriberStatusActive, awstypes.SubscriberStatusReady},
+	).Wait(ctx)
+}
+
+func waitSubscriberDeleted(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
+	return subscriberWaiter(conn, id, timeout,
+		awstypes.SubscriberStatus("").Values(),
+		nil,
+	).Wait(ctx)
+}
+
+func subscriberWaiter(conn *securitylake.Client, id string, timeout time.Duration, pending, target []awstypes.SubscriberStatus) tfresource.Waiter[awstypes.SubscriberResource, awstypes.SubscriberStatus] {
+	return tfresource.Waiter[awstypes.SubscriberResource, awstypes.SubscriberStatus]{
+		Find: func(ctx context.Context) (*awstypes.SubscriberResource, error) {
+			return findSubscriberByID(ctx, conn, id)
+		},
+		Status: func(v *awstypes.SubscriberResource) awstypes.SubscriberStatus {
+			return v.SubscriberStatus
+		},
+		Pending: pending,
+		Target:  target,
+		Timeout: timeout,
+		Options: tfresource.Options{
+			MinPollInterval: 5 * time.Second,
+		},
+	}
+}
+
+type resourceSubscriberData struct {
+	AccessType            types.String                                           `tfsdk:"access_type"`
+	ARN                   types.String                                           `tfsdk:"arn"`
+	ID                    types.String                                           `tfsdk:"id"`
+	ResourceShareARN      types.String                                           `tfsdk:"resource_share_arn"`
+	ResourceShareName     types.String                                           `tfsdk:"resource_share_name"`
+	RoleARN               types.String                                           `tfsdk:"role_arn"`
+	S3BucketARN           types.String                                           `tfsdk:"s3_bucket_arn"`
+	Sources               fwtypes.ListNestedObjectValueOf[subscriberSourceModel] `tfsdk:"source"`
+	SubscriberDescription types.String                                           `tfsdk:"subscriber_description"`
+	SubscriberEndpoint    types.String                                           `tfsdk:"subscriber_endpoint"`
+	SubscriberIdentity    fwtypes.ListNestedObjectValueOf[awsIdentityModel]      `tfsdk:"subscriber_identity"`
+	SubscriberName        types.String                                           `tfsdk:"subscriber_name"`
+	SubscriberStatus      types.String                                           `tfsdk:"subscriber_status"`
+	Tags                  types.Map                                              `tfsdk:"tags"`
+	TagsAll               types.Map                                              `tfsdk:"tags_all"`
+	Timeouts              timeouts.Value                                         `tfsdk:"timeouts"`
+}
+
+type subscriberSourceModel struct {
+	AWSLogSourceResource    fwtypes.ListNestedObjectValueOf[logSourceResourceModel] `tfsdk:"aws_log_source_resource"`
+	CustomLogSourceResource fwtypes.ListNestedObjectValueOf[logSourceResourceModel] `tfsdk:"custom_log_source_resource"`
+}
+
+type logSourceResourceModel struct {
+	SourceName    types.String `tfsdk:"source_name"`
+	SourceVersion types.String `tfsdk:"source_version"`
+}
+
+func (data *resourceSubscriberData) flatten(ctx context.Context, apiObject *awstypes.SubscriberResource) {
+	data.ARN = flex.StringToFramework(ctx, apiObject.SubscriberArn)
+	data.ResourceShareARN = flex.StringToFramework(ctx, apiObject.ResourceShareArn)
+	data.ResourceShareName = flex.StringToFramework(ctx, apiObject.ResourceShareName)
+	data.RoleARN = flex.StringToFramework(ctx, apiObject.RoleArn)
+	data.S3BucketARN = flex.StringToFramework(ctx, apiObject.S3BucketArn)
+	data.Sources = flattenLogSourceResources(ctx, apiObject.Sources)
+	data.SubscriberDescription = flex.StringToFramework(ctx, apiObject.SubscriberDescription)
+	data.SubscriberEndpoint = flex.StringToFramework(ctx, apiObject.SubscriberEndpoint)
+	data.SubscriberIdentity = flattenAWSIdentity(ctx, apiObject.SubscriberIdentity)
+	data.SubscriberName = flex.StringToFramework(ctx, apiObject.SubscriberName)
+	data.SubscriberStatus = flex.StringValueToFramework(ctx, apiObject.SubscriberStatus)
+
+	if len(apiObject.AccessTypes) > 0 {
+		data.AccessType = flex.StringValueToFramework(ctx, apiObject.AccessTypes[0])
+	} else {
+		data.AccessType = types.StringNull()
+	}
+}
+
+func expandLogSourceResources(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[subscriberSourceModel]) []awstypes.LogSourceResource {
+	var apiObjects []awstypes.LogSourceResource
+
+	for _, data := range nestedObjectSlice(ctx, tfList) {
+		if data := nestedObjectPtr(ctx, data.AWSLogSourceResource); data != nil {
+			apiObjects = append(apiObjects, &awstypes.LogSourceResourceMemberAwsLogSource{
+				Value: awstypes.AwsLogSourceResource{
+					SourceName:    awstypes.AwsLogSourceName(data.SourceName.ValueString()),
+					SourceVersion: flex.StringFromFramework(ctx, data.SourceVersion),
+				},
+			})
+		}
+
+		if data := nestedObjectPtr(ctx, data.CustomLogSourceResource); data != nil {
+			apiObjects = append(apiObjects, &awstypes.LogSourceResourceMemberCustomLogSource{
+				Value: awstypes.CustomLogSourceResource{
+					SourceName:    flex.StringFromFramework(ctx, data.SourceName),
+					SourceVersion: flex.StringFromFramework(ctx, data.SourceVersion),
+				},
+			})
+		}
+	}
+
+	return apiObjects
+}
+
+func flattenLogSourceResources(ctx context.Context, apiObjects []awstypes.LogSourceResource) fwtypes.ListNestedObjectValueOf[subscriberSourceModel] {
+	var sources []*subscriberSourceModel
+
+	for _, apiObject := range apiObjects {
+		source := &subscriberSourceModel{
+			AWSLogSourceResource:    fwtypes.NewListNestedObjectValueOfNull[logSourceResourceModel](ctx),
+			CustomLogSourceResource: fwtypes.NewListNestedObjectValueOfNull[logSourceResourceModel](ctx),
+		}
+
+		switch v := apiObject.(type) {
+		case *awstypes.LogSourceResourceMemberAwsLogSource:
+			source.AWSLogSourceResource = fwtypes.NewListNestedObjectValueOfPtr(ctx, &logSourceResourceModel{
+				SourceName:    flex.StringValueToFramework(ctx, v.Value.SourceName),
+				SourceVersion: flex.StringToFramework(ctx, v.Value.SourceVersion),
+			})
+		case *awstypes.LogSourceResourceMemberCustomLogSource:
+			source.CustomLogSourceResource = fwtypes.NewListNestedObjectValueOfPtr(ctx, &logSourceResourceModel{
+				SourceName:    flex.StringToFramework(ctx, v.Value.SourceName),
+				SourceVersion: flex.StringToFramework(ctx, v.Value.SourceVersion),
+			})
+		default:
+			continue
+		}
+
+		sources = append(sources, source)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfSlice(ctx, sources)
+}
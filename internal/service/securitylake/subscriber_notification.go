@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Subscriber Notification")
+func newResourceSubscriberNotification(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceSubscriberNotification{}, nil
+}
+
+type resourceSubscriberNotification struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceSubscriberNotification) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_subscriber_notification"
+}
+
+func (r *resourceSubscriberNotification) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"endpoint_id": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"subscriber_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[subscriberNotificationConfigurationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"https_notification_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[httpsNotificationConfigurationModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"authorization_api_key_name": schema.StringAttribute{
+										Optional: true,
+									},
+									"authorization_api_key_value": schema.StringAttribute{
+										Optional:  true,
+										Sensitive: true,
+									},
+									"endpoint": schema.StringAttribute{
+										Required: true,
+									},
+									"http_method": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											enum.FrameworkValidate[awstypes.HttpMethod](),
+										},
+									},
+									"target_role_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("https_notification_configuration"),
+									path.MatchRelative().AtParent().AtName("sqs_notification_configuration"),
+								),
+							},
+						},
+						"sqs_notification_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[sqsNotificationConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSubscriberNotification) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceSubscriberNotificationData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.CreateSubscriberNotificationInput{
+		Configuration: data.expandConfiguration(ctx),
+		SubscriberId:  flex.StringFromFramework(ctx, data.SubscriberID),
+	}
+
+	output, err := conn.CreateSubscriberNotification(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Lake Subscriber Notification (%s)", data.SubscriberID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.EndpointID = flex.StringToFramework(ctx, output.SubscriberEndpoint)
+	data.ID = data.SubscriberID
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSubscriberNotification) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceSubscriberNotificationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	subscriber, err := findSubscriberNotificationBySubscriberID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Subscriber Notification (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The notification configuration is not returned by the API.
+	data.EndpointID = flex.StringToFramework(ctx, subscriber.SubscriberEndpoint)
+	data.SubscriberID = flex.StringToFramework(ctx, subscriber.SubscriberId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceSubscriberNotification) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceSubscriberNotificationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	if !new.Configuration.Equal(old.Configuration) {
+		input := &securitylake.UpdateSubscriberNotificationInput{
+			Configuration: new.expandConfiguration(ctx),
+			SubscriberId:  flex.StringFromFramework(ctx, new.ID),
+		}
+
+		output, err := conn.UpdateSubscriberNotification(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Security Lake Subscriber Notification (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.EndpointID = flex.StringToFramework(ctx, output.SubscriberEndpoint)
+	} else {
+		new.EndpointID = old.EndpointID
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceSubscriberNotification) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceSubscriberNotificationData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	_, err := conn.DeleteSubscriberNotification(ctx, &securitylake.DeleteSubscriberNotificationInput{
+		SubscriberId: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Lake Subscriber Notification (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+// findSubscriberNotificationBySubscriberID returns the subscriber, treating a subscriber without an endpoint as having no notification.
+func findSubscriberNotificationBySubscriberID(ctx context.Context, conn *securitylake.Client, subscriberID string) (*awstypes.SubscriberResource, error) {
+	output, err := findSubscriberByID(ctx, conn, subscriberID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.SubscriberEndpoint == nil {
+		return nil, tfresource.NewEmptyResultError(subscriberID)
+	}
+
+	return output, nil
+}
+
+type resourceSubscriberNotificationData struct {
+	Configuration fwtypes.ListNestedObjectValueOf[subscriberNotificationConfigurationModel] `tfsdk:"configuration"`
+	EndpointID    types.String                                                              `tfsdk:"endpoint_id"`
+	ID            types.String                                                              `tfsdk:"id"`
+	SubscriberID  types.String                                                              `tfsdk:"subscriber_id"`
+}
+
+type subscriberNotificationConfigurationModel struct {
+	HTTPSNotificationConfiguration fwtypes.ListNestedObjectValueOf[httpsNotificationConfigurationModel] `tfsdk:"https_notification_configuration"`
+	SQSNotificationConfiguration   fwtypes.ListNestedObjectValueOf[sqsNotificationConfigurationModel]   `tfsdk:"sqs_notification_configuration"`
+}
+
+type httpsNotificationConfigurationModel struct {
+	AuthorizationAPIKeyName  types.String `tfsdk:"authorization_api_key_name"`
+	AuthorizationAPIKeyValue types.String `tfsdk:"authorization_api_key_value"`
+	Endpoint                 types.String `tfsdk:"endpoint"`
+	HTTPMethod               types.String `tfsdk:"http_method"`
+	TargetRoleARN            fwtypes.ARN  `tfsdk:"target_role_arn"`
+}
+
+type sqsNotificationConfigurationModel struct{}
+
+func (data *resourceSubscriberNotificationData) expandConfiguration(ctx context.Context) awstypes.NotificationConfiguration {
+	configuration := nestedObjectPtr(ctx, data.Configuration)
+
+	if configuration == nil {
+		return nil
+	}
+
+	if data := nestedObjectPtr(ctx, configuration.HTTPSNotificationConfiguration); data != nil {
+		apiObject := &awstypes.NotificationConfigurationMemberHttpsNotificationConfiguration{
+			Value: awstypes.HttpsNotificationConfiguration{
+				AuthorizationApiKeyName:  flex.StringFromFramework(ctx, data.AuthorizationAPIKeyName),
+				AuthorizationApiKeyValue: flex.StringFromFramework(ctx, data.AuthorizationAPIKeyValue),
+				Endpoint:                 flex.StringFromFramework(ctx, data.Endpoint),
+				TargetRoleArn:            flex.ARNStringFromFramework(ctx, data.TargetRoleARN),
+			},
+		}
+
+		if v := data.HTTPMethod.ValueString(); v != "" {
+			apiObject.Value.HttpMethod = awstypes.HttpMethod(v)
+		}
+
+		return apiObject
+	}
+
+	if !configuration.SQSNotificationConfiguration.IsNull() && len(configuration.SQSNotificationConfiguration.Elements()) > 0 {
+		return &awstypes.NotificationConfigurationMemberSqsNotificationConfiguration{
+			Value: awstypes.SqsNotificationConfiguration{},
+		}
+	}
+
+	return nil
+}
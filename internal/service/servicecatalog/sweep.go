@@ -7,6 +7,7 @@
 package servicecatalog
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -17,7 +18,9 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -44,6 +47,7 @@ func init() {
 		Dependencies: []string{},
 		F:            sweepProductPortfolioAssociations,
 	})
+	discovery.Register("aws_servicecatalog_product_portfolio_association", listProductPortfolioAssociations)
 
 	resource.AddTestSweepers("aws_servicecatalog_product", &resource.Sweeper{
 		Name: "aws_servicecatalog_product",
@@ -52,24 +56,28 @@ func init() {
 		},
 		F: sweepProducts,
 	})
+	discovery.Register("aws_servicecatalog_product", listProducts)
 
 	resource.AddTestSweepers("aws_servicecatalog_provisioned_product", &resource.Sweeper{
 		Name:         "aws_servicecatalog_provisioned_product",
 		Dependencies: []string{},
 		F:            sweepProvisionedProducts,
 	})
+	discovery.Register("aws_servicecatalog_provisioned_product", listProvisionedProducts)
 
 	resource.AddTestSweepers("aws_servicecatalog_provisioning_artifact", &resource.Sweeper{
 		Name:         "aws_servicecatalog_provisioning_artifact",
 		Dependencies: []string{},
 		F:            sweepProvisioningArtifacts,
 	})
+	discovery.Register("aws_servicecatalog_provisioning_artifact", listProvisioningArtifacts)
 
 	resource.AddTestSweepers("aws_servicecatalog_service_action", &resource.Sweeper{
 		Name:         "aws_servicecatalog_service_action",
 		Dependencies: []string{},
 		F:            sweepServiceActions,
 	})
+	discovery.Register("aws_servicecatalog_service_action", listServiceActions)
 
 	resource.AddTestSweepers("aws_servicecatalog_tag_option_resource_association", &resource.Sweeper{
 		Name:         "aws_servicecatalog_tag_option_resource_association",
@@ -82,6 +90,7 @@ func init() {
 		Dependencies: []string{},
 		F:            sweepTagOptions,
 	})
+	discovery.Register("aws_servicecatalog_tag_option", listTagOptions)
 }
 
 func sweepBudgetResourceAssociations(region string) error {
@@ -347,6 +356,27 @@ func sweepProductPortfolioAssociations(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
+	var errs *multierror.Error
+
+	sweepResources, err := listProductPortfolioAssociations(ctx, client)
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error describing Service Catalog Product Portfolio Associations for %s: %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog Product Portfolio Associations for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Service Catalog Product Portfolio Associations sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func listProductPortfolioAssociations(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.ServiceCatalogConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 	var errs *multierror.Error
@@ -355,7 +385,7 @@ func sweepProductPortfolioAssociations(region string) error {
 
 	input := &servicecatalog.SearchProductsAsAdminInput{}
 
-	err = conn.SearchProductsAsAdminPagesWithContext(ctx, input, func(page *servicecatalog.SearchProductsAsAdminOutput, lastPage bool) bool {
+	err := conn.SearchProductsAsAdminPagesWithContext(ctx, input, func(page *servicecatalog.SearchProductsAsAdminOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -408,7 +438,7 @@ func sweepProductPortfolioAssociations(region string) error {
 			})
 
 			if err != nil {
-				errs = multierror.Append(errs, fmt.Errorf("error listing Service Catalog Portfolios for Products %s: %w", region, err))
+				errs = multierror.Append(errs, fmt.Errorf("error listing Service Catalog Portfolios for Products %s: %w", client.Region, err))
 				continue
 			}
 		}
@@ -417,19 +447,10 @@ func sweepProductPortfolioAssociations(region string) error {
 	})
 
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("error describing Service Catalog Product Portfolio Associations for %s: %w", region, err))
-	}
-
-	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog Product Portfolio Associations for %s: %w", region, err))
+		errs = multierror.Append(errs, err)
 	}
 
-	if sweep.SkipSweepError(errs.ErrorOrNil()) {
-		log.Printf("[WARN] Skipping Service Catalog Product Portfolio Associations sweep for %s: %s", region, errs)
-		return nil
-	}
-
-	return errs.ErrorOrNil()
+	return sweepResources, errs.ErrorOrNil()
 }
 
 func sweepProducts(region string) error {
@@ -440,13 +461,33 @@ func sweepProducts(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
+	var errs *multierror.Error
+
+	sweepResources, err := listProducts(ctx, client)
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error describing Service Catalog Products for %s: %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog Products for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Service Catalog Products sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func listProducts(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.ServiceCatalogConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
-	var errs *multierror.Error
 
 	input := &servicecatalog.SearchProductsAsAdminInput{}
 
-	err = conn.SearchProductsAsAdminPagesWithContext(ctx, input, func(page *servicecatalog.SearchProductsAsAdminOutput, lastPage bool) bool {
+	err := conn.SearchProductsAsAdminPagesWithContext(ctx, input, func(page *servicecatalog.SearchProductsAsAdminOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -469,19 +510,10 @@ func sweepProducts(region string) error {
 	})
 
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("error describing Service Catalog Products for %s: %w", region, err))
-	}
-
-	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog Products for %s: %w", region, err))
+		return nil, err
 	}
 
-	if sweep.SkipSweepError(errs.ErrorOrNil()) {
-		log.Printf("[WARN] Skipping Service Catalog Products sweep for %s: %s", region, errs)
-		return nil
-	}
-
-	return errs.ErrorOrNil()
+	return sweepResources, nil
 }
 
 func sweepProvisionedProducts(region string) error {
@@ -492,9 +524,29 @@ func sweepProvisionedProducts(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
+	var errs *multierror.Error
+
+	sweepResources, err := listProvisionedProducts(ctx, client)
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error describing Service Catalog Provisioned Products for %s: %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog Provisioned Products for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Service Catalog Provisioned Products sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func listProvisionedProducts(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.ServiceCatalogConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
-	var errs *multierror.Error
 
 	input := &servicecatalog.SearchProvisionedProductsInput{
 		AccessLevelFilter: &servicecatalog.AccessLevelFilter{
@@ -503,7 +555,7 @@ func sweepProvisionedProducts(region string) error {
 		},
 	}
 
-	err = conn.SearchProvisionedProductsPagesWithContext(ctx, input, func(page *servicecatalog.SearchProvisionedProductsOutput, lastPage bool) bool {
+	err := conn.SearchProvisionedProductsPagesWithContext(ctx, input, func(page *servicecatalog.SearchProvisionedProductsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -524,19 +576,10 @@ func sweepProvisionedProducts(region string) error {
 	})
 
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("error describing Service Catalog Provisioned Products for %s: %w", region, err))
-	}
-
-	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog Provisioned Products for %s: %w", region, err))
-	}
-
-	if sweep.SkipSweepError(errs.ErrorOrNil()) {
-		log.Printf("[WARN] Skipping Service Catalog Provisioned Products sweep for %s: %s", region, errs)
-		return nil
+		return nil, err
 	}
 
-	return errs.ErrorOrNil()
+	return sweepResources, nil
 }
 
 func sweepProvisioningArtifacts(region string) error {
@@ -547,13 +590,34 @@ func sweepProvisioningArtifacts(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
+	var errs *multierror.Error
+
+	sweepResources, err := listProvisioningArtifacts(ctx, client)
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error describing Service Catalog Provisioning Artifacts for %s: %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog Provisioning Artifacts for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Service Catalog Provisioning Artifacts sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func listProvisioningArtifacts(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.ServiceCatalogConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 	var errs *multierror.Error
 
 	input := &servicecatalog.SearchProductsAsAdminInput{}
 
-	err = conn.SearchProductsAsAdminPagesWithContext(ctx, input, func(page *servicecatalog.SearchProductsAsAdminOutput, lastPage bool) bool {
+	err := conn.SearchProductsAsAdminPagesWithContext(ctx, input, func(page *servicecatalog.SearchProductsAsAdminOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -606,19 +670,10 @@ func sweepProvisioningArtifacts(region string) error {
 	})
 
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("error describing Service Catalog Provisioning Artifacts for %s: %w", region, err))
-	}
-
-	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog Provisioning Artifacts for %s: %w", region, err))
-	}
-
-	if sweep.SkipSweepError(errs.ErrorOrNil()) {
-		log.Printf("[WARN] Skipping Service Catalog Provisioning Artifacts sweep for %s: %s", region, errs)
-		return nil
+		errs = multierror.Append(errs, err)
 	}
 
-	return errs.ErrorOrNil()
+	return sweepResources, errs.ErrorOrNil()
 }
 
 func sweepServiceActions(region string) error {
@@ -629,13 +684,33 @@ func sweepServiceActions(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
+	var errs *multierror.Error
+
+	sweepResources, err := listServiceActions(ctx, client)
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error describing Service Catalog Service Actions for %s: %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog Service Actions for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Service Catalog Service Actions sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func listServiceActions(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.ServiceCatalogConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
-	var errs *multierror.Error
 
 	input := &servicecatalog.ListServiceActionsInput{}
 
-	err = conn.ListServiceActionsPagesWithContext(ctx, input, func(page *servicecatalog.ListServiceActionsOutput, lastPage bool) bool {
+	err := conn.ListServiceActionsPagesWithContext(ctx, input, func(page *servicecatalog.ListServiceActionsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -658,19 +733,10 @@ func sweepServiceActions(region string) error {
 	})
 
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("error describing Service Catalog Service Actions for %s: %w", region, err))
-	}
-
-	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog Service Actions for %s: %w", region, err))
-	}
-
-	if sweep.SkipSweepError(errs.ErrorOrNil()) {
-		log.Printf("[WARN] Skipping Service Catalog Service Actions sweep for %s: %s", region, errs)
-		return nil
+		return nil, err
 	}
 
-	return errs.ErrorOrNil()
+	return sweepResources, nil
 }
 
 func sweepTagOptionResourceAssociations(region string) error {
@@ -753,13 +819,37 @@ func sweepTagOptions(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
+	var errs *multierror.Error
+
+	sweepResources, err := listTagOptions(ctx, client)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeTagOptionNotMigratedException) {
+		log.Printf("[WARN] Skipping Service Catalog Tag Options sweep for %s: %s", region, err)
+		return nil
+	}
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error describing Service Catalog Tag Options for %s: %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog Tag Options for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Service Catalog Tag Options sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func listTagOptions(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.ServiceCatalogConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
-	var errs *multierror.Error
 
 	input := &servicecatalog.ListTagOptionsInput{}
 
-	err = conn.ListTagOptionsPagesWithContext(ctx, input, func(page *servicecatalog.ListTagOptionsOutput, lastPage bool) bool {
+	err := conn.ListTagOptionsPagesWithContext(ctx, input, func(page *servicecatalog.ListTagOptionsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -781,22 +871,9 @@ func sweepTagOptions(region string) error {
 		return !lastPage
 	})
 
-	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeTagOptionNotMigratedException) {
-		log.Printf("[WARN] Skipping Service Catalog Tag Options sweep for %s: %s", region, err)
-		return nil
-	}
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("error describing Service Catalog Tag Options for %s: %w", region, err))
-	}
-
-	if err = sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog Tag Options for %s: %w", region, err))
+		return nil, err
 	}
 
-	if sweep.SkipSweepError(errs.ErrorOrNil()) {
-		log.Printf("[WARN] Skipping Service Catalog Tag Options sweep for %s: %s", region, errs)
-		return nil
-	}
-
-	return errs.ErrorOrNil()
+	return sweepResources, nil
 }
@@ -7,6 +7,7 @@
 package sesv2
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,8 +15,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/sesv2"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -23,11 +26,13 @@ func init() {
 		Name: "aws_sesv2_configuration_set",
 		F:    sweepConfigurationSets,
 	})
+	discovery.Register("aws_sesv2_configuration_set", listConfigurationSets)
 
 	resource.AddTestSweepers("aws_sesv2_contact_list", &resource.Sweeper{
 		Name: "aws_sesv2_contact_list",
 		F:    sweepContactLists,
 	})
+	discovery.Register("aws_sesv2_contact_list", listContactLists)
 }
 
 func sweepConfigurationSets(region string) error {
@@ -38,13 +43,33 @@ func sweepConfigurationSets(region string) error {
 		return fmt.Errorf("getting client: %w", err)
 	}
 
+	var errs *multierror.Error
+
+	sweepResources, err := listConfigurationSets(ctx, client)
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("listing Configuration Sets for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping Configuration Sets for %s: %w", region, err))
+	}
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Configuration Sets sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func listConfigurationSets(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.SESV2Client(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
-	var errs *multierror.Error
 
 	input := &sesv2.ListConfigurationSetsInput{}
 
-	err = ListConfigurationSetsPages(ctx, conn, input, func(page *sesv2.ListConfigurationSetsOutput, lastPage bool) bool {
+	err := ListConfigurationSetsPages(ctx, conn, input, func(page *sesv2.ListConfigurationSetsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -62,19 +87,10 @@ func sweepConfigurationSets(region string) error {
 	})
 
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("listing Configuration Sets for %s: %w", region, err))
+		return nil, err
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("sweeping Configuration Sets for %s: %w", region, err))
-	}
-
-	if awsv2.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Configuration Sets sweep for %s: %s", region, errs)
-		return nil
-	}
-
-	return errs.ErrorOrNil()
+	return sweepResources, nil
 }
 
 func sweepContactLists(region string) error {
@@ -85,13 +101,33 @@ func sweepContactLists(region string) error {
 		return fmt.Errorf("getting client: %w", err)
 	}
 
+	var errs *multierror.Error
+
+	sweepResources, err := listContactLists(ctx, client)
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("listing Contact Lists for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping Contact Lists for %s: %w", region, err))
+	}
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Contact Lists sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func listContactLists(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.SESV2Client(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
-	var errs *multierror.Error
 
 	input := &sesv2.ListContactListsInput{}
 
-	err = ListContactListsPages(ctx, conn, input, func(page *sesv2.ListContactListsOutput, lastPage bool) bool {
+	err := ListContactListsPages(ctx, conn, input, func(page *sesv2.ListContactListsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -109,17 +145,8 @@ func sweepContactLists(region string) error {
 	})
 
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("listing Contact Lists for %s: %w", region, err))
+		return nil, err
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("sweeping Contact Lists for %s: %w", region, err))
-	}
-
-	if awsv2.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Contact Lists sweep for %s: %s", region, errs)
-		return nil
-	}
-
-	return errs.ErrorOrNil()
+	return sweepResources, nil
 }
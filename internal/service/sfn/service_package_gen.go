@@ -37,6 +37,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceStateMachine,
 			TypeName: "aws_sfn_state_machine",
 		},
+		{
+			Factory:  DataSourceStateMachineDefinition,
+			TypeName: "aws_sfn_state_machine_definition",
+		},
 		{
 			Factory:  DataSourceStateMachineVersions,
 			TypeName: "aws_sfn_state_machine_versions",
@@ -0,0 +1,692 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+)
+
+// Amazon States Language state types.
+// See https://states-language.net/spec.html#state-type-table.
+const (
+	stateTypeChoice   = "Choice"
+	stateTypeFail     = "Fail"
+	stateTypeMap      = "Map"
+	stateTypeParallel = "Parallel"
+	stateTypePass     = "Pass"
+	stateTypeSucceed  = "Succeed"
+	stateTypeTask     = "Task"
+	stateTypeWait     = "Wait"
+)
+
+// stateMachineErrorNames are the predefined error names.
+// See https://docs.aws.amazon.com/step-functions/latest/dg/concepts-error-handling.html#error-handling-error-representation.
+var stateMachineErrorNames = []string{
+	"States.ALL",
+	"States.BranchFailed",
+	"States.DataLimitExceeded",
+	"States.ExceedToleratedFailureThreshold",
+	"States.HeartbeatTimeout",
+	"States.Http.Socket",
+	"States.IntrinsicFailure",
+	"States.ItemReaderFailed",
+	"States.NoChoiceMatched",
+	"States.ParameterPathFailure",
+	"States.Permissions",
+	"States.ResultPathMatchFailure",
+	"States.ResultWriterFailed",
+	"States.Runtime",
+	"States.TaskFailed",
+	"States.Timeout",
+}
+
+// stateMachineChoiceOperators returns the Choice rule comparison operators.
+// See https://states-language.net/spec.html#choice-state.
+func stateMachineChoiceOperators() []string {
+	operators := []string{
+		"IsBoolean",
+		"IsNull",
+		"IsNumeric",
+		"IsPresent",
+		"IsString",
+		"IsTimestamp",
+		"StringMatches",
+	}
+
+	for _, prefix := range []string{"Boolean", "Numeric", "String", "Timestamp"} {
+		suffixes := []string{"Equals"}
+		if prefix != "Boolean" {
+			suffixes = append(suffixes, "GreaterThan", "GreaterThanEquals", "LessThan", "LessThanEquals")
+		}
+
+		for _, suffix := range suffixes {
+			operators = append(operators, prefix+suffix, prefix+suffix+"Path")
+		}
+	}
+
+	sort.Strings(operators)
+
+	return operators
+}
+
+// validateStateMachineDefinition statically validates an Amazon States Language definition.
+// The definition is the result of unmarshaling the JSON document into an interface{}.
+// All validation errors are returned.
+func validateStateMachineDefinition(definition map[string]interface{}) []error {
+	v := &stateMachineDefinitionValidator{}
+	v.validateDefinition("", definition, true)
+
+	return v.errs
+}
+
+type stateMachineDefinitionValidator struct {
+	errs []error
+}
+
+func (v *stateMachineDefinitionValidator) errorf(location, format string, a ...any) {
+	if location != "" {
+		format = "%s: " + format
+		a = append([]any{location}, a...)
+	}
+
+	v.errs = append(v.errs, fmt.Errorf(format, a...))
+}
+
+// validateDefinition validates a state machine, Parallel state branch or Map state item processor.
+// State names are scoped to the enclosing definition.
+func (v *stateMachineDefinitionValidator) validateDefinition(location string, definition map[string]interface{}, topLevel bool) {
+	if !topLevel {
+		for _, k := range []string{"TimeoutSeconds", "Version"} {
+			if _, ok := definition[k]; ok {
+				v.errorf(location, "%s is only supported at the top level of a state machine", k)
+			}
+		}
+	}
+
+	states, ok := definition["States"].(map[string]interface{})
+	if !ok || len(states) == 0 {
+		v.errorf(location, "States must be a non-empty object")
+		return
+	}
+
+	startAt, _ := definition["StartAt"].(string)
+	if startAt == "" {
+		v.errorf(location, "StartAt is required")
+	} else if _, ok := states[startAt]; !ok {
+		v.errorf(location, "StartAt state %q does not exist", startAt)
+	}
+
+	names := make([]string, 0, len(states))
+	for name := range states {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	transitions := make(map[string][]string)
+	terminal := make(map[string]bool)
+
+	for _, name := range names {
+		stateLocation := joinStateMachineLocation(location, "States."+name)
+
+		state, ok := states[name].(map[string]interface{})
+		if !ok {
+			v.errorf(stateLocation, "state must be an object")
+			continue
+		}
+
+		if len(name) > 80 {
+			v.errorf(stateLocation, "state name must be at most 80 characters")
+		}
+
+		next, isTerminal := v.validateState(stateLocation, state)
+		for _, target := range next {
+			if _, ok := states[target]; !ok {
+				v.errorf(stateLocation, "transition target %q does not exist", target)
+			}
+		}
+
+		transitions[name] = next
+		terminal[name] = isTerminal
+	}
+
+	if _, ok := states[startAt]; !ok {
+		return
+	}
+
+	// Every state must be reachable from StartAt.
+	reachable := map[string]bool{startAt: true}
+	queue := []string{startAt}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		for _, target := range transitions[name] {
+			if _, ok := states[target]; ok && !reachable[target] {
+				reachable[target] = true
+				queue = append(queue, target)
+			}
+		}
+	}
+
+	for _, name := range names {
+		if !reachable[name] {
+			v.errorf(joinStateMachineLocation(location, "States."+name), "state is not reachable from StartAt %q", startAt)
+		}
+	}
+
+	// Every reachable state must be able to reach a terminal state.
+	canTerminate := make(map[string]bool)
+	for changed := true; changed; {
+		changed = false
+
+		for _, name := range names {
+			if canTerminate[name] {
+				continue
+			}
+
+			if terminal[name] {
+				canTerminate[name] = true
+				changed = true
+				continue
+			}
+
+			for _, target := range transitions[name] {
+				if canTerminate[target] {
+					canTerminate[name] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	for _, name := range names {
+		if reachable[name] && !canTerminate[name] {
+			v.errorf(joinStateMachineLocation(location, "States."+name), "state can never reach a terminal state (End, Succeed or Fail)")
+		}
+	}
+}
+
+// validateState validates a single state, returning its transition targets and whether it can end the execution.
+func (v *stateMachineDefinitionValidator) validateState(location string, state map[string]interface{}) ([]string, bool) {
+	var next []string
+
+	stateType, _ := state["Type"].(string)
+	nextState, hasNext := state["Next"].(string)
+	end, _ := state["End"].(bool)
+	isTerminal := false
+
+	switch stateType {
+	case stateTypeChoice:
+		if hasNext || end {
+			v.errorf(location, "Choice states must not specify Next or End")
+		}
+
+		choices, ok := state["Choices"].([]interface{})
+		if !ok || len(choices) == 0 {
+			v.errorf(location, "Choices must be a non-empty array")
+		}
+
+		for i, choice := range choices {
+			choiceLocation := fmt.Sprintf("%s.Choices[%d]", location, i)
+
+			rule, ok := choice.(map[string]interface{})
+			if !ok {
+				v.errorf(choiceLocation, "choice rule must be an object")
+				continue
+			}
+
+			if target, _ := rule["Next"].(string); target == "" {
+				v.errorf(choiceLocation, "Next is required")
+			} else {
+				next = append(next, target)
+			}
+
+			v.validateChoiceRule(choiceLocation, rule, true)
+		}
+
+		if target, ok := state["Default"].(string); ok {
+			next = append(next, target)
+		}
+	case stateTypeFail, stateTypeSucceed:
+		if hasNext || end {
+			v.errorf(location, "%s states must not specify Next or End", stateType)
+		}
+
+		isTerminal = true
+	case stateTypeMap, stateTypeParallel, stateTypePass, stateTypeTask, stateTypeWait:
+		switch {
+		case hasNext && end:
+			v.errorf(location, "only one of Next or End may be specified")
+		case hasNext:
+			next = append(next, nextState)
+		case end:
+			isTerminal = true
+		default:
+			v.errorf(location, "one of Next or End must be specified")
+		}
+	case "":
+		v.errorf(location, "Type is required")
+		return nil, false
+	default:
+		v.errorf(location, "unsupported state Type %q", stateType)
+		return nil, false
+	}
+
+	switch stateType {
+	case stateTypeMap:
+		processor, ok := state["ItemProcessor"].(map[string]interface{})
+		if !ok {
+			// Iterator is the deprecated name for ItemProcessor.
+			processor, ok = state["Iterator"].(map[string]interface{})
+		}
+
+		if !ok {
+			v.errorf(location, "ItemProcessor is required")
+		} else {
+			v.validateDefinition(location+".ItemProcessor", processor, false)
+		}
+	case stateTypeParallel:
+		branches, ok := state["Branches"].([]interface{})
+		if !ok || len(branches) == 0 {
+			v.errorf(location, "Branches must be a non-empty array")
+		}
+
+		for i, branch := range branches {
+			branchLocation := fmt.Sprintf("%s.Branches[%d]", location, i)
+
+			if branch, ok := branch.(map[string]interface{}); ok {
+				v.validateDefinition(branchLocation, branch, false)
+			} else {
+				v.errorf(branchLocation, "branch must be an object")
+			}
+		}
+	case stateTypeTask:
+		if resource, _ := state["Resource"].(string); resource == "" {
+			v.errorf(location, "Resource is required")
+		}
+	case stateTypeWait:
+		n := 0
+		for _, k := range []string{"Seconds", "SecondsPath", "Timestamp", "TimestampPath"} {
+			if _, ok := state[k]; ok {
+				n++
+			}
+		}
+
+		if n != 1 {
+			v.errorf(location, "exactly one of Seconds, SecondsPath, Timestamp or TimestampPath must be specified")
+		}
+
+		if timestamp, ok := state["Timestamp"].(string); ok {
+			if _, err := time.Parse(time.RFC3339, timestamp); err != nil {
+				v.errorf(location, "Timestamp %q must be an RFC3339 timestamp", timestamp)
+			}
+		}
+	}
+
+	for _, k := range []string{"CausePath", "ErrorPath", "HeartbeatSecondsPath", "InputPath", "ItemsPath", "OutputPath", "SecondsPath", "TimeoutSecondsPath", "TimestampPath"} {
+		if path, ok := state[k].(string); ok {
+			if err := validateStateMachineJSONPath(path, false); err != nil {
+				v.errorf(location, "%s: %s", k, err)
+			}
+		}
+	}
+
+	if path, ok := state["ResultPath"].(string); ok {
+		if err := validateStateMachineJSONPath(path, true); err != nil {
+			v.errorf(location, "ResultPath: %s", err)
+		}
+	}
+
+	for _, k := range []string{"ItemSelector", "Parameters", "ResultSelector"} {
+		if payload, ok := state[k]; ok {
+			v.validatePayloadTemplate(location+"."+k, payload)
+		}
+	}
+
+	if retriers, ok := state["Retry"].([]interface{}); ok {
+		for i, retrier := range retriers {
+			retrierLocation := fmt.Sprintf("%s.Retry[%d]", location, i)
+
+			if retrier, ok := retrier.(map[string]interface{}); ok {
+				v.validateErrorEquals(retrierLocation, retrier["ErrorEquals"], i == len(retriers)-1)
+			} else {
+				v.errorf(retrierLocation, "retrier must be an object")
+			}
+		}
+	}
+
+	if catchers, ok := state["Catch"].([]interface{}); ok {
+		for i, catcher := range catchers {
+			catcherLocation := fmt.Sprintf("%s.Catch[%d]", location, i)
+
+			catcher, ok := catcher.(map[string]interface{})
+			if !ok {
+				v.errorf(catcherLocation, "catcher must be an object")
+				continue
+			}
+
+			v.validateErrorEquals(catcherLocation, catcher["ErrorEquals"], i == len(catchers)-1)
+
+			if target, _ := catcher["Next"].(string); target == "" {
+				v.errorf(catcherLocation, "Next is required")
+			} else {
+				next = append(next, target)
+			}
+
+			if path, ok := catcher["ResultPath"].(string); ok {
+				if err := validateStateMachineJSONPath(path, true); err != nil {
+					v.errorf(catcherLocation, "ResultPath: %s", err)
+				}
+			}
+		}
+	}
+
+	return next, isTerminal
+}
+
+func (v *stateMachineDefinitionValidator) validateChoiceRule(location string, rule map[string]interface{}, topLevel bool) {
+	if !topLevel {
+		if _, ok := rule["Next"]; ok {
+			v.errorf(location, "Next is only supported in top-level choice rules")
+		}
+	}
+
+	if and, ok := rule["And"]; ok {
+		v.validateChoiceRules(location+".And", and)
+		return
+	}
+
+	if or, ok := rule["Or"]; ok {
+		v.validateChoiceRules(location+".Or", or)
+		return
+	}
+
+	if not, ok := rule["Not"]; ok {
+		if not, ok := not.(map[string]interface{}); ok {
+			v.validateChoiceRule(location+".Not", not, false)
+		} else {
+			v.errorf(location, "Not must be an object")
+		}
+
+		return
+	}
+
+	if variable, ok := rule["Variable"].(string); !ok {
+		v.errorf(location, "Variable is required")
+	} else if err := validateStateMachineJSONPath(variable, false); err != nil {
+		v.errorf(location, "Variable: %s", err)
+	}
+
+	var operators []string
+	for _, operator := range stateMachineChoiceOperators() {
+		if value, ok := rule[operator]; ok {
+			operators = append(operators, operator)
+
+			if strings.HasSuffix(operator, "Path") {
+				if path, ok := value.(string); !ok {
+					v.errorf(location, "%s must be a string", operator)
+				} else if err := validateStateMachineJSONPath(path, false); err != nil {
+					v.errorf(location, "%s: %s", operator, err)
+				}
+			}
+		}
+	}
+
+	if len(operators) != 1 {
+		v.errorf(location, "exactly one comparison operator must be specified, got %v", operators)
+	}
+}
+
+func (v *stateMachineDefinitionValidator) validateChoiceRules(location string, raw interface{}) {
+	rules, ok := raw.([]interface{})
+	if !ok || len(rules) == 0 {
+		v.errorf(location, "must be a non-empty array")
+		return
+	}
+
+	for i, rule := range rules {
+		ruleLocation := fmt.Sprintf("%s[%d]", location, i)
+
+		if rule, ok := rule.(map[string]interface{}); ok {
+			v.validateChoiceRule(ruleLocation, rule, false)
+		} else {
+			v.errorf(ruleLocation, "choice rule must be an object")
+		}
+	}
+}
+
+// validatePayloadTemplate validates that fields whose names end in ".$" have JSONPath or intrinsic function values.
+func (v *stateMachineDefinitionValidator) validatePayloadTemplate(location string, payload interface{}) {
+	switch payload := payload.(type) {
+	case map[string]interface{}:
+		for k, value := range payload {
+			fieldLocation := location + "." + k
+
+			if !strings.HasSuffix(k, ".$") {
+				v.validatePayloadTemplate(fieldLocation, value)
+				continue
+			}
+
+			s, ok := value.(string)
+			switch {
+			case !ok:
+				v.errorf(fieldLocation, "value must be a string")
+			case strings.HasPrefix(s, "$"):
+				if err := validateStateMachineJSONPath(s, false); err != nil {
+					v.errorf(fieldLocation, "%s", err)
+				}
+			case strings.HasPrefix(s, "States."):
+				// Intrinsic function.
+			default:
+				v.errorf(fieldLocation, "value %q must be a JSONPath or an intrinsic function", s)
+			}
+		}
+	case []interface{}:
+		for i, value := range payload {
+			v.validatePayloadTemplate(fmt.Sprintf("%s[%d]", location, i), value)
+		}
+	}
+}
+
+func (v *stateMachineDefinitionValidator) validateErrorEquals(location string, errorEquals interface{}, last bool) {
+	names, ok := errorEquals.([]interface{})
+	if !ok || len(names) == 0 {
+		v.errorf(location, "ErrorEquals must be a non-empty array")
+		return
+	}
+
+	for _, name := range names {
+		name, ok := name.(string)
+		if !ok || name == "" {
+			v.errorf(location, "ErrorEquals values must be non-empty strings")
+			continue
+		}
+
+		if name == "States.ALL" {
+			if len(names) != 1 {
+				v.errorf(location, "States.ALL must be the only error name in ErrorEquals")
+			}
+
+			if !last {
+				v.errorf(location, "States.ALL must only appear in the last retrier or catcher")
+			}
+
+			continue
+		}
+
+		if strings.HasPrefix(name, "States.") && !isStateMachineErrorName(name) {
+			v.errorf(location, "unknown predefined error name %q", name)
+		}
+	}
+}
+
+func isStateMachineErrorName(name string) bool {
+	for _, v := range stateMachineErrorNames {
+		if v == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func joinStateMachineLocation(location, element string) string {
+	if location == "" {
+		return element
+	}
+
+	return location + "." + element
+}
+
+var (
+	stateMachineJSONPathNameRegexp   = regexache.MustCompile(`^[^.\[\]\s]+`)
+	stateMachineJSONPathIndexRegexp  = regexache.MustCompile(`^-?[0-9]+$`)
+	stateMachineJSONPathSliceRegexp  = regexache.MustCompile(`^(-?[0-9]+)?:(-?[0-9]+)?(:-?[0-9]+)?$`)
+	stateMachineJSONPathQuotedRegexp = regexache.MustCompile(`^('[^']*'|"[^"]*")$`)
+)
+
+// validateStateMachineJSONPath checks the syntax of a JSONPath expression.
+// Reference paths, such as ResultPath, may only identify a single node and so may only
+// contain dot notation, quoted bracket notation and non-negative array indexes.
+// See https://states-language.net/spec.html#path.
+func validateStateMachineJSONPath(path string, reference bool) error {
+	if !strings.HasPrefix(path, "$") {
+		return fmt.Errorf("JSONPath %q must begin with \"$\"", path)
+	}
+
+	rest := path[1:]
+	if strings.HasPrefix(rest, "$") {
+		if reference {
+			return fmt.Errorf("reference path %q must not refer to the context object", path)
+		}
+
+		rest = rest[1:]
+	}
+
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+
+			if strings.HasPrefix(rest, ".") {
+				if reference {
+					return fmt.Errorf("reference path %q must not contain the \"..\" operator", path)
+				}
+
+				rest = rest[1:]
+			}
+
+			if strings.HasPrefix(rest, "*") {
+				if reference {
+					return fmt.Errorf("reference path %q must not contain wildcards", path)
+				}
+
+				rest = rest[1:]
+				continue
+			}
+
+			name := stateMachineJSONPathNameRegexp.FindString(rest)
+			if name == "" {
+				return fmt.Errorf("JSONPath %q has an empty field name", path)
+			}
+
+			rest = rest[len(name):]
+		case '[':
+			end := indexStateMachineJSONPathBracket(rest)
+			if end < 0 {
+				return fmt.Errorf("JSONPath %q has an unterminated \"[\"", path)
+			}
+
+			expr := strings.TrimSpace(rest[1:end])
+			rest = rest[end+1:]
+
+			switch {
+			case stateMachineJSONPathQuotedRegexp.MatchString(expr):
+			case stateMachineJSONPathIndexRegexp.MatchString(expr):
+				if reference && strings.HasPrefix(expr, "-") {
+					return fmt.Errorf("reference path %q must not contain negative array indexes", path)
+				}
+			case reference:
+				return fmt.Errorf("reference path %q must only contain field names and array indexes", path)
+			case expr == "*":
+			case strings.HasPrefix(expr, "?(") && strings.HasSuffix(expr, ")"):
+			case strings.HasPrefix(expr, "(") && strings.HasSuffix(expr, ")"):
+			case stateMachineJSONPathSliceRegexp.MatchString(expr):
+			case strings.Contains(expr, ","):
+				for _, v := range strings.Split(expr, ",") {
+					v = strings.TrimSpace(v)
+					if !stateMachineJSONPathIndexRegexp.MatchString(v) && !stateMachineJSONPathQuotedRegexp.MatchString(v) {
+						return fmt.Errorf("JSONPath %q has an invalid union element %q", path, v)
+					}
+				}
+			default:
+				return fmt.Errorf("JSONPath %q has an invalid bracket expression %q", path, expr)
+			}
+		default:
+			return fmt.Errorf("JSONPath %q has an unexpected character %q at offset %d", path, rest[0], len(path)-len(rest))
+		}
+	}
+
+	return nil
+}
+
+// indexStateMachineJSONPathBracket returns the index of the "]" that closes the "[" at the start of s.
+// Quoted strings and parenthesized filter expressions may contain "]".
+func indexStateMachineJSONPathBracket(s string) int {
+	var quote byte
+	depth := 0
+
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == ']' && depth == 0:
+			return i
+		}
+	}
+
+	return -1
+}
+
+// parseStateMachineChoiceValue converts a Choice rule comparison value from its string representation
+// to the JSON type expected by the comparison operator.
+func parseStateMachineChoiceValue(operator, value string) (interface{}, error) {
+	switch {
+	case strings.HasSuffix(operator, "Path"), strings.HasPrefix(operator, "String"), strings.HasPrefix(operator, "Timestamp"):
+		return value, nil
+	case strings.HasPrefix(operator, "Numeric"):
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s value %q must be a number", operator, value)
+		}
+
+		return v, nil
+	case strings.HasPrefix(operator, "Boolean"), strings.HasPrefix(operator, "Is"):
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s value %q must be a boolean", operator, value)
+		}
+
+		return v, nil
+	}
+
+	return nil, fmt.Errorf("unsupported comparison operator %q", operator)
+}
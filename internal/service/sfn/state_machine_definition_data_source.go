@@ -0,0 +1,656 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
+)
+
+// @SDKDataSource("aws_sfn_state_machine_definition")
+func DataSourceStateMachineDefinition() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceStateMachineDefinitionRead,
+
+		Schema: map[string]*schema.Schema{
+			"choice_state": stateMachineDefinitionStateSchema(stateTypeChoice),
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"fail_state": stateMachineDefinitionStateSchema(stateTypeFail),
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"map_state":      stateMachineDefinitionStateSchema(stateTypeMap),
+			"parallel_state": stateMachineDefinitionStateSchema(stateTypeParallel),
+			"pass_state":     stateMachineDefinitionStateSchema(stateTypePass),
+			"start_at": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"succeed_state": stateMachineDefinitionStateSchema(stateTypeSucceed),
+			"task_state":    stateMachineDefinitionStateSchema(stateTypeTask),
+			"timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"wait_state": stateMachineDefinitionStateSchema(stateTypeWait),
+		},
+	}
+}
+
+// stateMachineDefinitionStateBlocks maps configuration block names to state types.
+var stateMachineDefinitionStateBlocks = map[string]string{
+	"choice_state":   stateTypeChoice,
+	"fail_state":     stateTypeFail,
+	"map_state":      stateTypeMap,
+	"parallel_state": stateTypeParallel,
+	"pass_state":     stateTypePass,
+	"succeed_state":  stateTypeSucceed,
+	"task_state":     stateTypeTask,
+	"wait_state":     stateTypeWait,
+}
+
+// stateMachineDefinitionStateSchema returns the configuration block schema for the specified state type.
+// Only the fields supported by the state type are included.
+// See https://states-language.net/spec.html#state-type-table.
+func stateMachineDefinitionStateSchema(stateType string) *schema.Schema {
+	jsonString := func() *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+		}
+	}
+	optionalString := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		}
+	}
+	errorEquals := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		}
+	}
+
+	s := map[string]*schema.Schema{
+		"comment": optionalString(),
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 80),
+		},
+	}
+
+	switch stateType {
+	case stateTypeFail, stateTypeSucceed, stateTypeChoice:
+	default:
+		s["end"] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		}
+		s["next"] = optionalString()
+	}
+
+	if stateType != stateTypeFail {
+		s["input_path"] = optionalString()
+		s["output_path"] = optionalString()
+	}
+
+	switch stateType {
+	case stateTypeMap, stateTypeParallel, stateTypePass, stateTypeTask:
+		s["parameters"] = jsonString()
+		s["result_path"] = optionalString()
+	}
+
+	switch stateType {
+	case stateTypeMap, stateTypeParallel, stateTypeTask:
+		s["catch"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"error_equals": errorEquals(),
+					"next": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"result_path": optionalString(),
+				},
+			},
+		}
+		s["result_selector"] = jsonString()
+		s["retry"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"backoff_rate": {
+						Type:         schema.TypeFloat,
+						Optional:     true,
+						ValidateFunc: validation.FloatAtLeast(1),
+					},
+					"error_equals": errorEquals(),
+					"interval_seconds": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					"jitter_strategy": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"FULL", "NONE"}, false),
+					},
+					"max_attempts": {
+						Type:         nullable.TypeNullableInt,
+						Optional:     true,
+						ValidateFunc: nullable.ValidateTypeStringNullableIntAtLeast(0),
+					},
+					"max_delay_seconds": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		}
+	}
+
+	switch stateType {
+	case stateTypeChoice:
+		s["default"] = optionalString()
+		s["rule"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"combinator": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"And", "Not", "Or"}, false),
+					},
+					"condition": {
+						Type:     schema.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"operator": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice(stateMachineChoiceOperators(), false),
+								},
+								"value": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"variable": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+							},
+						},
+					},
+					"next": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		}
+	case stateTypeFail:
+		s["cause"] = optionalString()
+		s["cause_path"] = optionalString()
+		s["error"] = optionalString()
+		s["error_path"] = optionalString()
+	case stateTypeMap:
+		s["item_processor"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"definition": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsJSON,
+					},
+					"execution_type": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(sfn.StateMachineType_Values(), false),
+					},
+					"mode": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"DISTRIBUTED", "INLINE"}, false),
+					},
+				},
+			},
+		}
+		s["item_reader"] = jsonString()
+		s["item_selector"] = jsonString()
+		s["items_path"] = optionalString()
+		s["max_concurrency"] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		}
+		s["result_writer"] = jsonString()
+		s["tolerated_failure_count"] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		}
+		s["tolerated_failure_percentage"] = &schema.Schema{
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			ValidateFunc: validation.FloatBetween(0, 100),
+		}
+	case stateTypeParallel:
+		s["branch"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"definition": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsJSON,
+					},
+				},
+			},
+		}
+	case stateTypePass:
+		s["result"] = jsonString()
+	case stateTypeTask:
+		s["heartbeat_seconds"] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		}
+		s["heartbeat_seconds_path"] = optionalString()
+		s["resource"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		}
+		s["timeout_seconds"] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		}
+		s["timeout_seconds_path"] = optionalString()
+	case stateTypeWait:
+		s["seconds"] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		}
+		s["seconds_path"] = optionalString()
+		s["timestamp"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		}
+		s["timestamp_path"] = optionalString()
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: s,
+		},
+	}
+}
+
+func dataSourceStateMachineDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	definition := map[string]interface{}{
+		"StartAt": d.Get("start_at").(string),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		definition["Comment"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("timeout_seconds"); ok {
+		definition["TimeoutSeconds"] = v.(int)
+	}
+
+	if v, ok := d.GetOk("version"); ok {
+		definition["Version"] = v.(string)
+	}
+
+	states := make(map[string]interface{})
+
+	for k, stateType := range stateMachineDefinitionStateBlocks {
+		for _, tfMapRaw := range d.Get(k).([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			name := tfMap["name"].(string)
+
+			if _, ok := states[name]; ok {
+				return diag.Errorf("building Step Functions state machine definition: duplicate state name %q", name)
+			}
+
+			state, err := expandStateMachineDefinitionState(stateType, tfMap)
+			if err != nil {
+				return diag.Errorf("building Step Functions state machine definition: state %q: %s", name, err)
+			}
+
+			states[name] = state
+		}
+	}
+
+	definition["States"] = states
+
+	var diags diag.Diagnostics
+
+	for _, err := range validateStateMachineDefinition(definition) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid Step Functions state machine definition",
+			Detail:   err.Error(),
+		})
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	// Don't escape characters such as '<', '>' and '&' that may appear in JSONPath filter expressions.
+	var b bytes.Buffer
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(definition); err != nil {
+		return diag.Errorf("building Step Functions state machine definition: %s", err)
+	}
+
+	jsonString := string(bytes.TrimSpace(b.Bytes()))
+
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+	d.Set("json", jsonString)
+
+	return diags
+}
+
+// expandStateMachineDefinitionState returns the Amazon States Language representation of a state configuration block.
+// Only the keys supported by the state type's schema are present in tfMap.
+func expandStateMachineDefinitionState(stateType string, tfMap map[string]interface{}) (map[string]interface{}, error) {
+	state := map[string]interface{}{
+		"Type": stateType,
+	}
+
+	for k, field := range map[string]string{
+		"cause":                  "Cause",
+		"cause_path":             "CausePath",
+		"comment":                "Comment",
+		"default":                "Default",
+		"error":                  "Error",
+		"error_path":             "ErrorPath",
+		"heartbeat_seconds_path": "HeartbeatSecondsPath",
+		"input_path":             "InputPath",
+		"items_path":             "ItemsPath",
+		"next":                   "Next",
+		"output_path":            "OutputPath",
+		"resource":               "Resource",
+		"result_path":            "ResultPath",
+		"seconds_path":           "SecondsPath",
+		"timeout_seconds_path":   "TimeoutSecondsPath",
+		"timestamp":              "Timestamp",
+		"timestamp_path":         "TimestampPath",
+	} {
+		if v, ok := tfMap[k].(string); ok && v != "" {
+			state[field] = v
+		}
+	}
+
+	for k, field := range map[string]string{
+		"heartbeat_seconds":       "HeartbeatSeconds",
+		"max_concurrency":         "MaxConcurrency",
+		"seconds":                 "Seconds",
+		"timeout_seconds":         "TimeoutSeconds",
+		"tolerated_failure_count": "ToleratedFailureCount",
+	} {
+		if v, ok := tfMap[k].(int); ok && v != 0 {
+			state[field] = v
+		}
+	}
+
+	if v, ok := tfMap["tolerated_failure_percentage"].(float64); ok && v != 0 {
+		state["ToleratedFailurePercentage"] = v
+	}
+
+	if v, ok := tfMap["end"].(bool); ok && v {
+		state["End"] = true
+	}
+
+	for k, field := range map[string]string{
+		"item_reader":     "ItemReader",
+		"item_selector":   "ItemSelector",
+		"parameters":      "Parameters",
+		"result":          "Result",
+		"result_selector": "ResultSelector",
+		"result_writer":   "ResultWriter",
+	} {
+		if v, ok := tfMap[k].(string); ok && v != "" {
+			var value interface{}
+			if err := json.Unmarshal([]byte(v), &value); err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+
+			state[field] = value
+		}
+	}
+
+	if v, ok := tfMap["retry"].([]interface{}); ok && len(v) > 0 {
+		state["Retry"] = expandStateMachineDefinitionRetriers(v)
+	}
+
+	if v, ok := tfMap["catch"].([]interface{}); ok && len(v) > 0 {
+		state["Catch"] = expandStateMachineDefinitionCatchers(v)
+	}
+
+	if v, ok := tfMap["rule"].([]interface{}); ok && len(v) > 0 {
+		choices, err := expandStateMachineDefinitionChoiceRules(v)
+		if err != nil {
+			return nil, err
+		}
+
+		state["Choices"] = choices
+	}
+
+	if v, ok := tfMap["branch"].([]interface{}); ok && len(v) > 0 {
+		var branches []interface{}
+
+		for i, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			var branch map[string]interface{}
+			if err := json.Unmarshal([]byte(tfMap["definition"].(string)), &branch); err != nil {
+				return nil, fmt.Errorf("branch %d: %w", i, err)
+			}
+
+			branches = append(branches, branch)
+		}
+
+		state["Branches"] = branches
+	}
+
+	if v, ok := tfMap["item_processor"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		var processor map[string]interface{}
+		if err := json.Unmarshal([]byte(tfMap["definition"].(string)), &processor); err != nil {
+			return nil, fmt.Errorf("item_processor: %w", err)
+		}
+
+		processorConfig := make(map[string]interface{})
+
+		if v, ok := tfMap["execution_type"].(string); ok && v != "" {
+			processorConfig["ExecutionType"] = v
+		}
+
+		if v, ok := tfMap["mode"].(string); ok && v != "" {
+			processorConfig["Mode"] = v
+		}
+
+		if len(processorConfig) > 0 {
+			processor["ProcessorConfig"] = processorConfig
+		}
+
+		state["ItemProcessor"] = processor
+	}
+
+	return state, nil
+}
+
+func expandStateMachineDefinitionRetriers(tfList []interface{}) []interface{} {
+	var apiObjects []interface{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := map[string]interface{}{
+			"ErrorEquals": tfMap["error_equals"].([]interface{}),
+		}
+
+		if v, ok := tfMap["backoff_rate"].(float64); ok && v != 0 {
+			apiObject["BackoffRate"] = v
+		}
+
+		if v, ok := tfMap["interval_seconds"].(int); ok && v != 0 {
+			apiObject["IntervalSeconds"] = v
+		}
+
+		if v, ok := tfMap["jitter_strategy"].(string); ok && v != "" {
+			apiObject["JitterStrategy"] = v
+		}
+
+		if v, null, _ := nullable.Int(tfMap["max_attempts"].(string)).Value(); !null {
+			apiObject["MaxAttempts"] = v
+		}
+
+		if v, ok := tfMap["max_delay_seconds"].(int); ok && v != 0 {
+			apiObject["MaxDelaySeconds"] = v
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandStateMachineDefinitionCatchers(tfList []interface{}) []interface{} {
+	var apiObjects []interface{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := map[string]interface{}{
+			"ErrorEquals": tfMap["error_equals"].([]interface{}),
+			"Next":        tfMap["next"].(string),
+		}
+
+		if v, ok := tfMap["result_path"].(string); ok && v != "" {
+			apiObject["ResultPath"] = v
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandStateMachineDefinitionChoiceRules(tfList []interface{}) ([]interface{}, error) {
+	var apiObjects []interface{}
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var conditions []interface{}
+		for _, tfMapRaw := range tfMap["condition"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			operator := tfMap["operator"].(string)
+			value, err := parseStateMachineChoiceValue(operator, tfMap["value"].(string))
+			if err != nil {
+				return nil, fmt.Errorf("rule %d: %w", i, err)
+			}
+
+			conditions = append(conditions, map[string]interface{}{
+				"Variable": tfMap["variable"].(string),
+				operator:   value,
+			})
+		}
+
+		var apiObject map[string]interface{}
+
+		switch combinator := tfMap["combinator"].(string); combinator {
+		case "":
+			if len(conditions) != 1 {
+				return nil, fmt.Errorf("rule %d: a combinator is required for multiple conditions", i)
+			}
+
+			apiObject = conditions[0].(map[string]interface{})
+		case "Not":
+			if len(conditions) != 1 {
+				return nil, fmt.Errorf("rule %d: the Not combinator requires exactly one condition", i)
+			}
+
+			apiObject = map[string]interface{}{combinator: conditions[0]}
+		default:
+			apiObject = map[string]interface{}{combinator: conditions}
+		}
+
+		apiObject["Next"] = tfMap["next"].(string)
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSFNStateMachineDefinitionDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_sfn_state_machine_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineDefinitionDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", `{
+  "Comment": "Example",
+  "StartAt": "Check",
+  "States": {
+    "Check": {
+      "Type": "Choice",
+      "Choices": [
+        {"And": [{"Variable": "$.count", "NumericGreaterThan": 10}, {"Variable": "$.enabled", "BooleanEquals": true}], "Next": "Process"}
+      ],
+      "Default": "Done"
+    },
+    "Process": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Parameters": {"FunctionName": "example", "Payload.$": "$"},
+      "ResultPath": "$.result",
+      "Retry": [{"ErrorEquals": ["States.ALL"], "IntervalSeconds": 2, "MaxAttempts": 0}],
+      "Catch": [{"ErrorEquals": ["States.ALL"], "ResultPath": "$.error", "Next": "Failed"}],
+      "Next": "Done"
+    },
+    "Done": {"Type": "Succeed"},
+    "Failed": {"Type": "Fail", "Error": "ProcessingFailed", "Cause": "Processing failed"}
+  }
+}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineDefinitionDataSource_parallel(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_sfn_state_machine_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineDefinitionDataSourceConfig_parallel,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", `{
+  "StartAt": "Fan",
+  "States": {
+    "Fan": {
+      "Type": "Parallel",
+      "Branches": [{"StartAt": "Wait", "States": {"Wait": {"Type": "Wait", "Seconds": 5, "End": true}}}],
+      "Next": "Items"
+    },
+    "Items": {
+      "Type": "Map",
+      "ItemsPath": "$.items",
+      "MaxConcurrency": 2,
+      "ItemProcessor": {"ProcessorConfig": {"Mode": "INLINE"}, "StartAt": "Wait", "States": {"Wait": {"Type": "Wait", "Seconds": 5, "End": true}}},
+      "End": true
+    }
+  }
+}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineDefinitionDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineDefinitionDataSourceConfig_invalid,
+				ExpectError: regexache.MustCompile(`transition target "Dnoe" does not exist`),
+			},
+		},
+	})
+}
+
+const testAccStateMachineDefinitionDataSourceConfig_basic = `
+data "aws_sfn_state_machine_definition" "test" {
+  comment  = "Example"
+  start_at = "Check"
+
+  choice_state {
+    name    = "Check"
+    default = "Done"
+
+    rule {
+      combinator = "And"
+      next       = "Process"
+
+      condition {
+        variable = "$.count"
+        operator = "NumericGreaterThan"
+        value    = "10"
+      }
+
+      condition {
+        variable = "$.enabled"
+        operator = "BooleanEquals"
+        value    = "true"
+      }
+    }
+  }
+
+  task_state {
+    name        = "Process"
+    resource    = "arn:aws:states:::lambda:invoke"
+    parameters  = jsonencode({ FunctionName = "example", "Payload.$" = "$" })
+    result_path = "$.result"
+    next        = "Done"
+
+    retry {
+      error_equals     = ["States.ALL"]
+      interval_seconds = 2
+      max_attempts     = 0
+    }
+
+    catch {
+      error_equals = ["States.ALL"]
+      result_path  = "$.error"
+      next         = "Failed"
+    }
+  }
+
+  succeed_state {
+    name = "Done"
+  }
+
+  fail_state {
+    name  = "Failed"
+    error = "ProcessingFailed"
+    cause = "Processing failed"
+  }
+}
+`
+
+const testAccStateMachineDefinitionDataSourceConfig_parallel = `
+data "aws_sfn_state_machine_definition" "wait" {
+  start_at = "Wait"
+
+  wait_state {
+    name    = "Wait"
+    seconds = 5
+    end     = true
+  }
+}
+
+data "aws_sfn_state_machine_definition" "test" {
+  start_at = "Fan"
+
+  parallel_state {
+    name = "Fan"
+    next = "Items"
+
+    branch {
+      definition = data.aws_sfn_state_machine_definition.wait.json
+    }
+  }
+
+  map_state {
+    name            = "Items"
+    items_path      = "$.items"
+    max_concurrency = 2
+    end             = true
+
+    item_processor {
+      definition = data.aws_sfn_state_machine_definition.wait.json
+      mode       = "INLINE"
+    }
+  }
+}
+`
+
+const testAccStateMachineDefinitionDataSourceConfig_invalid = `
+data "aws_sfn_state_machine_definition" "test" {
+  start_at = "Start"
+
+  pass_state {
+    name = "Start"
+    next = "Dnoe"
+  }
+
+  succeed_state {
+    name = "Done"
+  }
+}
+`
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestValidateStateMachineDefinition(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		definition string
+		want       []string
+	}{
+		"valid": {
+			definition: `{
+  "StartAt": "Check",
+  "States": {
+    "Check": {
+      "Type": "Choice",
+      "Choices": [
+        {"Variable": "$.count", "NumericGreaterThan": 10, "Next": "Process"},
+        {"And": [{"Variable": "$.status", "StringEquals": "skip"}, {"Not": {"Variable": "$.force", "IsPresent": true}}], "Next": "Done"}
+      ],
+      "Default": "Wait"
+    },
+    "Wait": {"Type": "Wait", "SecondsPath": "$.delay", "Next": "Check"},
+    "Process": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Parameters": {"FunctionName": "example", "Payload.$": "$", "Id.$": "States.UUID()", "Nested": {"Token.$": "$$.Task.Token"}},
+      "ResultPath": "$.result",
+      "Retry": [{"ErrorEquals": ["Lambda.ServiceException", "States.Timeout"]}, {"ErrorEquals": ["States.ALL"], "MaxAttempts": 0}],
+      "Catch": [{"ErrorEquals": ["States.ALL"], "ResultPath": "$.error", "Next": "Failed"}],
+      "Next": "Fan"
+    },
+    "Fan": {
+      "Type": "Parallel",
+      "Branches": [{"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true}}}],
+      "Next": "Items"
+    },
+    "Items": {
+      "Type": "Map",
+      "ItemsPath": "$.items[?(@.price < 10)]",
+      "ItemProcessor": {"ProcessorConfig": {"Mode": "INLINE"}, "StartAt": "B", "States": {"B": {"Type": "Succeed"}}},
+      "Next": "Done"
+    },
+    "Done": {"Type": "Succeed"},
+    "Failed": {"Type": "Fail", "Error": "Failed"}
+  }
+}`,
+		},
+		"missing StartAt state": {
+			definition: `{"StartAt": "Missing", "States": {"A": {"Type": "Pass", "End": true}}}`,
+			want: []string{
+				`StartAt state "Missing" does not exist`,
+			},
+		},
+		"missing transition target": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "Bee"}, "B": {"Type": "Succeed"}}}`,
+			want: []string{
+				`States.A: transition target "Bee" does not exist`,
+				`States.B: state is not reachable from StartAt "A"`,
+				`States.A: state can never reach a terminal state`,
+			},
+		},
+		"Next and End": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "arn:aws:states:::sqs:sendMessage", "Next": "B", "End": true}, "B": {"Type": "Succeed", "Next": "A"}}}`,
+			want: []string{
+				"States.A: only one of Next or End may be specified",
+				"States.B: Succeed states must not specify Next or End",
+				`States.B: state is not reachable from StartAt "A"`,
+				"States.A: state can never reach a terminal state",
+			},
+		},
+		"missing Next and End": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass"}}}`,
+			want: []string{
+				"States.A: one of Next or End must be specified",
+				"States.A: state can never reach a terminal state",
+			},
+		},
+		"infinite loop": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Wait", "Seconds": 1, "Next": "B"}, "B": {"Type": "Pass", "Next": "A"}}}`,
+			want: []string{
+				"States.A: state can never reach a terminal state",
+				"States.B: state can never reach a terminal state",
+			},
+		},
+		"branch scope": {
+			definition: `{"StartAt": "P", "States": {"P": {"Type": "Parallel", "Branches": [{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "Done"}}}], "End": true}, "Done": {"Type": "Succeed"}}}`,
+			want: []string{
+				`States.P.Branches[0].States.A: transition target "Done" does not exist`,
+				"States.P.Branches[0].States.A: state can never reach a terminal state",
+				`States.Done: state is not reachable from StartAt "P"`,
+			},
+		},
+		"nested TimeoutSeconds": {
+			definition: `{"StartAt": "M", "States": {"M": {"Type": "Map", "ItemProcessor": {"TimeoutSeconds": 10, "StartAt": "A", "States": {"A": {"Type": "Succeed"}}}, "End": true}}}`,
+			want: []string{
+				"States.M.ItemProcessor: TimeoutSeconds is only supported at the top level",
+			},
+		},
+		"invalid JSONPath": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "InputPath": "input", "ResultPath": "$.items[*]", "Parameters": {"Value.$": "value", "Other.$": "$.a[b"}, "End": true}}}`,
+			want: []string{
+				`States.A: InputPath: JSONPath "input" must begin with "$"`,
+				`States.A: ResultPath: reference path "$.items[*]" must only contain field names and array indexes`,
+				`States.A.Parameters.Other.$: JSONPath "$.a[b" has an unterminated "["`,
+				`States.A.Parameters.Value.$: value "value" must be a JSONPath or an intrinsic function`,
+			},
+		},
+		"invalid choice rule": {
+			definition: `{"StartAt": "C", "States": {"C": {"Type": "Choice", "Choices": [{"Variable": "$.a", "StringEquals": "x", "NumericEquals": 1, "Next": "D"}, {"Not": {"Variable": "$.b", "IsNull": true, "Next": "D"}, "Next": "D"}, {"Variable": "$.c", "StringEqualsPath": "c"}]}, "D": {"Type": "Succeed"}}}`,
+			want: []string{
+				"States.C.Choices[0]: exactly one comparison operator must be specified",
+				"States.C.Choices[1].Not: Next is only supported in top-level choice rules",
+				"States.C.Choices[2]: Next is required",
+				`States.C.Choices[2]: StringEqualsPath: JSONPath "c" must begin with "$"`,
+			},
+		},
+		"invalid error names": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "arn:aws:states:::sqs:sendMessage", "Retry": [{"ErrorEquals": ["States.ALL", "States.Timeout"]}, {"ErrorEquals": ["States.TimeOut"]}], "Catch": [{"ErrorEquals": []}], "End": true}}}`,
+			want: []string{
+				"States.A.Retry[0]: States.ALL must be the only error name in ErrorEquals",
+				"States.A.Retry[0]: States.ALL must only appear in the last retrier or catcher",
+				`States.A.Retry[1]: unknown predefined error name "States.TimeOut"`,
+				"States.A.Catch[0]: ErrorEquals must be a non-empty array",
+				"States.A.Catch[0]: Next is required",
+			},
+		},
+		"invalid wait": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Wait", "Seconds": 1, "Timestamp": "tomorrow", "End": true}}}`,
+			want: []string{
+				"States.A: exactly one of Seconds, SecondsPath, Timestamp or TimestampPath must be specified",
+				`States.A: Timestamp "tomorrow" must be an RFC3339 timestamp`,
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var definition map[string]interface{}
+			if err := json.Unmarshal([]byte(testcase.definition), &definition); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			errs := validateStateMachineDefinition(definition)
+
+			if got, want := len(errs), len(testcase.want); got != want {
+				t.Fatalf("got %d errors %v, want %d", got, errs, want)
+			}
+
+			for _, want := range testcase.want {
+				found := false
+				for _, err := range errs {
+					if strings.Contains(err.Error(), want) {
+						found = true
+						break
+					}
+				}
+
+				if !found {
+					t.Errorf("expected error containing %q, got %v", want, errs)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateStateMachineJSONPath(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		path      string
+		reference bool
+		valid     bool
+	}{
+		{path: "$", valid: true},
+		{path: "$", reference: true, valid: true},
+		{path: "$.a.b[0]['c d']", reference: true, valid: true},
+		{path: "$$.Execution.Id", valid: true},
+		{path: "$$.Execution.Id", reference: true, valid: false},
+		{path: "$..name", valid: true},
+		{path: "$..name", reference: true, valid: false},
+		{path: "$.items[*].id", valid: true},
+		{path: "$.items.*", valid: true},
+		{path: "$.items[-1:]", valid: true},
+		{path: "$.items[0,2]", valid: true},
+		{path: "$.items[?(@.tags[0] == 'a]')]", valid: true},
+		{path: "$.items[-1]", reference: true, valid: false},
+		{path: "$.", valid: false},
+		{path: "$.a[", valid: false},
+		{path: "$.a[b]", valid: false},
+		{path: "$a", valid: false},
+		{path: "a", valid: false},
+	}
+
+	for _, testcase := range testcases {
+		err := validateStateMachineJSONPath(testcase.path, testcase.reference)
+
+		if got, want := err == nil, testcase.valid; got != want {
+			t.Errorf("validateStateMachineJSONPath(%q, %t) = %v, want valid %t", testcase.path, testcase.reference, err, want)
+		}
+	}
+}
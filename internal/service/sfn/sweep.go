@@ -7,13 +7,16 @@
 package sfn
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -21,11 +24,13 @@ func init() {
 		Name: "aws_sfn_activity",
 		F:    sweepActivities,
 	})
+	discovery.Register("aws_sfn_activity", listActivities)
 
 	resource.AddTestSweepers("aws_sfn_state_machine", &resource.Sweeper{
 		Name: "aws_sfn_state_machine",
 		F:    sweepStateMachines,
 	})
+	discovery.Register("aws_sfn_state_machine", listStateMachines)
 }
 
 func sweepActivities(region string) error {
@@ -34,11 +39,33 @@ func sweepActivities(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listActivities(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Step Functions Activity sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Step Functions Activities (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Step Functions Activities (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listActivities(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.SFNConn(ctx)
 	input := &sfn.ListActivitiesInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListActivitiesPagesWithContext(ctx, input, func(page *sfn.ListActivitiesOutput, lastPage bool) bool {
+	err := conn.ListActivitiesPagesWithContext(ctx, input, func(page *sfn.ListActivitiesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -54,35 +81,46 @@ func sweepActivities(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepStateMachines(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	sweepResources, err := listStateMachines(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Step Functions Activity sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping Step Functions State Machine sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing Step Functions Activities (%s): %w", region, err)
+		return fmt.Errorf("error listing Step Functions State Machines (%s): %w", region, err)
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping Step Functions Activities (%s): %w", region, err)
+		return fmt.Errorf("error sweeping Step Functions State Machines (%s): %w", region, err)
 	}
 
 	return nil
 }
 
-func sweepStateMachines(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func listStateMachines(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.SFNConn(ctx)
 	input := &sfn.ListStateMachinesInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListStateMachinesPagesWithContext(ctx, input, func(page *sfn.ListStateMachinesOutput, lastPage bool) bool {
+	err := conn.ListStateMachinesPagesWithContext(ctx, input, func(page *sfn.ListStateMachinesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -98,20 +136,9 @@ func sweepStateMachines(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Step Functions State Machine sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("error listing Step Functions State Machines (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
 	if err != nil {
-		return fmt.Errorf("error sweeping Step Functions State Machines (%s): %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
@@ -109,7 +109,7 @@ func resourceSigningProfilePermissionCreate(ctx context.Context, d *schema.Resou
 		revisionId = aws.ToString(getProfilePermissionsOutput.RevisionId)
 	}
 
-	statementId := create.Name(d.Get("statement_id").(string), d.Get("statement_id_prefix").(string))
+	statementId := create.Name(ctx, d.Get("statement_id").(string), d.Get("statement_id_prefix").(string))
 
 	addProfilePermissionInput := &signer.AddProfilePermissionInput{
 		Action:      aws.String(d.Get("action").(string)),
@@ -7,14 +7,17 @@
 package signer
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/signer"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -22,6 +25,7 @@ func init() {
 		Name: "aws_signer_signing_profile",
 		F:    sweepSigningProfiles,
 	})
+	discovery.Register("aws_signer_signing_profile", listSigningProfiles)
 }
 
 func sweepSigningProfiles(region string) error {
@@ -32,6 +36,25 @@ func sweepSigningProfiles(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
+	sweepResources, err := listSigningProfiles(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Signer Signing Profiles sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error retrieving Signer Signing Profiles: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping Signer Signing Profiles for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listSigningProfiles(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.SignerClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 	input := &signer.ListSigningProfilesInput{}
@@ -40,12 +63,8 @@ func sweepSigningProfiles(region string) error {
 
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Signer Signing Profiles sweep for %s: %s", region, err)
-			return nil
-		}
 		if err != nil {
-			return fmt.Errorf("error retrieving Signer Signing Profiles: %w", err)
+			return nil, err
 		}
 
 		for _, profile := range page.Profiles {
@@ -60,9 +79,5 @@ func sweepSigningProfiles(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("error sweeping Signer Signing Profiles for %s: %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
@@ -7,13 +7,16 @@
 package sns
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -21,6 +24,7 @@ func init() {
 		Name: "aws_sns_platform_application",
 		F:    sweepPlatformApplications,
 	})
+	discovery.Register("aws_sns_platform_application", listPlatformApplications)
 
 	resource.AddTestSweepers("aws_sns_topic", &resource.Sweeper{
 		Name: "aws_sns_topic",
@@ -46,11 +50,13 @@ func init() {
 			"aws_sns_platform_application",
 		},
 	})
+	discovery.Register("aws_sns_topic", listTopics)
 
 	resource.AddTestSweepers("aws_sns_topic_subscription", &resource.Sweeper{
 		Name: "aws_sns_topic_subscription",
 		F:    sweepTopicSubscriptions,
 	})
+	discovery.Register("aws_sns_topic_subscription", listTopicSubscriptions)
 }
 
 func sweepPlatformApplications(region string) error {
@@ -59,11 +65,33 @@ func sweepPlatformApplications(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
+
+	sweepResources, err := listPlatformApplications(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping SNS Platform Applications sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing SNS Platform Applications: %w", err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping SNS Platform Applications (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listPlatformApplications(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &sns.ListPlatformApplicationsInput{}
 	conn := client.SNSConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListPlatformApplicationsPagesWithContext(ctx, input, func(page *sns.ListPlatformApplicationsOutput, lastPage bool) bool {
+	err := conn.ListPlatformApplicationsPagesWithContext(ctx, input, func(page *sns.ListPlatformApplicationsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -79,35 +107,46 @@ func sweepPlatformApplications(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepTopics(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	sweepResources, err := listTopics(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping SNS Platform Applications sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping SNS Topics sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing SNS Platform Applications: %w", err)
+		return fmt.Errorf("error listing SNS Topics: %w", err)
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping SNS Platform Applications (%s): %w", region, err)
+		return fmt.Errorf("error sweeping SNS Topics (%s): %w", region, err)
 	}
 
 	return nil
 }
 
-func sweepTopics(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
-	}
+func listTopics(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &sns.ListTopicsInput{}
 	conn := client.SNSConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListTopicsPagesWithContext(ctx, input, func(page *sns.ListTopicsOutput, lastPage bool) bool {
+	err := conn.ListTopicsPagesWithContext(ctx, input, func(page *sns.ListTopicsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -123,35 +162,46 @@ func sweepTopics(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepTopicSubscriptions(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	sweepResources, err := listTopicSubscriptions(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping SNS Topics sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping SNS Topic Subscriptions sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing SNS Topics: %w", err)
+		return fmt.Errorf("error listing SNS Topic Subscriptions: %w", err)
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping SNS Topics (%s): %w", region, err)
+		return fmt.Errorf("error sweeping SNS Topic Subscriptions (%s): %w", region, err)
 	}
 
 	return nil
 }
 
-func sweepTopicSubscriptions(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
-	}
+func listTopicSubscriptions(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &sns.ListSubscriptionsInput{}
 	conn := client.SNSConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListSubscriptionsPagesWithContext(ctx, input, func(page *sns.ListSubscriptionsOutput, lastPage bool) bool {
+	err := conn.ListSubscriptionsPagesWithContext(ctx, input, func(page *sns.ListSubscriptionsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -172,20 +222,9 @@ func sweepTopicSubscriptions(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping SNS Topic Subscriptions sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("error listing SNS Topic Subscriptions: %w", err)
-	}
-
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
 	if err != nil {
-		return fmt.Errorf("error sweeping SNS Topic Subscriptions (%s): %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
@@ -245,9 +245,9 @@ func resourceTopicCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	var name string
 	fifoTopic := d.Get("fifo_topic").(bool)
 	if fifoTopic {
-		name = create.NameWithSuffix(d.Get("name").(string), d.Get("name_prefix").(string), FIFOTopicNameSuffix)
+		name = create.NameWithSuffix(ctx, d.Get("name").(string), d.Get("name_prefix").(string), FIFOTopicNameSuffix)
 	} else {
-		name = create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+		name = create.Name(ctx, d.Get("name").(string), d.Get("name_prefix").(string))
 	}
 
 	input := &sns.CreateTopicInput{
@@ -390,7 +390,7 @@ func resourceTopicDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceTopicCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+func resourceTopicCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	fifoTopic := diff.Get("fifo_topic").(bool)
 	contentBasedDeduplication := diff.Get("content_based_deduplication").(bool)
 
@@ -400,9 +400,9 @@ func resourceTopicCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, me
 		var name string
 
 		if fifoTopic {
-			name = create.NameWithSuffix(diff.Get("name").(string), diff.Get("name_prefix").(string), FIFOTopicNameSuffix)
+			name = create.NameWithSuffix(ctx, diff.Get("name").(string), diff.Get("name_prefix").(string), FIFOTopicNameSuffix)
 		} else {
-			name = create.Name(diff.Get("name").(string), diff.Get("name_prefix").(string))
+			name = create.Name(ctx, diff.Get("name").(string), diff.Get("name_prefix").(string))
 		}
 
 		var re *regexp.Regexp
@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+// Exports for use in tests only.
+var (
+	FindParametersByPath = findParametersByPath
+)
@@ -0,0 +1,512 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// See https://docs.aws.amazon.com/systems-manager/latest/APIReference/API_GetParameters.html.
+	getParametersMaxNames = 10
+	// See https://docs.aws.amazon.com/systems-manager/latest/APIReference/API_DeleteParameters.html.
+	deleteParametersMaxNames = 10
+	// See https://docs.aws.amazon.com/systems-manager/latest/APIReference/API_ParameterStringFilter.html.
+	parameterStringFilterMaxValues = 50
+
+	// The KMS key used for SecureString parameters if none is specified.
+	parameterDefaultKeyID = "alias/aws/ssm"
+)
+
+// @SDKResource("aws_ssm_parameters")
+func ResourceParameters() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceParametersCreate,
+		ReadWithoutTimeout:   resourceParametersRead,
+		UpdateWithoutTimeout: resourceParametersUpdate,
+		DeleteWithoutTimeout: resourceParametersDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceParametersImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"parameter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+						"key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 1024),
+								validation.StringDoesNotMatch(regexache.MustCompile(`^/|/$`), "must not start or end with a '/'"),
+							),
+						},
+						"tier": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ssm.ParameterTierStandard,
+							ValidateFunc: validation.StringInSlice([]string{ssm.ParameterTierAdvanced, ssm.ParameterTierStandard}, false),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ssm.ParameterTypeString,
+							ValidateFunc: validation.StringInSlice(ssm.ParameterType_Values(), false),
+						},
+						"value": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 1024),
+					validation.StringMatch(regexache.MustCompile(`^/.*[^/]$`), "must start with a '/' and not end with a '/'"),
+				),
+			},
+			"prune": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceParametersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+
+	path := d.Get("path").(string)
+	parameters := expandParameters(d.Get("parameter").(*schema.Set).List())
+
+	for _, name := range parameterNames(parameters) {
+		if err := putParameter(ctx, conn, path, parameters[name], false); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating SSM Parameters (%s): %s", path, err)
+		}
+	}
+
+	if d.Get("prune").(bool) {
+		if err := pruneParameters(ctx, conn, path, parameters); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating SSM Parameters (%s): %s", path, err)
+		}
+	}
+
+	d.SetId(path)
+
+	return append(diags, resourceParametersRead(ctx, d, meta)...)
+}
+
+func resourceParametersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+
+	path := d.Id()
+	managed := expandParameters(d.Get("parameter").(*schema.Set).List())
+
+	var live map[string]*parameterDetail
+	var err error
+
+	// Unmanaged parameters are read so that they are deleted.
+	if d.Get("prune").(bool) {
+		live, err = findParametersByPath(ctx, conn, path)
+	} else {
+		live, err = findParametersByNames(ctx, conn, path, parameterNames(managed))
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Parameters (%s): %s", path, err)
+	}
+
+	// Parameters deleted outside of Terraform are dropped so that they are created again.
+	var tfList []interface{}
+
+	for _, name := range parameterNames(live) {
+		tfList = append(tfList, flattenParameter(live[name], managed[name]))
+	}
+
+	d.Set("path", path)
+	if err := d.Set("parameter", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting parameter: %s", err)
+	}
+
+	return diags
+}
+
+func resourceParametersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+
+	path := d.Id()
+	o, n := d.GetChange("parameter")
+	old := expandParameters(o.(*schema.Set).List())
+	new := expandParameters(n.(*schema.Set).List())
+
+	var remove []string
+
+	for _, name := range parameterNames(old) {
+		if _, ok := new[name]; !ok {
+			remove = append(remove, name)
+		}
+	}
+
+	if err := deleteParameters(ctx, conn, path, remove); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating SSM Parameters (%s): %s", path, err)
+	}
+
+	for _, name := range parameterNames(new) {
+		v, ok := old[name]
+
+		if ok && *v == *new[name] {
+			continue
+		}
+
+		// A parameter can't be downgraded from the advanced tier to the standard tier, it must be deleted and recreated.
+		if ok && v.Tier == ssm.ParameterTierAdvanced && new[name].Tier == ssm.ParameterTierStandard {
+			if err := deleteParameters(ctx, conn, path, []string{name}); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating SSM Parameters (%s): %s", path, err)
+			}
+		}
+
+		if err := putParameter(ctx, conn, path, new[name], true); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM Parameters (%s): %s", path, err)
+		}
+	}
+
+	if d.Get("prune").(bool) {
+		if err := pruneParameters(ctx, conn, path, new); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM Parameters (%s): %s", path, err)
+		}
+	}
+
+	return append(diags, resourceParametersRead(ctx, d, meta)...)
+}
+
+func resourceParametersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+
+	path := d.Id()
+	parameters := expandParameters(d.Get("parameter").(*schema.Set).List())
+
+	log.Printf("[DEBUG] Deleting SSM Parameters (%s)", path)
+	if err := deleteParameters(ctx, conn, path, parameterNames(parameters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSM Parameters (%s): %s", path, err)
+	}
+
+	return diags
+}
+
+// resourceParametersImport adopts all parameters under the path.
+func resourceParametersImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+
+	live, err := findParametersByPath(ctx, conn, d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("reading SSM Parameters (%s): %w", d.Id(), err)
+	}
+
+	var tfList []interface{}
+
+	for _, name := range parameterNames(live) {
+		tfList = append(tfList, flattenParameter(live[name], nil))
+	}
+
+	if err := d.Set("parameter", tfList); err != nil {
+		return nil, fmt.Errorf("setting parameter: %w", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// parameterDetail is a parameter managed by aws_ssm_parameters.
+type parameterDetail struct {
+	// Name is the parameter's name relative to the path.
+	Name        string
+	Description string
+	KeyID       string
+	Tier        string
+	Type        string
+	Value       string
+}
+
+// parameterName returns the full name of a parameter from its path and relative name.
+func parameterName(path, name string) string {
+	return path + "/" + name
+}
+
+// putParameter creates or overwrites a parameter.
+func putParameter(ctx context.Context, conn *ssm.SSM, path string, parameter *parameterDetail, overwrite bool) error {
+	name := parameterName(path, parameter.Name)
+	input := &ssm.PutParameterInput{
+		Description: aws.String(parameter.Description),
+		Name:        aws.String(name),
+		Overwrite:   aws.Bool(overwrite),
+		Tier:        aws.String(parameter.Tier),
+		Type:        aws.String(parameter.Type),
+		Value:       aws.String(parameter.Value),
+	}
+
+	if parameter.KeyID != "" && parameter.Type == ssm.ParameterTypeSecureString {
+		input.KeyId = aws.String(parameter.KeyID)
+	}
+
+	if _, err := conn.PutParameterWithContext(ctx, input); err != nil {
+		return fmt.Errorf("putting SSM Parameter (%s): %w", name, err)
+	}
+
+	return nil
+}
+
+// deleteParameters deletes parameters by relative name, up to 10 at a time.
+// Parameters that don't exist are ignored.
+func deleteParameters(ctx context.Context, conn *ssm.SSM, path string, names []string) error {
+	for _, chunk := range tfslices.Chunks(names, deleteParametersMaxNames) {
+		input := &ssm.DeleteParametersInput{
+			Names: aws.StringSlice(tfslices.ApplyToAll(chunk, func(name string) string {
+				return parameterName(path, name)
+			})),
+		}
+
+		if _, err := conn.DeleteParametersWithContext(ctx, input); err != nil {
+			return fmt.Errorf("deleting SSM Parameters (%s): %w", strings.Join(aws.StringValueSlice(input.Names), ", "), err)
+		}
+	}
+
+	return nil
+}
+
+// pruneParameters deletes the parameters under path that aren't managed.
+func pruneParameters(ctx context.Context, conn *ssm.SSM, path string, managed map[string]*parameterDetail) error {
+	live, err := findParametersByPath(ctx, conn, path)
+
+	if err != nil {
+		return err
+	}
+
+	var names []string
+
+	for _, name := range parameterNames(live) {
+		if _, ok := managed[name]; !ok {
+			names = append(names, name)
+		}
+	}
+
+	return deleteParameters(ctx, conn, path, names)
+}
+
+// findParametersByPath returns all parameters under path, keyed by relative name.
+func findParametersByPath(ctx context.Context, conn *ssm.SSM, path string) (map[string]*parameterDetail, error) {
+	input := &ssm.GetParametersByPathInput{
+		Path:           aws.String(path),
+		Recursive:      aws.Bool(true),
+		WithDecryption: aws.Bool(true),
+	}
+	output := make(map[string]*parameterDetail)
+
+	err := conn.GetParametersByPathPagesWithContext(ctx, input, func(page *ssm.GetParametersByPathOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Parameters {
+			if v == nil {
+				continue
+			}
+
+			name := strings.TrimPrefix(aws.StringValue(v.Name), path+"/")
+			output[name] = &parameterDetail{
+				Name:  name,
+				Type:  aws.StringValue(v.Type),
+				Value: aws.StringValue(v.Value),
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("getting SSM Parameters by path: %w", err)
+	}
+
+	filter := &ssm.ParameterStringFilter{
+		Key:    aws.String("Path"),
+		Option: aws.String("Recursive"),
+		Values: aws.StringSlice([]string{path}),
+	}
+
+	if err := findParametersMetadata(ctx, conn, path, filter, output); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// findParametersByNames returns the parameters under path with the specified relative names, keyed by relative name.
+// Parameters that don't exist are omitted from the result.
+func findParametersByNames(ctx context.Context, conn *ssm.SSM, path string, names []string) (map[string]*parameterDetail, error) {
+	output := make(map[string]*parameterDetail)
+
+	for _, chunk := range tfslices.Chunks(names, getParametersMaxNames) {
+		input := &ssm.GetParametersInput{
+			Names: aws.StringSlice(tfslices.ApplyToAll(chunk, func(name string) string {
+				return parameterName(path, name)
+			})),
+			WithDecryption: aws.Bool(true),
+		}
+
+		page, err := conn.GetParametersWithContext(ctx, input)
+
+		if err != nil {
+			return nil, fmt.Errorf("getting SSM Parameters: %w", err)
+		}
+
+		for _, v := range page.Parameters {
+			if v == nil {
+				continue
+			}
+
+			name := strings.TrimPrefix(aws.StringValue(v.Name), path+"/")
+			output[name] = &parameterDetail{
+				Name:  name,
+				Type:  aws.StringValue(v.Type),
+				Value: aws.StringValue(v.Value),
+			}
+		}
+	}
+
+	for _, chunk := range tfslices.Chunks(parameterNames(output), parameterStringFilterMaxValues) {
+		filter := &ssm.ParameterStringFilter{
+			Key:    aws.String("Name"),
+			Option: aws.String("Equals"),
+			Values: aws.StringSlice(tfslices.ApplyToAll(chunk, func(name string) string {
+				return parameterName(path, name)
+			})),
+		}
+
+		if err := findParametersMetadata(ctx, conn, path, filter, output); err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+// findParametersMetadata adds the description, KMS key and tier of the parameters matching filter to parameters.
+func findParametersMetadata(ctx context.Context, conn *ssm.SSM, path string, filter *ssm.ParameterStringFilter, parameters map[string]*parameterDetail) error {
+	input := &ssm.DescribeParametersInput{
+		ParameterFilters: []*ssm.ParameterStringFilter{filter},
+	}
+
+	err := conn.DescribeParametersPagesWithContext(ctx, input, func(page *ssm.DescribeParametersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Parameters {
+			if v == nil {
+				continue
+			}
+
+			if parameter, ok := parameters[strings.TrimPrefix(aws.StringValue(v.Name), path+"/")]; ok {
+				parameter.Description = aws.StringValue(v.Description)
+				parameter.KeyID = aws.StringValue(v.KeyId)
+				parameter.Tier = aws.StringValue(v.Tier)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("describing SSM Parameters: %w", err)
+	}
+
+	return nil
+}
+
+func expandParameters(tfList []interface{}) map[string]*parameterDetail {
+	parameters := make(map[string]*parameterDetail, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		parameter := &parameterDetail{
+			Description: tfMap["description"].(string),
+			KeyID:       tfMap["key_id"].(string),
+			Name:        tfMap["name"].(string),
+			Tier:        tfMap["tier"].(string),
+			Type:        tfMap["type"].(string),
+			Value:       tfMap["value"].(string),
+		}
+
+		parameters[parameter.Name] = parameter
+	}
+
+	return parameters
+}
+
+// flattenParameter returns the state of a live parameter. The default KMS key isn't reported unless it was configured.
+func flattenParameter(parameter, managed *parameterDetail) map[string]interface{} {
+	keyID := parameter.KeyID
+
+	if keyID == parameterDefaultKeyID && (managed == nil || managed.KeyID == "") {
+		keyID = ""
+	}
+
+	return map[string]interface{}{
+		"description": parameter.Description,
+		"key_id":      keyID,
+		"name":        parameter.Name,
+		"tier":        parameter.Tier,
+		"type":        parameter.Type,
+		"value":       parameter.Value,
+	}
+}
+
+// parameterNames returns the sorted names of parameters.
+func parameterNames(parameters map[string]*parameterDetail) []string {
+	names := maps.Keys(parameters)
+	slices.Sort(names)
+
+	return names
+}
@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+)
+
+func TestAccSSMParameters_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	path := fmt.Sprintf("/%s/%s", t.Name(), sdkacctest.RandString(10))
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_basic(path, "info"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersCount(ctx, path, 3),
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "log_level",
+						"type":  ssm.ParameterTypeString,
+						"tier":  ssm.ParameterTierStandard,
+						"value": "info",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "db/hosts",
+						"type":  ssm.ParameterTypeStringList,
+						"value": "db1,db2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":   "db/password",
+						"type":   ssm.ParameterTypeSecureString,
+						"key_id": "",
+						"value":  "secret",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccParametersConfig_updated(path),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersCount(ctx, path, 2),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":        "log_level",
+						"description": "Log level",
+						"value":       "debug",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "feature/enabled",
+						"tier":  ssm.ParameterTierAdvanced,
+						"value": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_drift(t *testing.T) {
+	ctx := acctest.Context(t)
+	path := fmt.Sprintf("/%s/%s", t.Name(), sdkacctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_basic(path, "info"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersCount(ctx, path, 3),
+					testAccCheckParametersPut(ctx, path+"/log_level", "warn"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_prune(t *testing.T) {
+	ctx := acctest.Context(t)
+	path := fmt.Sprintf("/%s/%s", t.Name(), sdkacctest.RandString(10))
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_basic(path, "info"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParametersPut(ctx, path+"/unmanaged/one", "1"),
+					testAccCheckParametersPut(ctx, path+"/unmanaged/two", "2"),
+					testAccCheckParametersCount(ctx, path, 5),
+				),
+			},
+			{
+				// Unmanaged parameters are left alone.
+				Config: testAccParametersConfig_basic(path, "info"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "3"),
+					testAccCheckParametersCount(ctx, path, 5),
+				),
+			},
+			{
+				Config: testAccParametersConfig_prune(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "prune", "true"),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "1"),
+					testAccCheckParametersCount(ctx, path, 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckParametersDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_parameters" {
+				continue
+			}
+
+			output, err := tfssm.FindParametersByPath(ctx, conn, rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) > 0 {
+				return fmt.Errorf("SSM Parameters (%s) still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckParametersCount(ctx context.Context, path string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn(ctx)
+
+		output, err := tfssm.FindParametersByPath(ctx, conn, path)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output) != count {
+			return fmt.Errorf("SSM Parameters (%s): expected %d parameters, got %d", path, count, len(output))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckParametersPut(ctx context.Context, name, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn(ctx)
+
+		_, err := conn.PutParameterWithContext(ctx, &ssm.PutParameterInput{
+			Name:      aws.String(name),
+			Overwrite: aws.Bool(true),
+			Type:      aws.String(ssm.ParameterTypeString),
+			Value:     aws.String(value),
+		})
+
+		return err
+	}
+}
+
+func testAccParametersConfig_basic(path, logLevel string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = %[1]q
+
+  parameter {
+    name  = "log_level"
+    value = %[2]q
+  }
+
+  parameter {
+    name  = "db/hosts"
+    type  = "StringList"
+    value = "db1,db2"
+  }
+
+  parameter {
+    name  = "db/password"
+    type  = "SecureString"
+    value = "secret"
+  }
+}
+`, path, logLevel)
+}
+
+func testAccParametersConfig_updated(path string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = %[1]q
+
+  parameter {
+    name        = "log_level"
+    description = "Log level"
+    value       = "debug"
+  }
+
+  parameter {
+    name  = "feature/enabled"
+    tier  = "Advanced"
+    value = "true"
+  }
+}
+`, path)
+}
+
+func testAccParametersConfig_prune(path string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path  = %[1]q
+  prune = true
+
+  parameter {
+    name  = "log_level"
+    value = "info"
+  }
+}
+`, path)
+}
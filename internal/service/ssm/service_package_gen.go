@@ -105,6 +105,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				ResourceType:        "Parameter",
 			},
 		},
+		{
+			Factory:  ResourceParameters,
+			TypeName: "aws_ssm_parameters",
+		},
 		{
 			Factory:  ResourcePatchBaseline,
 			TypeName: "aws_ssm_patch_baseline",
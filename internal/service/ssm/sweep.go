@@ -20,11 +20,13 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv1"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -51,6 +53,7 @@ func init() {
 		Name: "aws_ssm_resource_data_sync",
 		F:    sweepResourceDataSyncs,
 	})
+	discovery.Register("aws_ssm_resource_data_sync", listResourceDataSyncs)
 }
 
 func sweepResourceDefaultPatchBaselines(region string) error {
@@ -223,14 +226,34 @@ func sweepResourceDataSyncs(region string) error {
 		return fmt.Errorf("getting client: %w", err)
 	}
 
+	var errs *multierror.Error
+
+	sweepResources, err := listResourceDataSyncs(ctx, client)
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("listing SSM Resource Data Sync for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping SSM Resource Data Sync for %s: %w", region, err))
+	}
+
+	if awsv1.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping SSM Resource Data Sync sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func listResourceDataSyncs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.SSMConn(ctx)
 
 	sweepResources := make([]sweep.Sweepable, 0)
-	var errs *multierror.Error
 
 	input := &ssm_sdkv1.ListResourceDataSyncInput{}
 
-	err = conn.ListResourceDataSyncPagesWithContext(ctx, input, func(page *ssm_sdkv1.ListResourceDataSyncOutput, lastPage bool) bool {
+	err := conn.ListResourceDataSyncPagesWithContext(ctx, input, func(page *ssm_sdkv1.ListResourceDataSyncOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -249,17 +272,8 @@ func sweepResourceDataSyncs(region string) error {
 	})
 
 	if err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("listing SSM Resource Data Sync for %s: %w", region, err))
+		return nil, err
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		errs = multierror.Append(errs, fmt.Errorf("sweeping SSM Resource Data Sync for %s: %w", region, err))
-	}
-
-	if awsv1.SkipSweepError(errs.ErrorOrNil()) {
-		log.Printf("[WARN] Skipping SSM Resource Data Sync sweep for %s: %s", region, errs)
-		return nil
-	}
-
-	return errs.ErrorOrNil()
+	return sweepResources, nil
 }
@@ -7,13 +7,16 @@
 package storagegateway
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -24,16 +27,19 @@ func init() {
 			"aws_storagegateway_file_system_association",
 		},
 	})
+	discovery.Register("aws_storagegateway_gateway", listGateways)
 
 	resource.AddTestSweepers("aws_storagegateway_tape_pool", &resource.Sweeper{
 		Name: "aws_storagegateway_tape_pool",
 		F:    sweepTapePools,
 	})
+	discovery.Register("aws_storagegateway_tape_pool", listTapePools)
 
 	resource.AddTestSweepers("aws_storagegateway_file_system_association", &resource.Sweeper{
 		Name: "aws_storagegateway_file_system_association",
 		F:    sweepFileSystemAssociations,
 	})
+	discovery.Register("aws_storagegateway_file_system_association", listFileSystemAssociations)
 }
 
 func sweepGateways(region string) error {
@@ -42,10 +48,32 @@ func sweepGateways(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listGateways(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Storage Gateway Gateway sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Storage Gateway Gateways (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Storage Gateway Gateways (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listGateways(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.StorageGatewayConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListGatewaysPagesWithContext(ctx, &storagegateway.ListGatewaysInput{}, func(page *storagegateway.ListGatewaysOutput, lastPage bool) bool {
+	err := conn.ListGatewaysPagesWithContext(ctx, &storagegateway.ListGatewaysInput{}, func(page *storagegateway.ListGatewaysOutput, lastPage bool) bool {
 		if len(page.Gateways) == 0 {
 			log.Print("[DEBUG] No Storage Gateway Gateways to sweep")
 			return true
@@ -62,13 +90,29 @@ func sweepGateways(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepTapePools(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	sweepResources, err := listTapePools(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Storage Gateway Gateway sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping Storage Gateway Tape Pool sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing Storage Gateway Gateways (%s): %w", region, err)
+		return fmt.Errorf("error listing Storage Gateway Tape Pools (%s): %w", region, err)
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
@@ -80,16 +124,11 @@ func sweepGateways(region string) error {
 	return nil
 }
 
-func sweepTapePools(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func listTapePools(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.StorageGatewayConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListTapePoolsPagesWithContext(ctx, &storagegateway.ListTapePoolsInput{}, func(page *storagegateway.ListTapePoolsOutput, lastPage bool) bool {
+	err := conn.ListTapePoolsPagesWithContext(ctx, &storagegateway.ListTapePoolsInput{}, func(page *storagegateway.ListTapePoolsOutput, lastPage bool) bool {
 		if len(page.PoolInfos) == 0 {
 			log.Print("[DEBUG] No Storage Gateway Tape Pools to sweep")
 			return true
@@ -106,34 +145,45 @@ func sweepTapePools(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepFileSystemAssociations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	sweepResources, err := listFileSystemAssociations(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Storage Gateway Tape Pool sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping Storage Gateway File System Association sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing Storage Gateway Tape Pools (%s): %w", region, err)
+		return fmt.Errorf("error listing Storage Gateway File System Associations (%s): %w", region, err)
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping Storage Gateway Gateways (%s): %w", region, err)
+		return fmt.Errorf("error sweeping Storage Gateway File System Associations (%s): %w", region, err)
 	}
 
 	return nil
 }
 
-func sweepFileSystemAssociations(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func listFileSystemAssociations(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.StorageGatewayConn(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListFileSystemAssociationsPagesWithContext(ctx, &storagegateway.ListFileSystemAssociationsInput{}, func(page *storagegateway.ListFileSystemAssociationsOutput, lastPage bool) bool {
+	err := conn.ListFileSystemAssociationsPagesWithContext(ctx, &storagegateway.ListFileSystemAssociationsInput{}, func(page *storagegateway.ListFileSystemAssociationsOutput, lastPage bool) bool {
 		if len(page.FileSystemAssociationSummaryList) == 0 {
 			log.Print("[DEBUG] No Storage Gateway File System Associations to sweep")
 			return true
@@ -150,20 +200,9 @@ func sweepFileSystemAssociations(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Storage Gateway File System Association sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("error listing Storage Gateway File System Associations (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
 	if err != nil {
-		return fmt.Errorf("error sweeping Storage Gateway File System Associations (%s): %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
@@ -7,6 +7,7 @@
 package swf
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,8 +15,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/swf"
 	"github.com/aws/aws-sdk-go-v2/service/swf/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -23,6 +26,7 @@ func init() {
 		Name: "aws_swf_domain",
 		F:    sweepDomains,
 	})
+	discovery.Register("aws_swf_domain", listDomains)
 }
 
 func sweepDomains(region string) error {
@@ -31,6 +35,28 @@ func sweepDomains(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listDomains(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping SWF Domain sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing SWF Domains (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping SWF Domains (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listDomains(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.SWFClient(ctx)
 	input := &swf.ListDomainsInput{
 		RegistrationStatus: types.RegistrationStatusRegistered,
@@ -41,13 +67,8 @@ func sweepDomains(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping SWF Domain sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error listing SWF Domains (%s): %w", region, err)
+			return nil, err
 		}
 
 		for _, v := range page.DomainInfos {
@@ -59,11 +80,5 @@ func sweepDomains(region string) error {
 		}
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping SWF Domains (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
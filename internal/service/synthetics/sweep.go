@@ -7,6 +7,7 @@
 package synthetics
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,7 +15,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/synthetics"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -27,6 +30,7 @@ func init() {
 			"aws_cloudwatch_log_group",
 		},
 	})
+	discovery.Register("aws_synthetics_canary", listCanaries)
 }
 
 func sweepCanaries(region string) error {
@@ -35,20 +39,37 @@ func sweepCanaries(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	var sweeperErrs *multierror.Error
+
+	sweepResources, err := listCanaries(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Synthetics Canary sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error retrieving Synthetics Canaries: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Synthetics Canaries: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func listCanaries(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.SyntheticsConn(ctx)
 
 	sweepResources := make([]sweep.Sweepable, 0)
-	var sweeperErrs *multierror.Error
 
 	input := &synthetics.DescribeCanariesInput{}
 	for {
 		output, err := conn.DescribeCanariesWithContext(ctx, input)
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Synthetics Canary sweep for %s: %s", region, err)
-			return nil
-		}
 		if err != nil {
-			return fmt.Errorf("error retrieving Synthetics Canaries: %w", err)
+			return nil, err
 		}
 
 		for _, canary := range output.Canaries {
@@ -68,9 +89,5 @@ func sweepCanaries(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Synthetics Canaries: %w", err))
-	}
-
-	return sweeperErrs.ErrorOrNil()
+	return sweepResources, nil
 }
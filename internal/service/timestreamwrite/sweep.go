@@ -7,14 +7,17 @@
 package timestreamwrite
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -23,11 +26,13 @@ func init() {
 		F:            sweepDatabases,
 		Dependencies: []string{"aws_timestreamwrite_table"},
 	})
+	discovery.Register("aws_timestreamwrite_database", listDatabases)
 
 	resource.AddTestSweepers("aws_timestreamwrite_table", &resource.Sweeper{
 		Name: "aws_timestreamwrite_table",
 		F:    sweepTables,
 	})
+	discovery.Register("aws_timestreamwrite_table", listTables)
 }
 
 func sweepDatabases(region string) error {
@@ -36,6 +41,28 @@ func sweepDatabases(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listDatabases(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Timestream Database sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Timestream Databases (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Timestream Databases (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listDatabases(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &timestreamwrite.ListDatabasesInput{}
 	conn := client.TimestreamWriteClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
@@ -44,13 +71,8 @@ func sweepDatabases(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Timestream Database sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error listing Timestream Databases (%s): %w", region, err)
+			return nil, err
 		}
 
 		for _, v := range page.Databases {
@@ -62,13 +84,7 @@ func sweepDatabases(region string) error {
 		}
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping Timestream Databases (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepTables(region string) error {
@@ -77,6 +93,28 @@ func sweepTables(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listTables(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Timestream Table sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Timestream Tables (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Timestream Tables (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listTables(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &timestreamwrite.ListTablesInput{}
 	conn := client.TimestreamWriteClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
@@ -85,13 +123,8 @@ func sweepTables(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Timestream Table sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error listing Timestream Tables (%s): %w", region, err)
+			return nil, err
 		}
 
 		for _, v := range page.Tables {
@@ -103,11 +136,5 @@ func sweepTables(region string) error {
 		}
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping Timestream Tables (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
@@ -7,14 +7,17 @@
 package transcribe
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/transcribe"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -26,6 +29,7 @@ func init() {
 			"aws_iam_role",
 		},
 	})
+	discovery.Register("aws_transcribe_language_model", listLanguageModels)
 
 	resource.AddTestSweepers("aws_transcribe_medical_vocabulary", &resource.Sweeper{
 		Name: "aws_transcribe_medical_vocabulary",
@@ -34,6 +38,7 @@ func init() {
 			"aws_s3_bucket",
 		},
 	})
+	discovery.Register("aws_transcribe_medical_vocabulary", listMedicalVocabularies)
 
 	resource.AddTestSweepers("aws_transcribe_vocabulary", &resource.Sweeper{
 		Name: "aws_transcribe_vocabulary",
@@ -42,6 +47,7 @@ func init() {
 			"aws_s3_bucket",
 		},
 	})
+	discovery.Register("aws_transcribe_vocabulary", listVocabularies)
 
 	resource.AddTestSweepers("aws_transcribe_vocabulary_filter", &resource.Sweeper{
 		Name: "aws_transcribe_vocabulary_filter",
@@ -50,6 +56,7 @@ func init() {
 			"aws_s3_bucket",
 		},
 	})
+	discovery.Register("aws_transcribe_vocabulary_filter", listVocabularyFilters)
 }
 
 func sweepLanguageModels(region string) error {
@@ -59,6 +66,25 @@ func sweepLanguageModels(region string) error {
 		fmt.Errorf("error getting client: %s", err)
 	}
 
+	sweepResources, err := listLanguageModels(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Println("[WARN] Skipping Transcribe Language Models sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error retrieving Transcribe Language Models: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping Transcribe Language Models for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listLanguageModels(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.TranscribeClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 	in := &transcribe.ListLanguageModelsInput{}
@@ -68,13 +94,8 @@ func sweepLanguageModels(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if awsv2.SkipSweepError(err) {
-			log.Println("[WARN] Skipping Transcribe Language Models sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error retrieving Transcribe Language Models: %w", err)
+			return nil, err
 		}
 
 		for _, model := range page.Models {
@@ -89,11 +110,7 @@ func sweepLanguageModels(region string) error {
 		}
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("error sweeping Transcribe Language Models for %s: %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepMedicalVocabularies(region string) error {
@@ -103,18 +120,33 @@ func sweepMedicalVocabularies(region string) error {
 		fmt.Errorf("error getting client: %s", err)
 	}
 
+	sweepResources, err := listMedicalVocabularies(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Println("[WARN] Skipping Transcribe Medical Vocabularies sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error retrieving Transcribe Medical Vocabularies: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping Transcribe Medical Vocabularies for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listMedicalVocabularies(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.TranscribeClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 	in := &transcribe.ListMedicalVocabulariesInput{}
 
 	for {
 		out, err := conn.ListMedicalVocabularies(ctx, in)
-		if awsv2.SkipSweepError(err) {
-			log.Println("[WARN] Skipping Transcribe Medical Vocabularies sweep for %s: %s", region, err)
-			return nil
-		}
 		if err != nil {
-			return fmt.Errorf("error retrieving Transcribe Medical Vocabularies: %w", err)
+			return nil, err
 		}
 
 		for _, vocab := range out.Vocabularies {
@@ -134,11 +166,7 @@ func sweepMedicalVocabularies(region string) error {
 		in.NextToken = out.NextToken
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("error sweeping Transcribe Medical Vocabularies for %s: %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepVocabularies(region string) error {
@@ -148,18 +176,33 @@ func sweepVocabularies(region string) error {
 		fmt.Errorf("error getting client: %s", err)
 	}
 
+	sweepResources, err := listVocabularies(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Println("[WARN] Skipping Transcribe Vocabularies sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error retrieving Transcribe Vocabularies: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping Transcribe Vocabularies for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listVocabularies(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.TranscribeClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 	in := &transcribe.ListVocabulariesInput{}
 
 	for {
 		out, err := conn.ListVocabularies(ctx, in)
-		if awsv2.SkipSweepError(err) {
-			log.Println("[WARN] Skipping Transcribe Vocabularies sweep for %s: %s", region, err)
-			return nil
-		}
 		if err != nil {
-			return fmt.Errorf("error retrieving Transcribe Vocabularies: %w", err)
+			return nil, err
 		}
 
 		for _, vocab := range out.Vocabularies {
@@ -179,11 +222,7 @@ func sweepVocabularies(region string) error {
 		in.NextToken = out.NextToken
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("error sweeping Transcribe Vocabularies for %s: %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepVocabularyFilters(region string) error {
@@ -193,18 +232,33 @@ func sweepVocabularyFilters(region string) error {
 		fmt.Errorf("error getting client: %s", err)
 	}
 
+	sweepResources, err := listVocabularyFilters(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Println("[WARN] Skipping Transcribe Vocabulary Filter sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error retrieving Transcribe Vocabulary Filters: %w", err)
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		return fmt.Errorf("error sweeping Transcribe Vocabulary Filters for %s: %w", region, err)
+	}
+
+	return nil
+}
+
+func listVocabularyFilters(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.TranscribeClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 	in := &transcribe.ListVocabularyFiltersInput{}
 
 	for {
 		out, err := conn.ListVocabularyFilters(ctx, in)
-		if awsv2.SkipSweepError(err) {
-			log.Println("[WARN] Skipping Transcribe Vocabulary Filter sweep for %s: %s", region, err)
-			return nil
-		}
 		if err != nil {
-			return fmt.Errorf("error retrieving Transcribe Vocabulary Filters: %w", err)
+			return nil, err
 		}
 
 		log.Println(out)
@@ -225,9 +279,5 @@ func sweepVocabularyFilters(region string) error {
 		in.NextToken = out.NextToken
 	}
 
-	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
-		return fmt.Errorf("error sweeping Transcribe Vocabulary Filters for %s: %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
@@ -7,13 +7,16 @@
 package transfer
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -21,6 +24,7 @@ func init() {
 		Name: "aws_transfer_server",
 		F:    sweepServers,
 	})
+	discovery.Register("aws_transfer_server", listServers)
 
 	resource.AddTestSweepers("aws_transfer_workflow", &resource.Sweeper{
 		Name: "aws_transfer_workflow",
@@ -29,6 +33,7 @@ func init() {
 			"aws_transfer_server",
 		},
 	})
+	discovery.Register("aws_transfer_workflow", listWorkflows)
 }
 
 func sweepServers(region string) error {
@@ -37,11 +42,33 @@ func sweepServers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listServers(ctx, client)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Transfer Server sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Transfer Servers (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Transfer Servers (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listServers(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.TransferConn(ctx)
 	input := &transfer.ListServersInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListServersPagesWithContext(ctx, input, func(page *transfer.ListServersOutput, lastPage bool) bool {
+	err := conn.ListServersPagesWithContext(ctx, input, func(page *transfer.ListServersOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -59,35 +86,46 @@ func sweepServers(region string) error {
 		return !lastPage
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepWorkflows(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	sweepResources, err := listWorkflows(ctx, client)
+
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Transfer Server sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping Transfer Workflow sweep for %s: %s", region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing Transfer Servers (%s): %w", region, err)
+		return fmt.Errorf("error listing Transfer Workflows (%s): %w", region, err)
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping Transfer Servers (%s): %w", region, err)
+		return fmt.Errorf("error sweeping Transfer Workflows (%s): %w", region, err)
 	}
 
 	return nil
 }
 
-func sweepWorkflows(region string) error {
-	ctx := sweep.Context(region)
-	client, err := sweep.SharedRegionalSweepClient(ctx, region)
-	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
-	}
+func listWorkflows(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.TransferConn(ctx)
 	input := &transfer.ListWorkflowsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListWorkflowsPagesWithContext(ctx, input, func(page *transfer.ListWorkflowsOutput, lastPage bool) bool {
+	err := conn.ListWorkflowsPagesWithContext(ctx, input, func(page *transfer.ListWorkflowsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -103,20 +141,9 @@ func sweepWorkflows(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Transfer Workflow sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("error listing Transfer Workflows (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
 	if err != nil {
-		return fmt.Errorf("error sweeping Transfer Workflows (%s): %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
@@ -0,0 +1,919 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// This file implements an offline parser for the Cedar policy language, sufficient to statically
+// validate policies and policy templates before they are sent to the Verified Permissions API.
+// See https://docs.cedarpolicy.com/policies/syntax-grammar.html.
+
+// cedarError is a Cedar syntax or validation error at a position in the policy text.
+type cedarError struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e *cedarError) Error() string {
+	if e.Line == 0 {
+		return e.Message
+	}
+
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
+
+type cedarTokenKind int
+
+const (
+	cedarTokenEOF cedarTokenKind = iota
+	cedarTokenIdent
+	cedarTokenString
+	cedarTokenInt
+	cedarTokenPunct
+)
+
+type cedarToken struct {
+	kind   cedarTokenKind
+	text   string // Identifier, punctuation or unquoted string value.
+	line   int
+	column int
+}
+
+func (t cedarToken) String() string {
+	switch t.kind {
+	case cedarTokenEOF:
+		return "end of input"
+	case cedarTokenString:
+		return strconv.Quote(t.text)
+	default:
+		return fmt.Sprintf("%q", t.text)
+	}
+}
+
+// cedarPunctuation lists punctuation tokens, longest first.
+var cedarPunctuation = []string{
+	"::", "==", "!=", "<=", ">=", "&&", "||",
+	"(", ")", "[", "]", "{", "}", ",", ";", ".", ":", "<", ">", "!", "-", "+", "*", "@", "?",
+}
+
+func cedarLex(text string) ([]cedarToken, error) {
+	var tokens []cedarToken
+	line, column := 1, 1
+
+	advance := func(s string) {
+		for _, r := range s {
+			if r == '\n' {
+				line++
+				column = 1
+			} else {
+				column++
+			}
+		}
+	}
+
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+
+		switch {
+		case unicode.IsSpace(r):
+			advance(text[i : i+size])
+			i += size
+
+		case strings.HasPrefix(text[i:], "//"):
+			end := strings.IndexByte(text[i:], '\n')
+			if end < 0 {
+				end = len(text) - i
+			}
+			advance(text[i : i+end])
+			i += end
+
+		case r == '"':
+			value, n, err := cedarUnquote(text[i:])
+			if err != nil {
+				return nil, &cedarError{Line: line, Column: column, Message: err.Error()}
+			}
+			tokens = append(tokens, cedarToken{kind: cedarTokenString, text: value, line: line, column: column})
+			advance(text[i : i+n])
+			i += n
+
+		case r == '_' || unicode.IsLetter(r):
+			j := i
+			for j < len(text) {
+				r, size := utf8.DecodeRuneInString(text[j:])
+				if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+					break
+				}
+				j += size
+			}
+			tokens = append(tokens, cedarToken{kind: cedarTokenIdent, text: text[i:j], line: line, column: column})
+			advance(text[i:j])
+			i = j
+
+		case r >= '0' && r <= '9':
+			j := i
+			for j < len(text) && text[j] >= '0' && text[j] <= '9' {
+				j++
+			}
+			tokens = append(tokens, cedarToken{kind: cedarTokenInt, text: text[i:j], line: line, column: column})
+			advance(text[i:j])
+			i = j
+
+		default:
+			matched := false
+			for _, p := range cedarPunctuation {
+				if strings.HasPrefix(text[i:], p) {
+					tokens = append(tokens, cedarToken{kind: cedarTokenPunct, text: p, line: line, column: column})
+					advance(p)
+					i += len(p)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, &cedarError{Line: line, Column: column, Message: fmt.Sprintf("unexpected character %q", r)}
+			}
+		}
+	}
+
+	tokens = append(tokens, cedarToken{kind: cedarTokenEOF, line: line, column: column})
+
+	return tokens, nil
+}
+
+// cedarUnquote unquotes the Cedar string literal at the start of s, returning the value and the number of bytes consumed.
+// The `\*` escape used in `like` patterns is preserved.
+func cedarUnquote(s string) (string, int, error) {
+	var b strings.Builder
+
+	for i := 1; i < len(s); {
+		switch c := s[i]; c {
+		case '"':
+			return b.String(), i + 1, nil
+		case '\\':
+			if i+1 >= len(s) {
+				return "", 0, fmt.Errorf("unterminated string literal")
+			}
+			switch e := s[i+1]; e {
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case '0':
+				b.WriteByte(0)
+			case '\\', '"', '\'':
+				b.WriteByte(e)
+			case '*':
+				b.WriteString(`\*`)
+			case 'u':
+				end := strings.IndexByte(s[i:], '}')
+				if i+2 >= len(s) || s[i+2] != '{' || end < 0 {
+					return "", 0, fmt.Errorf(`invalid unicode escape in string literal`)
+				}
+				v, err := strconv.ParseUint(s[i+3:i+end], 16, 32)
+				if err != nil || !utf8.ValidRune(rune(v)) {
+					return "", 0, fmt.Errorf(`invalid unicode escape in string literal`)
+				}
+				b.WriteRune(rune(v))
+				i += end + 1
+				continue
+			default:
+				return "", 0, fmt.Errorf(`invalid escape sequence "\%c" in string literal`, e)
+			}
+			i += 2
+		case '\n':
+			return "", 0, fmt.Errorf("unterminated string literal")
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+
+	return "", 0, fmt.Errorf("unterminated string literal")
+}
+
+// cedarEntityUID is a Cedar entity reference such as `PhotoApp::User::"alice"`.
+type cedarEntityUID struct {
+	Type string
+	ID   string
+
+	line, column int
+}
+
+func (e cedarEntityUID) String() string {
+	return fmt.Sprintf("%s::%s", e.Type, strconv.Quote(e.ID))
+}
+
+// cedarScopeConstraint is the constraint on the principal, action or resource in a policy's scope.
+type cedarScopeConstraint struct {
+	Operator string           // "", "==", "in" or "is".
+	Entities []cedarEntityUID // Action scope may contain a list of entities.
+	Slot     string           // "?principal" or "?resource" in a policy template.
+	IsType   string           // Entity type for the "is" operator.
+}
+
+// cedarPolicy is the result of parsing a Cedar policy or policy template.
+type cedarPolicy struct {
+	Effect      string
+	Annotations map[string]string
+	Principal   cedarScopeConstraint
+	Action      cedarScopeConstraint
+	Resource    cedarScopeConstraint
+
+	// Entities lists every entity referenced in the policy, including its conditions.
+	Entities []cedarEntityUID
+	// EntityTypes lists every entity type referenced by `is` operators.
+	EntityTypes []cedarEntityUID
+
+	line, column int
+}
+
+// IsTemplate returns whether the policy contains template slots.
+func (p *cedarPolicy) IsTemplate() bool {
+	return p.Principal.Slot != "" || p.Resource.Slot != ""
+}
+
+// cedarExtensionFunctions lists the Cedar extension constructor functions and their arity.
+var cedarExtensionFunctions = map[string]int{
+	"ip":      1,
+	"decimal": 1,
+}
+
+// cedarMethods lists the Cedar methods and their arity.
+var cedarMethods = map[string]int{
+	"contains":           1,
+	"containsAll":        1,
+	"containsAny":        1,
+	"greaterThan":        1,
+	"greaterThanOrEqual": 1,
+	"isInRange":          1,
+	"isIpv4":             0,
+	"isIpv6":             0,
+	"isLoopback":         0,
+	"isMulticast":        0,
+	"lessThan":           1,
+	"lessThanOrEqual":    1,
+}
+
+var cedarVariables = map[string]bool{
+	"principal": true,
+	"action":    true,
+	"resource":  true,
+	"context":   true,
+}
+
+type cedarParser struct {
+	tokens []cedarToken
+	pos    int
+	policy *cedarPolicy
+}
+
+// parseCedarPolicies parses a policy set, i.e. zero or more Cedar policies each terminated by a semicolon.
+func parseCedarPolicies(text string) ([]*cedarPolicy, error) {
+	tokens, err := cedarLex(text)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p := &cedarParser{tokens: tokens}
+
+	var policies []*cedarPolicy
+
+	for p.peek().kind != cedarTokenEOF {
+		policy, err := p.parsePolicy()
+
+		if err != nil {
+			return nil, err
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+// parseCedarPolicy parses a single Cedar policy or policy template.
+func parseCedarPolicy(text string) (*cedarPolicy, error) {
+	policies, err := parseCedarPolicies(text)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if n := len(policies); n != 1 {
+		return nil, &cedarError{Message: fmt.Sprintf("expected exactly one policy, found %d", n)}
+	}
+
+	return policies[0], nil
+}
+
+func (p *cedarParser) peek() cedarToken {
+	return p.tokens[p.pos]
+}
+
+func (p *cedarParser) next() cedarToken {
+	t := p.tokens[p.pos]
+	if t.kind != cedarTokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *cedarParser) is(text string) bool {
+	t := p.peek()
+	return (t.kind == cedarTokenPunct || t.kind == cedarTokenIdent) && t.text == text
+}
+
+func (p *cedarParser) accept(text string) bool {
+	if p.is(text) {
+		p.next()
+		return true
+	}
+	return false
+}
+
+func (p *cedarParser) errorf(t cedarToken, format string, a ...any) error {
+	return &cedarError{Line: t.line, Column: t.column, Message: fmt.Sprintf(format, a...)}
+}
+
+func (p *cedarParser) expect(text string) error {
+	if t := p.peek(); !p.accept(text) {
+		return p.errorf(t, "expected %q, found %s", text, t)
+	}
+	return nil
+}
+
+func (p *cedarParser) expectIdent() (cedarToken, error) {
+	t := p.next()
+	if t.kind != cedarTokenIdent {
+		return t, p.errorf(t, "expected identifier, found %s", t)
+	}
+	return t, nil
+}
+
+func (p *cedarParser) expectString() (cedarToken, error) {
+	t := p.next()
+	if t.kind != cedarTokenString {
+		return t, p.errorf(t, "expected string literal, found %s", t)
+	}
+	return t, nil
+}
+
+func (p *cedarParser) parsePolicy() (*cedarPolicy, error) {
+	start := p.peek()
+	p.policy = &cedarPolicy{
+		Annotations: make(map[string]string),
+		line:        start.line,
+		column:      start.column,
+	}
+
+	// Annotations, e.g. @id("policy1").
+	for p.is("@") {
+		p.next()
+		name, err := p.expectIdent()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		value, err := p.expectString()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		if _, ok := p.policy.Annotations[name.text]; ok {
+			return nil, p.errorf(name, "duplicate annotation %q", name.text)
+		}
+		p.policy.Annotations[name.text] = value.text
+	}
+
+	effect := p.next()
+	if effect.kind != cedarTokenIdent || (effect.text != "permit" && effect.text != "forbid") {
+		return nil, p.errorf(effect, `expected "permit" or "forbid", found %s`, effect)
+	}
+	p.policy.Effect = effect.text
+
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	var err error
+
+	if p.policy.Principal, err = p.parseScopeVariable("principal"); err != nil {
+		return nil, err
+	}
+	if err := p.expect(","); err != nil {
+		return nil, err
+	}
+	if p.policy.Action, err = p.parseActionScope(); err != nil {
+		return nil, err
+	}
+	if err := p.expect(","); err != nil {
+		return nil, err
+	}
+	if p.policy.Resource, err = p.parseScopeVariable("resource"); err != nil {
+		return nil, err
+	}
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+
+	for p.is("when") || p.is("unless") {
+		p.next()
+		if err := p.expect("{"); err != nil {
+			return nil, err
+		}
+		if err := p.parseExpr(); err != nil {
+			return nil, err
+		}
+		if err := p.expect("}"); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.expect(";"); err != nil {
+		return nil, err
+	}
+
+	return p.policy, nil
+}
+
+// parseScopeVariable parses the principal or resource element of a policy scope.
+func (p *cedarParser) parseScopeVariable(variable string) (cedarScopeConstraint, error) {
+	var c cedarScopeConstraint
+
+	if err := p.expect(variable); err != nil {
+		return c, err
+	}
+
+	switch {
+	case p.is("==") || p.is("in"):
+		c.Operator = p.next().text
+		if err := p.parseScopeTarget(variable, &c); err != nil {
+			return c, err
+		}
+
+	case p.is("is"):
+		p.next()
+		c.Operator = "is"
+		t := p.peek()
+		path, err := p.parsePath()
+		if err != nil {
+			return c, err
+		}
+		c.IsType = path
+		p.policy.EntityTypes = append(p.policy.EntityTypes, cedarEntityUID{Type: path, line: t.line, column: t.column})
+
+		if p.accept("in") {
+			if err := p.parseScopeTarget(variable, &c); err != nil {
+				return c, err
+			}
+		}
+	}
+
+	return c, nil
+}
+
+func (p *cedarParser) parseScopeTarget(variable string, c *cedarScopeConstraint) error {
+	if p.is("?") {
+		q := p.next()
+		slot, err := p.expectIdent()
+		if err != nil {
+			return err
+		}
+		if slot.text != variable {
+			return p.errorf(q, "invalid template slot ?%s in %s scope, expected ?%s", slot.text, variable, variable)
+		}
+		c.Slot = "?" + slot.text
+		return nil
+	}
+
+	uid, err := p.parseEntityUID()
+	if err != nil {
+		return err
+	}
+
+	c.Entities = append(c.Entities, uid)
+
+	return nil
+}
+
+func (p *cedarParser) parseActionScope() (cedarScopeConstraint, error) {
+	var c cedarScopeConstraint
+
+	if err := p.expect("action"); err != nil {
+		return c, err
+	}
+
+	switch {
+	case p.is("=="):
+		c.Operator = p.next().text
+		uid, err := p.parseEntityUID()
+		if err != nil {
+			return c, err
+		}
+		c.Entities = append(c.Entities, uid)
+
+	case p.is("in"):
+		c.Operator = p.next().text
+		if p.accept("[") {
+			for !p.is("]") {
+				uid, err := p.parseEntityUID()
+				if err != nil {
+					return c, err
+				}
+				c.Entities = append(c.Entities, uid)
+				if !p.accept(",") {
+					break
+				}
+			}
+			if err := p.expect("]"); err != nil {
+				return c, err
+			}
+		} else {
+			uid, err := p.parseEntityUID()
+			if err != nil {
+				return c, err
+			}
+			c.Entities = append(c.Entities, uid)
+		}
+
+	case p.is("?"):
+		return c, p.errorf(p.peek(), "template slots are not allowed in the action scope")
+	}
+
+	for _, v := range c.Entities {
+		if v.Type != "Action" && !strings.HasSuffix(v.Type, "::Action") {
+			return c, &cedarError{Line: v.line, Column: v.column, Message: fmt.Sprintf("expected an action entity in the action scope, found %s", v)}
+		}
+	}
+
+	return c, nil
+}
+
+// parsePath parses a (possibly namespaced) name such as `PhotoApp::User`.
+func (p *cedarParser) parsePath() (string, error) {
+	t, err := p.expectIdent()
+	if err != nil {
+		return "", err
+	}
+
+	parts := []string{t.text}
+
+	for p.is("::") && p.tokens[p.pos+1].kind == cedarTokenIdent {
+		p.next()
+		parts = append(parts, p.next().text)
+	}
+
+	return strings.Join(parts, "::"), nil
+}
+
+func (p *cedarParser) parseEntityUID() (cedarEntityUID, error) {
+	t := p.peek()
+
+	path, err := p.parsePath()
+	if err != nil {
+		return cedarEntityUID{}, err
+	}
+
+	if err := p.expect("::"); err != nil {
+		return cedarEntityUID{}, err
+	}
+
+	id, err := p.expectString()
+	if err != nil {
+		return cedarEntityUID{}, err
+	}
+
+	uid := cedarEntityUID{Type: path, ID: id.text, line: t.line, column: t.column}
+	p.policy.Entities = append(p.policy.Entities, uid)
+
+	return uid, nil
+}
+
+func (p *cedarParser) parseExpr() error {
+	if p.accept("if") {
+		if err := p.parseExpr(); err != nil {
+			return err
+		}
+		if err := p.expect("then"); err != nil {
+			return err
+		}
+		if err := p.parseExpr(); err != nil {
+			return err
+		}
+		if err := p.expect("else"); err != nil {
+			return err
+		}
+		return p.parseExpr()
+	}
+
+	return p.parseOr()
+}
+
+func (p *cedarParser) parseOr() error {
+	if err := p.parseAnd(); err != nil {
+		return err
+	}
+	for p.accept("||") {
+		if err := p.parseAnd(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *cedarParser) parseAnd() error {
+	if err := p.parseRelation(); err != nil {
+		return err
+	}
+	for p.accept("&&") {
+		if err := p.parseRelation(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *cedarParser) parseRelation() error {
+	if err := p.parseAdd(); err != nil {
+		return err
+	}
+
+	switch {
+	case p.is("==") || p.is("!=") || p.is("<") || p.is("<=") || p.is(">") || p.is(">=") || p.is("in"):
+		p.next()
+		return p.parseAdd()
+
+	case p.is("has"):
+		p.next()
+		if t := p.next(); t.kind != cedarTokenIdent && t.kind != cedarTokenString {
+			return p.errorf(t, `expected attribute name after "has", found %s`, t)
+		}
+
+	case p.is("like"):
+		p.next()
+		if _, err := p.expectString(); err != nil {
+			return err
+		}
+
+	case p.is("is"):
+		p.next()
+		t := p.peek()
+		path, err := p.parsePath()
+		if err != nil {
+			return err
+		}
+		p.policy.EntityTypes = append(p.policy.EntityTypes, cedarEntityUID{Type: path, line: t.line, column: t.column})
+		if p.accept("in") {
+			return p.parseAdd()
+		}
+	}
+
+	return nil
+}
+
+func (p *cedarParser) parseAdd() error {
+	if err := p.parseMult(); err != nil {
+		return err
+	}
+	for p.is("+") || p.is("-") {
+		p.next()
+		if err := p.parseMult(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *cedarParser) parseMult() error {
+	if err := p.parseUnary(); err != nil {
+		return err
+	}
+	for p.accept("*") {
+		if err := p.parseUnary(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *cedarParser) parseUnary() error {
+	for n := 0; p.is("!") || p.is("-"); n++ {
+		if n == 4 {
+			return p.errorf(p.peek(), "too many unary operators")
+		}
+		p.next()
+	}
+
+	return p.parseMember()
+}
+
+func (p *cedarParser) parseMember() error {
+	if err := p.parsePrimary(); err != nil {
+		return err
+	}
+
+	for {
+		switch {
+		case p.is("."):
+			p.next()
+			name, err := p.expectIdent()
+			if err != nil {
+				return err
+			}
+			if p.is("(") {
+				arity, ok := cedarMethods[name.text]
+				if !ok {
+					return p.errorf(name, "unknown method %q", name.text)
+				}
+				n, err := p.parseArgs()
+				if err != nil {
+					return err
+				}
+				if n != arity {
+					return p.errorf(name, "method %q expects %d argument(s), found %d", name.text, arity, n)
+				}
+			}
+
+		case p.is("["):
+			p.next()
+			if _, err := p.expectString(); err != nil {
+				return err
+			}
+			if err := p.expect("]"); err != nil {
+				return err
+			}
+
+		default:
+			return nil
+		}
+	}
+}
+
+// parseArgs parses a parenthesized argument list, returning the number of arguments.
+func (p *cedarParser) parseArgs() (int, error) {
+	if err := p.expect("("); err != nil {
+		return 0, err
+	}
+
+	n := 0
+
+	for !p.is(")") {
+		if err := p.parseExpr(); err != nil {
+			return 0, err
+		}
+		n++
+		if !p.accept(",") {
+			break
+		}
+	}
+
+	return n, p.expect(")")
+}
+
+func (p *cedarParser) parsePrimary() error {
+	t := p.peek()
+
+	switch t.kind {
+	case cedarTokenInt:
+		p.next()
+		if _, err := strconv.ParseInt(t.text, 10, 64); err != nil {
+			return p.errorf(t, "integer literal %s out of range", t.text)
+		}
+		return nil
+
+	case cedarTokenString:
+		p.next()
+		return nil
+
+	case cedarTokenIdent:
+		switch {
+		case t.text == "true" || t.text == "false":
+			p.next()
+			return nil
+
+		case cedarVariables[t.text] && !(p.tokens[p.pos+1].kind == cedarTokenPunct && p.tokens[p.pos+1].text == "::"):
+			p.next()
+			return nil
+		}
+
+		// Entity reference or extension function call.
+		start := p.pos
+		path, err := p.parsePath()
+		if err != nil {
+			return err
+		}
+
+		if p.is("(") {
+			arity, ok := cedarExtensionFunctions[path]
+			if !ok {
+				return p.errorf(t, "unknown extension function %q", path)
+			}
+			argStart := p.pos
+			n, err := p.parseArgs()
+			if err != nil {
+				return err
+			}
+			if n != arity {
+				return p.errorf(t, "function %q expects %d argument(s), found %d", path, arity, n)
+			}
+			// Validate literal arguments.
+			if n == 1 && p.pos == argStart+3 && p.tokens[argStart+1].kind == cedarTokenString {
+				if err := validateCedarExtensionLiteral(path, p.tokens[argStart+1].text); err != nil {
+					return p.errorf(p.tokens[argStart+1], "%s", err)
+				}
+			}
+			return nil
+		}
+
+		p.pos = start
+
+		_, err = p.parseEntityUID()
+		return err
+
+	case cedarTokenPunct:
+		switch t.text {
+		case "(":
+			p.next()
+			if err := p.parseExpr(); err != nil {
+				return err
+			}
+			return p.expect(")")
+
+		case "[":
+			p.next()
+			for !p.is("]") {
+				if err := p.parseExpr(); err != nil {
+					return err
+				}
+				if !p.accept(",") {
+					break
+				}
+			}
+			return p.expect("]")
+
+		case "{":
+			p.next()
+			for !p.is("}") {
+				if k := p.next(); k.kind != cedarTokenIdent && k.kind != cedarTokenString {
+					return p.errorf(k, "expected record attribute name, found %s", k)
+				}
+				if err := p.expect(":"); err != nil {
+					return err
+				}
+				if err := p.parseExpr(); err != nil {
+					return err
+				}
+				if !p.accept(",") {
+					break
+				}
+			}
+			return p.expect("}")
+
+		case "?":
+			return p.errorf(t, "template slots are only allowed in the policy scope")
+		}
+	}
+
+	return p.errorf(t, "unexpected %s", t)
+}
+
+func validateCedarExtensionLiteral(function, value string) error {
+	switch function {
+	case "ip":
+		if net.ParseIP(value) != nil {
+			return nil
+		}
+		if _, _, err := net.ParseCIDR(value); err == nil {
+			return nil
+		}
+		return fmt.Errorf("invalid IP address or CIDR block %q", value)
+
+	case "decimal":
+		// Up to 4 digits after the decimal point, within the range of a 64-bit integer when scaled.
+		whole, frac, ok := strings.Cut(value, ".")
+		if !ok || frac == "" || len(frac) > 4 || strings.TrimLeft(frac, "0123456789") != "" {
+			return fmt.Errorf("invalid decimal literal %q", value)
+		}
+		if _, err := strconv.ParseInt(whole, 10, 64); err != nil {
+			return fmt.Errorf("invalid decimal literal %q", value)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,394 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// This file implements offline validation of Cedar schemas in JSON format.
+// See https://docs.cedarpolicy.com/schema/json-schema.html.
+
+type cedarSchemaNamespace struct {
+	EntityTypes map[string]cedarSchemaEntityType `json:"entityTypes"`
+	Actions     map[string]cedarSchemaAction     `json:"actions"`
+	CommonTypes map[string]cedarSchemaType       `json:"commonTypes,omitempty"`
+}
+
+type cedarSchemaEntityType struct {
+	MemberOfTypes []string         `json:"memberOfTypes,omitempty"`
+	Shape         *cedarSchemaType `json:"shape,omitempty"`
+}
+
+type cedarSchemaAction struct {
+	AppliesTo *cedarSchemaAppliesTo     `json:"appliesTo,omitempty"`
+	MemberOf  []cedarSchemaActionMember `json:"memberOf,omitempty"`
+}
+
+type cedarSchemaAppliesTo struct {
+	PrincipalTypes []string         `json:"principalTypes,omitempty"`
+	ResourceTypes  []string         `json:"resourceTypes,omitempty"`
+	Context        *cedarSchemaType `json:"context,omitempty"`
+}
+
+type cedarSchemaActionMember struct {
+	ID   string `json:"id"`
+	Type string `json:"type,omitempty"`
+}
+
+type cedarSchemaType struct {
+	Type                 string                     `json:"type"`
+	Element              *cedarSchemaType           `json:"element,omitempty"`
+	Attributes           map[string]cedarSchemaType `json:"attributes,omitempty"`
+	AdditionalAttributes *bool                      `json:"additionalAttributes,omitempty"`
+	Name                 string                     `json:"name,omitempty"`
+	Required             *bool                      `json:"required,omitempty"`
+}
+
+// cedarSchema is a validated Cedar schema.
+type cedarSchema struct {
+	// entityTypes is the set of fully qualified entity type names.
+	entityTypes map[string]struct{}
+	// actions is the set of fully qualified action entity references, e.g. `PhotoApp::Action::"view"`.
+	actions map[string]struct{}
+	// appliesTo maps each action to its allowed principal and resource types, if constrained.
+	appliesTo map[string]*cedarSchemaResolvedAppliesTo
+}
+
+type cedarSchemaResolvedAppliesTo struct {
+	principalTypes map[string]struct{}
+	resourceTypes  map[string]struct{}
+}
+
+var cedarExtensionTypes = map[string]struct{}{
+	"decimal": {},
+	"ipaddr":  {},
+}
+
+func qualifyCedarName(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+
+	return namespace + "::" + name
+}
+
+func cedarActionType(namespace string) string {
+	return qualifyCedarName(namespace, "Action")
+}
+
+// parseCedarSchema parses and validates a Cedar schema in JSON format.
+// All problems found are returned, joined into a single error.
+func parseCedarSchema(text string) (*cedarSchema, error) {
+	var namespaces map[string]cedarSchemaNamespace
+
+	decoder := json.NewDecoder(bytes.NewBufferString(text))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&namespaces); err != nil {
+		return nil, fmt.Errorf("decoding Cedar schema JSON: %w", err)
+	}
+
+	s := &cedarSchema{
+		entityTypes: make(map[string]struct{}),
+		actions:     make(map[string]struct{}),
+		appliesTo:   make(map[string]*cedarSchemaResolvedAppliesTo),
+	}
+	commonTypes := make(map[string]struct{})
+
+	// Collect all declared names before resolving references, as namespaces may refer to each other.
+	for namespace, ns := range namespaces {
+		if namespace != "" && !isValidCedarPath(namespace) {
+			return nil, fmt.Errorf("invalid Cedar schema namespace %q", namespace)
+		}
+
+		for name := range ns.EntityTypes {
+			s.entityTypes[qualifyCedarName(namespace, name)] = struct{}{}
+		}
+		for name := range ns.Actions {
+			s.actions[cedarEntityUID{Type: cedarActionType(namespace), ID: name}.String()] = struct{}{}
+		}
+		for name := range ns.CommonTypes {
+			commonTypes[qualifyCedarName(namespace, name)] = struct{}{}
+		}
+	}
+
+	var errs []error
+
+	// Iterate in a stable order so that errors are reported deterministically.
+	for _, namespace := range sortedKeys(namespaces) {
+		ns := namespaces[namespace]
+		v := &cedarSchemaValidator{
+			schema:      s,
+			namespace:   namespace,
+			commonTypes: commonTypes,
+		}
+
+		for _, name := range sortedKeys(ns.CommonTypes) {
+			if !isValidCedarIdent(name) {
+				v.errorf("invalid common type name %q", name)
+			}
+			typ := ns.CommonTypes[name]
+			v.validateType(fmt.Sprintf("commonTypes.%s", name), &typ)
+		}
+
+		for _, name := range sortedKeys(ns.EntityTypes) {
+			if !isValidCedarIdent(name) {
+				v.errorf("invalid entity type name %q", name)
+			}
+
+			entityType := ns.EntityTypes[name]
+			path := fmt.Sprintf("entityTypes.%s", name)
+
+			for _, member := range entityType.MemberOfTypes {
+				if _, ok := v.resolveEntityType(member); !ok {
+					v.errorf("%s.memberOfTypes: undeclared entity type %q", path, member)
+				}
+			}
+
+			if entityType.Shape != nil {
+				if t := entityType.Shape.Type; t != "Record" && !v.isCommonType(t) {
+					v.errorf("%s.shape: expected type Record, found %q", path, t)
+				}
+				v.validateType(path+".shape", entityType.Shape)
+			}
+		}
+
+		for _, name := range sortedKeys(ns.Actions) {
+			action := ns.Actions[name]
+			path := fmt.Sprintf("actions.%s", name)
+
+			for _, member := range action.MemberOf {
+				typ := member.Type
+				if typ == "" {
+					typ = cedarActionType(namespace)
+				}
+				if _, ok := s.actions[cedarEntityUID{Type: typ, ID: member.ID}.String()]; !ok {
+					v.errorf("%s.memberOf: undeclared action %s", path, cedarEntityUID{Type: typ, ID: member.ID})
+				}
+			}
+
+			if appliesTo := action.AppliesTo; appliesTo != nil {
+				resolved := &cedarSchemaResolvedAppliesTo{}
+
+				if appliesTo.PrincipalTypes != nil {
+					resolved.principalTypes = v.resolveEntityTypes(path+".appliesTo.principalTypes", appliesTo.PrincipalTypes)
+				}
+				if appliesTo.ResourceTypes != nil {
+					resolved.resourceTypes = v.resolveEntityTypes(path+".appliesTo.resourceTypes", appliesTo.ResourceTypes)
+				}
+				if appliesTo.Context != nil {
+					v.validateType(path+".appliesTo.context", appliesTo.Context)
+				}
+
+				s.appliesTo[cedarEntityUID{Type: cedarActionType(namespace), ID: name}.String()] = resolved
+			}
+		}
+
+		for _, err := range v.errs {
+			if namespace != "" {
+				err = fmt.Errorf("namespace %q: %w", namespace, err)
+			}
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return s, nil
+}
+
+type cedarSchemaValidator struct {
+	schema      *cedarSchema
+	namespace   string
+	commonTypes map[string]struct{}
+	errs        []error
+}
+
+func (v *cedarSchemaValidator) errorf(format string, a ...any) {
+	v.errs = append(v.errs, fmt.Errorf(format, a...))
+}
+
+// resolveEntityType resolves an entity type name, which may be unqualified, to its fully qualified name.
+func (v *cedarSchemaValidator) resolveEntityType(name string) (string, bool) {
+	if qualified := qualifyCedarName(v.namespace, name); v.schema.hasEntityType(qualified) {
+		return qualified, true
+	}
+
+	return name, v.schema.hasEntityType(name)
+}
+
+func (v *cedarSchemaValidator) resolveEntityTypes(path string, names []string) map[string]struct{} {
+	resolved := make(map[string]struct{}, len(names))
+
+	for _, name := range names {
+		qualified, ok := v.resolveEntityType(name)
+		if !ok {
+			v.errorf("%s: undeclared entity type %q", path, name)
+			continue
+		}
+		resolved[qualified] = struct{}{}
+	}
+
+	return resolved
+}
+
+func (v *cedarSchemaValidator) isCommonType(name string) bool {
+	if _, ok := v.commonTypes[qualifyCedarName(v.namespace, name)]; ok {
+		return true
+	}
+
+	_, ok := v.commonTypes[name]
+
+	return ok
+}
+
+func (v *cedarSchemaValidator) validateType(path string, t *cedarSchemaType) {
+	switch t.Type {
+	case "String", "Long", "Boolean":
+	case "Set":
+		if t.Element == nil {
+			v.errorf("%s: Set type requires an element type", path)
+		} else {
+			v.validateType(path+".element", t.Element)
+		}
+	case "Record":
+		for _, name := range sortedKeys(t.Attributes) {
+			attribute := t.Attributes[name]
+			v.validateType(path+".attributes."+name, &attribute)
+		}
+	case "Entity":
+		if _, ok := v.resolveEntityType(t.Name); !ok {
+			v.errorf("%s: undeclared entity type %q", path, t.Name)
+		}
+	case "Extension":
+		if _, ok := cedarExtensionTypes[t.Name]; !ok {
+			v.errorf("%s: unknown extension type %q", path, t.Name)
+		}
+	case "":
+		v.errorf("%s: missing type", path)
+	default:
+		if !v.isCommonType(t.Type) {
+			v.errorf("%s: unknown type %q", path, t.Type)
+		}
+	}
+}
+
+func (s *cedarSchema) hasEntityType(name string) bool {
+	_, ok := s.entityTypes[name]
+
+	return ok
+}
+
+func (s *cedarSchema) hasAction(uid cedarEntityUID) bool {
+	_, ok := s.actions[uid.String()]
+
+	return ok
+}
+
+// validatePolicy checks that the entity types and actions referenced by a policy are declared in the schema,
+// and that the policy's scope is consistent with the actions' principal and resource types.
+func (s *cedarSchema) validatePolicy(p *cedarPolicy) []error {
+	var errs []error
+
+	errorf := func(uid cedarEntityUID, format string, a ...any) {
+		errs = append(errs, &cedarError{Line: uid.line, Column: uid.column, Message: fmt.Sprintf(format, a...)})
+	}
+
+	for _, uid := range p.Entities {
+		if uid.Type == "Action" || strings.HasSuffix(uid.Type, "::Action") {
+			if !s.hasAction(uid) {
+				errorf(uid, "undeclared action %s", uid)
+			}
+		} else if !s.hasEntityType(uid.Type) {
+			errorf(uid, "undeclared entity type %q", uid.Type)
+		}
+	}
+
+	for _, uid := range p.EntityTypes {
+		if !s.hasEntityType(uid.Type) {
+			errorf(uid, "undeclared entity type %q", uid.Type)
+		}
+	}
+
+	// Check the principal and resource scope of policies constrained to specific actions.
+	if p.Action.Operator == "" {
+		return errs
+	}
+
+	for _, action := range p.Action.Entities {
+		appliesTo, ok := s.appliesTo[action.String()]
+		if !ok {
+			continue
+		}
+
+		if typ, ok := scopeEntityType(p.Principal); ok && appliesTo.principalTypes != nil {
+			if _, ok := appliesTo.principalTypes[typ]; !ok {
+				errorf(action, "action %s does not apply to principal type %q", action, typ)
+			}
+		}
+		if typ, ok := scopeEntityType(p.Resource); ok && appliesTo.resourceTypes != nil {
+			if _, ok := appliesTo.resourceTypes[typ]; !ok {
+				errorf(action, "action %s does not apply to resource type %q", action, typ)
+			}
+		}
+	}
+
+	return errs
+}
+
+// scopeEntityType returns the entity type that a principal or resource scope constrains the variable to, if any.
+func scopeEntityType(c cedarScopeConstraint) (string, bool) {
+	switch {
+	case c.IsType != "":
+		return c.IsType, true
+	case c.Operator == "==" && len(c.Entities) == 1:
+		return c.Entities[0].Type, true
+	}
+
+	return "", false
+}
+
+func isValidCedarIdent(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i, r := range s {
+		if r != '_' && !(r >= 'a' && r <= 'z') && !(r >= 'A' && r <= 'Z') && !(i > 0 && r >= '0' && r <= '9') {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isValidCedarPath(s string) bool {
+	for _, v := range strings.Split(s, "::") {
+		if !isValidCedarIdent(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
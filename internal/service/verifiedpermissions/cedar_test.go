@@ -0,0 +1,348 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseCedarPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		policy        string
+		expectedError string
+		isTemplate    bool
+	}{
+		{
+			name:   "permit all",
+			policy: `permit (principal, action, resource);`,
+		},
+		{
+			name: "scope and conditions",
+			policy: `
+// Alice can view photos in her vacation album.
+@id("policy1")
+permit (
+  principal == PhotoApp::User::"alice",
+  action in [PhotoApp::Action::"view", PhotoApp::Action::"comment"],
+  resource in PhotoApp::Album::"vacation"
+)
+when {
+  context.authenticated && resource.owner == principal &&
+  context.ip.isInRange(ip("10.0.0.0/8")) && resource has tags && resource.tags.contains("public")
+}
+unless { principal.age < 18 || (if context.mfa then false else principal["level"] >= 2 && decimal("1.5").lessThan(decimal("2.0"))) };
+`,
+		},
+		{
+			name:   "is operator",
+			policy: `forbid (principal is User in Group::"banned", action, resource) when { resource is Photo && resource.name like "*.jpg" };`,
+		},
+		{
+			name:       "template",
+			policy:     `permit (principal == ?principal, action == Action::"view", resource in ?resource);`,
+			isTemplate: true,
+		},
+		{
+			name:          "missing semicolon",
+			policy:        `permit (principal, action, resource)`,
+			expectedError: `line 1, column 37: expected ";", found end of input`,
+		},
+		{
+			name:          "invalid effect",
+			policy:        `allow (principal, action, resource);`,
+			expectedError: `line 1, column 1: expected "permit" or "forbid", found "allow"`,
+		},
+		{
+			name:          "invalid template slot",
+			policy:        `permit (principal == ?resource, action, resource);`,
+			expectedError: `invalid template slot ?resource in principal scope`,
+		},
+		{
+			name:          "slot in condition",
+			policy:        `permit (principal, action, resource) when { principal == ?principal };`,
+			expectedError: `template slots are only allowed in the policy scope`,
+		},
+		{
+			name:          "action scope",
+			policy:        `permit (principal, action == User::"alice", resource);`,
+			expectedError: `expected an action entity in the action scope`,
+		},
+		{
+			name:          "unknown method",
+			policy:        `permit (principal, action, resource) when { principal.tags.has("x") };`,
+			expectedError: `unknown method "has"`,
+		},
+		{
+			name:          "unknown function",
+			policy:        `permit (principal, action, resource) when { datetime("2024-01-01") };`,
+			expectedError: `unknown extension function "datetime"`,
+		},
+		{
+			name:          "invalid ip literal",
+			policy:        `permit (principal, action, resource) when { context.ip == ip("10.0.0.256") };`,
+			expectedError: `invalid IP address or CIDR block "10.0.0.256"`,
+		},
+		{
+			name:          "invalid decimal literal",
+			policy:        `permit (principal, action, resource) when { context.amount == decimal("1.23456") };`,
+			expectedError: `invalid decimal literal "1.23456"`,
+		},
+		{
+			name:          "unterminated string",
+			policy:        `permit (principal == User::"alice, action, resource);`,
+			expectedError: `line 1, column 28: unterminated string literal`,
+		},
+		{
+			name:          "multiple policies",
+			policy:        `permit (principal, action, resource); forbid (principal, action, resource);`,
+			expectedError: `expected exactly one policy, found 2`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			policy, err := parseCedarPolicy(testCase.policy)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error containing %q", testCase.expectedError)
+				}
+				if !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("error = %q, expected error containing %q", err, testCase.expectedError)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := policy.IsTemplate(), testCase.isTemplate; got != want {
+				t.Errorf("IsTemplate() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestParseCedarPolicies(t *testing.T) {
+	t.Parallel()
+
+	policies, err := parseCedarPolicies(`
+permit (principal, action == Action::"view", resource);
+forbid (principal, action, resource) unless { principal in Group::"admins" };
+`)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(policies), 2; got != want {
+		t.Fatalf("len(policies) = %d, want %d", got, want)
+	}
+
+	if got, want := policies[1].Effect, "forbid"; got != want {
+		t.Errorf("Effect = %q, want %q", got, want)
+	}
+
+	if got, want := policies[1].Entities[0].String(), `Group::"admins"`; got != want {
+		t.Errorf("Entities[0] = %s, want %s", got, want)
+	}
+}
+
+const testCedarSchema = `{
+  "PhotoApp": {
+    "commonTypes": {
+      "PersonType": {
+        "type": "Record",
+        "attributes": {
+          "age": {"type": "Long"},
+          "name": {"type": "String", "required": true}
+        }
+      }
+    },
+    "entityTypes": {
+      "User": {
+        "memberOfTypes": ["UserGroup"],
+        "shape": {"type": "PersonType"}
+      },
+      "UserGroup": {},
+      "Photo": {
+        "shape": {
+          "type": "Record",
+          "attributes": {
+            "owner": {"type": "Entity", "name": "User"},
+            "tags": {"type": "Set", "element": {"type": "String"}},
+            "source": {"type": "Extension", "name": "ipaddr"}
+          }
+        }
+      }
+    },
+    "actions": {
+      "viewPhoto": {
+        "appliesTo": {
+          "principalTypes": ["User"],
+          "resourceTypes": ["Photo"],
+          "context": {"type": "Record", "attributes": {"authenticated": {"type": "Boolean"}}}
+        },
+        "memberOf": [{"id": "read"}]
+      },
+      "read": {}
+    }
+  }
+}`
+
+func TestParseCedarSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		schema         string
+		expectedErrors []string
+	}{
+		{
+			name:   "valid",
+			schema: testCedarSchema,
+		},
+		{
+			name:   "empty namespace",
+			schema: `{"": {"entityTypes": {"User": {}}, "actions": {"view": {"appliesTo": {"principalTypes": ["User"]}}}}}`,
+		},
+		{
+			name:           "invalid JSON",
+			schema:         `{"PhotoApp": `,
+			expectedErrors: []string{"decoding Cedar schema JSON"},
+		},
+		{
+			name:           "unknown field",
+			schema:         `{"PhotoApp": {"entityTypes": {}, "actions": {}, "entities": {}}}`,
+			expectedErrors: []string{`unknown field "entities"`},
+		},
+		{
+			name: "undeclared references",
+			schema: `{"PhotoApp": {
+  "entityTypes": {
+    "User": {"memberOfTypes": ["Group"], "shape": {"type": "Record", "attributes": {"manager": {"type": "Entity", "name": "Manager"}}}}
+  },
+  "actions": {
+    "view": {"appliesTo": {"principalTypes": ["User"], "resourceTypes": ["Photo"]}, "memberOf": [{"id": "read"}]}
+  }
+}}`,
+			expectedErrors: []string{
+				`namespace "PhotoApp": entityTypes.User.memberOfTypes: undeclared entity type "Group"`,
+				`namespace "PhotoApp": entityTypes.User.shape.attributes.manager: undeclared entity type "Manager"`,
+				`namespace "PhotoApp": actions.view.memberOf: undeclared action PhotoApp::Action::"read"`,
+				`namespace "PhotoApp": actions.view.appliesTo.resourceTypes: undeclared entity type "Photo"`,
+			},
+		},
+		{
+			name:   "invalid types",
+			schema: `{"": {"entityTypes": {"User": {"shape": {"type": "Set", "element": {"type": "Long"}}}, "Host": {"shape": {"type": "Record", "attributes": {"ip": {"type": "Extension", "name": "ip"}, "x": {"type": "Integer"}}}}}, "actions": {}}}`,
+			expectedErrors: []string{
+				`entityTypes.Host.shape.attributes.ip: unknown extension type "ip"`,
+				`entityTypes.Host.shape.attributes.x: unknown type "Integer"`,
+				`entityTypes.User.shape: expected type Record, found "Set"`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parseCedarSchema(testCase.schema)
+
+			if len(testCase.expectedErrors) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected errors %q", testCase.expectedErrors)
+			}
+
+			for _, expected := range testCase.expectedErrors {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("error = %q, expected error containing %q", err, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestCedarSchemaValidatePolicy(t *testing.T) {
+	t.Parallel()
+
+	schema, err := parseCedarSchema(testCedarSchema)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		name           string
+		policy         string
+		expectedErrors []string
+	}{
+		{
+			name:   "valid",
+			policy: `permit (principal == PhotoApp::User::"alice", action == PhotoApp::Action::"viewPhoto", resource is PhotoApp::Photo);`,
+		},
+		{
+			name:   "valid group",
+			policy: `permit (principal in PhotoApp::UserGroup::"friends", action in PhotoApp::Action::"read", resource);`,
+		},
+		{
+			name:   "unqualified names",
+			policy: `permit (principal == User::"alice", action == Action::"viewPhoto", resource) when { resource is Photo };`,
+			expectedErrors: []string{
+				`line 1, column 22: undeclared entity type "User"`,
+				`line 1, column 47: undeclared action Action::"viewPhoto"`,
+				`line 1, column 97: undeclared entity type "Photo"`,
+			},
+		},
+		{
+			name:   "action does not apply",
+			policy: `permit (principal == PhotoApp::UserGroup::"friends", action == PhotoApp::Action::"viewPhoto", resource == PhotoApp::User::"bob");`,
+			expectedErrors: []string{
+				`line 1, column 64: action PhotoApp::Action::"viewPhoto" does not apply to principal type "PhotoApp::UserGroup"`,
+				`line 1, column 64: action PhotoApp::Action::"viewPhoto" does not apply to resource type "PhotoApp::User"`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			policy, err := parseCedarPolicy(testCase.policy)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			errs := schema.validatePolicy(policy)
+
+			if got, want := len(errs), len(testCase.expectedErrors); got != want {
+				t.Fatalf("got %d errors (%q), want %d", got, errs, want)
+			}
+
+			for i, err := range errs {
+				if got, want := err.Error(), testCase.expectedErrors[i]; got != want {
+					t.Errorf("error %d = %q, want %q", i, got, want)
+				}
+			}
+		})
+	}
+}
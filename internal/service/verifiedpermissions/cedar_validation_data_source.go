@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Cedar Validation")
+func newDataSourceCedarValidation(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceCedarValidation{}, nil
+}
+
+// dataSourceCedarValidation statically validates Cedar policies, and optionally a Cedar schema, without calling AWS.
+// As the validation is offline, problems are reported while planning rather than when the policies are applied.
+type dataSourceCedarValidation struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceCedarValidation) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_cedar_validation"
+}
+
+func (d *dataSourceCedarValidation) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"errors": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"fail_on_error": schema.BoolAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"schema": schema.StringAttribute{
+				Optional: true,
+			},
+			"valid": schema.BoolAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"policy": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrID: schema.StringAttribute{
+							Required: true,
+						},
+						"statement": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceCedarValidation) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceCedarValidationData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var problems []string
+
+	var cedarSchema *cedarSchema
+	if !data.Schema.IsNull() {
+		v, err := parseCedarSchema(data.Schema.ValueString())
+
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("schema: %s", err))
+		}
+
+		cedarSchema = v
+	}
+
+	for _, policy := range data.Policies {
+		id := policy.ID.ValueString()
+		policies, err := parseCedarPolicies(policy.Statement.ValueString())
+
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("policy %q: %s", id, err))
+
+			continue
+		}
+
+		if cedarSchema == nil {
+			continue
+		}
+
+		for _, v := range policies {
+			for _, err := range cedarSchema.validatePolicy(v) {
+				problems = append(problems, fmt.Sprintf("policy %q: %s", id, err))
+			}
+		}
+	}
+
+	if data.FailOnError.ValueBool() {
+		for _, v := range problems {
+			response.Diagnostics.AddAttributeError(path.Root("policy"), "Invalid Cedar", v)
+		}
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	data.Errors = flex.FlattenFrameworkStringValueListLegacy(ctx, problems)
+	data.ID = types.StringValue(d.Meta().Region)
+	data.Valid = types.BoolValue(len(problems) == 0)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceCedarValidationData struct {
+	Errors      types.List              `tfsdk:"errors"`
+	FailOnError types.Bool              `tfsdk:"fail_on_error"`
+	ID          types.String            `tfsdk:"id"`
+	Policies    []cedarValidationPolicy `tfsdk:"policy"`
+	Schema      types.String            `tfsdk:"schema"`
+	Valid       types.Bool              `tfsdk:"valid"`
+}
+
+type cedarValidationPolicy struct {
+	ID        types.String `tfsdk:"id"`
+	Statement types.String `tfsdk:"statement"`
+}
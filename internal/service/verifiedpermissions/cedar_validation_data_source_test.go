@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVerifiedPermissionsCedarValidationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_verifiedpermissions_cedar_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCedarValidationDataSourceConfig_basic(`PhotoApp::User::\"alice\"`, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "valid", "true"),
+				),
+			},
+			{
+				Config: testAccCedarValidationDataSourceConfig_basic(`PhotoApp::Person::\"alice\"`, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "1"),
+					resource.TestMatchResourceAttr(dataSourceName, "errors.0", regexache.MustCompile(`undeclared entity type "PhotoApp::Person"`)),
+					resource.TestCheckResourceAttr(dataSourceName, "valid", "false"),
+				),
+			},
+			{
+				Config:      testAccCedarValidationDataSourceConfig_basic(`PhotoApp::Person::\"alice\"`, true),
+				ExpectError: regexache.MustCompile(`undeclared entity type "PhotoApp::Person"`),
+			},
+		},
+	})
+}
+
+func testAccCedarValidationDataSourceConfig_basic(principal string, failOnError bool) string {
+	return fmt.Sprintf(`
+data "aws_verifiedpermissions_cedar_validation" "test" {
+  fail_on_error = %[2]t
+
+  schema = jsonencode({
+    "PhotoApp" = {
+      "entityTypes" = {
+        "User"  = {}
+        "Photo" = {}
+      }
+      "actions" = {
+        "viewPhoto" = {
+          "appliesTo" = {
+            "principalTypes" = ["User"]
+            "resourceTypes"  = ["Photo"]
+          }
+        }
+      }
+    }
+  })
+
+  policy {
+    id        = "view"
+    statement = "permit (principal == %[1]s, action == PhotoApp::Action::\"viewPhoto\", resource);"
+  }
+}
+`, principal, failOnError)
+}
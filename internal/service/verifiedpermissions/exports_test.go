@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+// Exports for use in tests only.
+var (
+	ResourceIdentitySource = newResourceIdentitySource
+	ResourcePolicy         = newResourcePolicy
+	ResourcePolicyStore    = newResourcePolicyStore
+	ResourcePolicyTemplate = newResourcePolicyTemplate
+	ResourceSchema         = newResourceSchema
+
+	FindIdentitySourceByTwoPartKey = findIdentitySourceByTwoPartKey
+	FindPolicyByTwoPartKey         = findPolicyByTwoPartKey
+	FindPolicyStoreByID            = findPolicyStoreByID
+	FindPolicyTemplateByTwoPartKey = findPolicyTemplateByTwoPartKey
+	FindSchemaByPolicyStoreID      = findSchemaByPolicyStoreID
+)
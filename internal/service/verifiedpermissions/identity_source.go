@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Identity Source")
+func newResourceIdentitySource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceIdentitySource{}, nil
+}
+
+type resourceIdentitySource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceIdentitySource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_identity_source"
+}
+
+func (r *resourceIdentitySource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"discovery_url": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"identity_source_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"open_id_issuer": schema.StringAttribute{
+				Computed: true,
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_entity_type": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"cognito_user_pool_configuration": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"client_ids": schema.SetAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"user_pool_arn": schema.StringAttribute{
+						CustomType: fwtypes.ARNType,
+						Optional:   true,
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.IsRequired(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceIdentitySource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	input := &verifiedpermissions.CreateIdentitySourceInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Configuration: &awstypes.ConfigurationMemberCognitoUserPoolConfiguration{
+			Value: awstypes.CognitoUserPoolConfiguration{
+				ClientIds:   flex.ExpandFrameworkStringValueSet(ctx, data.CognitoUserPoolConfiguration.ClientIDs),
+				UserPoolArn: flex.ARNStringFromFramework(ctx, data.CognitoUserPoolConfiguration.UserPoolARN),
+			},
+		},
+		PolicyStoreId:       flex.StringFromFramework(ctx, data.PolicyStoreID),
+		PrincipalEntityType: flex.StringFromFramework(ctx, data.PrincipalEntityType),
+	}
+
+	output, err := conn.CreateIdentitySource(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Verified Permissions Identity Source (%s)", data.PolicyStoreID.ValueString()), err.Error())
+
+		return
+	}
+
+	identitySourceID := aws.ToString(output.IdentitySourceId)
+	data.ID = types.StringValue(createPolicyStoreChildID(data.PolicyStoreID.ValueString(), identitySourceID))
+	data.IdentitySourceID = types.StringValue(identitySourceID)
+
+	// Set values for unknowns.
+	identitySource, err := findIdentitySourceByTwoPartKey(ctx, conn, data.PolicyStoreID.ValueString(), identitySourceID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Permissions Identity Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.DiscoveryURL = flex.StringToFramework(ctx, identitySource.Details.DiscoveryUrl)
+	data.OpenIDIssuer = flex.StringValueToFramework(ctx, identitySource.Details.OpenIdIssuer)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceIdentitySource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policyStoreID, identitySourceID, err := parsePolicyStoreChildID(data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	output, err := findIdentitySourceByTwoPartKey(ctx, conn, policyStoreID, identitySourceID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Permissions Identity Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.CognitoUserPoolConfiguration = &cognitoUserPoolConfigurationModel{
+		ClientIDs:   flex.FlattenFrameworkStringValueSet(ctx, output.Details.ClientIds),
+		UserPoolARN: flex.StringToFrameworkARN(ctx, output.Details.UserPoolArn, &response.Diagnostics),
+	}
+	data.DiscoveryURL = flex.StringToFramework(ctx, output.Details.DiscoveryUrl)
+	data.IdentitySourceID = flex.StringToFramework(ctx, output.IdentitySourceId)
+	data.OpenIDIssuer = flex.StringValueToFramework(ctx, output.Details.OpenIdIssuer)
+	data.PolicyStoreID = flex.StringToFramework(ctx, output.PolicyStoreId)
+	data.PrincipalEntityType = flex.StringToFramework(ctx, output.PrincipalEntityType)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceIdentitySource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	if !new.CognitoUserPoolConfiguration.ClientIDs.Equal(old.CognitoUserPoolConfiguration.ClientIDs) ||
+		!new.CognitoUserPoolConfiguration.UserPoolARN.Equal(old.CognitoUserPoolConfiguration.UserPoolARN) ||
+		!new.PrincipalEntityType.Equal(old.PrincipalEntityType) {
+		input := &verifiedpermissions.UpdateIdentitySourceInput{
+			IdentitySourceId:    flex.StringFromFramework(ctx, new.IdentitySourceID),
+			PolicyStoreId:       flex.StringFromFramework(ctx, new.PolicyStoreID),
+			PrincipalEntityType: flex.StringFromFramework(ctx, new.PrincipalEntityType),
+			UpdateConfiguration: &awstypes.UpdateConfigurationMemberCognitoUserPoolConfiguration{
+				Value: awstypes.UpdateCognitoUserPoolConfiguration{
+					ClientIds:   flex.ExpandFrameworkStringValueSet(ctx, new.CognitoUserPoolConfiguration.ClientIDs),
+					UserPoolArn: flex.ARNStringFromFramework(ctx, new.CognitoUserPoolConfiguration.UserPoolARN),
+				},
+			},
+		}
+
+		_, err := conn.UpdateIdentitySource(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Verified Permissions Identity Source (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		identitySource, err := findIdentitySourceByTwoPartKey(ctx, conn, new.PolicyStoreID.ValueString(), new.IdentitySourceID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Verified Permissions Identity Source (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.DiscoveryURL = flex.StringToFramework(ctx, identitySource.Details.DiscoveryUrl)
+		new.OpenIDIssuer = flex.StringValueToFramework(ctx, identitySource.Details.OpenIdIssuer)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceIdentitySource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	_, err := conn.DeleteIdentitySource(ctx, &verifiedpermissions.DeleteIdentitySourceInput{
+		IdentitySourceId: flex.StringFromFramework(ctx, data.IdentitySourceID),
+		PolicyStoreId:    flex.StringFromFramework(ctx, data.PolicyStoreID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Verified Permissions Identity Source (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type resourceIdentitySourceData struct {
+	CognitoUserPoolConfiguration *cognitoUserPoolConfigurationModel `tfsdk:"cognito_user_pool_configuration"`
+	DiscoveryURL                 types.String                       `tfsdk:"discovery_url"`
+	ID                           types.String                       `tfsdk:"id"`
+	IdentitySourceID             types.String                       `tfsdk:"identity_source_id"`
+	OpenIDIssuer                 types.String                       `tfsdk:"open_id_issuer"`
+	PolicyStoreID                types.String                       `tfsdk:"policy_store_id"`
+	PrincipalEntityType          types.String                       `tfsdk:"principal_entity_type"`
+}
+
+type cognitoUserPoolConfigurationModel struct {
+	ClientIDs   types.Set   `tfsdk:"client_ids"`
+	UserPoolARN fwtypes.ARN `tfsdk:"user_pool_arn"`
+}
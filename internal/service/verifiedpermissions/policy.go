@@ -0,0 +1,381 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Policy")
+func newResourcePolicy(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourcePolicy{}, nil
+}
+
+type resourcePolicy struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourcePolicy) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_policy"
+}
+
+func (r *resourcePolicy) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	entityIdentifierBlock := schema.SingleNestedBlock{
+		Attributes: map[string]schema.Attribute{
+			"entity_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_type": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"policy_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.SingleNestedBlock{
+				Validators: []validator.Object{
+					objectvalidator.IsRequired(),
+				},
+				Blocks: map[string]schema.Block{
+					"static": schema.SingleNestedBlock{
+						Attributes: map[string]schema.Attribute{
+							names.AttrDescription: schema.StringAttribute{
+								Optional: true,
+							},
+							"statement": schema.StringAttribute{
+								Optional: true,
+								PlanModifiers: []planmodifier.String{
+									// A template-linked policy can't be updated to a static policy.
+									stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, request planmodifier.StringRequest, response *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+										response.RequiresReplace = request.StateValue.IsNull()
+									}, "", ""),
+								},
+								Validators: []validator.String{
+									policyStatement(),
+								},
+							},
+						},
+						Validators: []validator.Object{
+							objectvalidator.AlsoRequires(path.MatchRelative().AtName("statement")),
+							objectvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("template_linked")),
+						},
+					},
+					"template_linked": schema.SingleNestedBlock{
+						Attributes: map[string]schema.Attribute{
+							"policy_template_id": schema.StringAttribute{
+								Optional: true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.RequiresReplace(),
+								},
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"principal": entityIdentifierBlock,
+							"resource":  entityIdentifierBlock,
+						},
+						Validators: []validator.Object{
+							objectvalidator.AlsoRequires(path.MatchRelative().AtName("policy_template_id")),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourcePolicy) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourcePolicyData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	input := &verifiedpermissions.CreatePolicyInput{
+		ClientToken:   aws.String(id.UniqueId()),
+		Definition:    data.Definition.expand(ctx),
+		PolicyStoreId: flex.StringFromFramework(ctx, data.PolicyStoreID),
+	}
+
+	output, err := conn.CreatePolicy(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Verified Permissions Policy (%s)", data.PolicyStoreID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	policyID := aws.ToString(output.PolicyId)
+	data.ID = types.StringValue(createPolicyStoreChildID(data.PolicyStoreID.ValueString(), policyID))
+	data.PolicyID = types.StringValue(policyID)
+	data.PolicyType = flex.StringValueToFramework(ctx, output.PolicyType)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourcePolicy) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourcePolicyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policyStoreID, policyID, err := parsePolicyStoreChildID(data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	data.PolicyID = types.StringValue(policyID)
+	data.PolicyStoreID = types.StringValue(policyStoreID)
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	output, err := findPolicyByTwoPartKey(ctx, conn, policyStoreID, policyID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Verified Permissions Policy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Definition = flattenPolicyDefinitionDetail(ctx, output.Definition)
+	data.PolicyType = flex.StringValueToFramework(ctx, output.PolicyType)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourcePolicy) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourcePolicyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	// Only static policies can be updated.
+	if v := new.Definition.Static; v != nil && (old.Definition.Static == nil || !v.Statement.Equal(old.Definition.Static.Statement) || !v.Description.Equal(old.Definition.Static.Description)) {
+		input := &verifiedpermissions.UpdatePolicyInput{
+			Definition: &awstypes.UpdatePolicyDefinitionMemberStatic{
+				Value: awstypes.UpdateStaticPolicyDefinition{
+					Description: flex.StringFromFramework(ctx, v.Description),
+					Statement:   flex.StringFromFramework(ctx, v.Statement),
+				},
+			},
+			PolicyId:      flex.StringFromFramework(ctx, new.PolicyID),
+			PolicyStoreId: flex.StringFromFramework(ctx, new.PolicyStoreID),
+		}
+
+		_, err := conn.UpdatePolicy(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Verified Permissions Policy (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourcePolicy) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourcePolicyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	_, err := conn.DeletePolicy(ctx, &verifiedpermissions.DeletePolicyInput{
+		PolicyId:      flex.StringFromFramework(ctx, data.PolicyID),
+		PolicyStoreId: flex.StringFromFramework(ctx, data.PolicyStoreID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Verified Permissions Policy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type resourcePolicyData struct {
+	Definition    *policyDefinitionModel `tfsdk:"definition"`
+	ID            types.String           `tfsdk:"id"`
+	PolicyID      types.String           `tfsdk:"policy_id"`
+	PolicyStoreID types.String           `tfsdk:"policy_store_id"`
+	PolicyType    types.String           `tfsdk:"policy_type"`
+}
+
+type policyDefinitionModel struct {
+	Static         *staticPolicyDefinitionModel         `tfsdk:"static"`
+	TemplateLinked *templateLinkedPolicyDefinitionModel `tfsdk:"template_linked"`
+}
+
+type staticPolicyDefinitionModel struct {
+	Description types.String `tfsdk:"description"`
+	Statement   types.String `tfsdk:"statement"`
+}
+
+type templateLinkedPolicyDefinitionModel struct {
+	PolicyTemplateID types.String           `tfsdk:"policy_template_id"`
+	Principal        *entityIdentifierModel `tfsdk:"principal"`
+	Resource         *entityIdentifierModel `tfsdk:"resource"`
+}
+
+type entityIdentifierModel struct {
+	EntityID   types.String `tfsdk:"entity_id"`
+	EntityType types.String `tfsdk:"entity_type"`
+}
+
+func (m *policyDefinitionModel) expand(ctx context.Context) awstypes.PolicyDefinition {
+	if m == nil {
+		return nil
+	}
+
+	if v := m.Static; v != nil {
+		return &awstypes.PolicyDefinitionMemberStatic{
+			Value: awstypes.StaticPolicyDefinition{
+				Description: flex.StringFromFramework(ctx, v.Description),
+				Statement:   flex.StringFromFramework(ctx, v.Statement),
+			},
+		}
+	}
+
+	if v := m.TemplateLinked; v != nil {
+		return &awstypes.PolicyDefinitionMemberTemplateLinked{
+			Value: awstypes.TemplateLinkedPolicyDefinition{
+				PolicyTemplateId: flex.StringFromFramework(ctx, v.PolicyTemplateID),
+				Principal:        v.Principal.expand(ctx),
+				Resource:         v.Resource.expand(ctx),
+			},
+		}
+	}
+
+	return nil
+}
+
+func (m *entityIdentifierModel) expand(ctx context.Context) *awstypes.EntityIdentifier {
+	if m == nil {
+		return nil
+	}
+
+	return &awstypes.EntityIdentifier{
+		EntityId:   flex.StringFromFramework(ctx, m.EntityID),
+		EntityType: flex.StringFromFramework(ctx, m.EntityType),
+	}
+}
+
+func flattenPolicyDefinitionDetail(ctx context.Context, apiObject awstypes.PolicyDefinitionDetail) *policyDefinitionModel {
+	switch v := apiObject.(type) {
+	case *awstypes.PolicyDefinitionDetailMemberStatic:
+		return &policyDefinitionModel{
+			Static: &staticPolicyDefinitionModel{
+				Description: flex.StringToFramework(ctx, v.Value.Description),
+				Statement:   flex.StringToFramework(ctx, v.Value.Statement),
+			},
+		}
+
+	case *awstypes.PolicyDefinitionDetailMemberTemplateLinked:
+		return &policyDefinitionModel{
+			TemplateLinked: &templateLinkedPolicyDefinitionModel{
+				PolicyTemplateID: flex.StringToFramework(ctx, v.Value.PolicyTemplateId),
+				Principal:        flattenEntityIdentifier(ctx, v.Value.Principal),
+				Resource:         flattenEntityIdentifier(ctx, v.Value.Resource),
+			},
+		}
+	}
+
+	return nil
+}
+
+func flattenEntityIdentifier(ctx context.Context, apiObject *awstypes.EntityIdentifier) *entityIdentifierModel {
+	if apiObject == nil {
+		return nil
+	}
+
+	return &entityIdentifierModel{
+		EntityID:   flex.StringToFramework(ctx, apiObject.EntityId),
+		EntityType: flex.StringToFramework(ctx, apiObject.EntityType),
+	}
+}
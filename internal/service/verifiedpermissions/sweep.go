@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build sweep
+// +build sweep
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+)
+
+func init() {
+	// Deleting a policy store deletes all its policies, policy templates, identity sources and schema.
+	resource.AddTestSweepers("aws_verifiedpermissions_policy_store", &resource.Sweeper{
+		Name: "aws_verifiedpermissions_policy_store",
+		F:    sweepPolicyStores,
+	})
+	discovery.Register("aws_verifiedpermissions_policy_store", listPolicyStores)
+}
+
+func sweepPolicyStores(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	sweepResources, err := listPolicyStores(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Verified Permissions Policy Store sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Verified Permissions Policy Stores (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Verified Permissions Policy Stores (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listPolicyStores(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.VerifiedPermissionsClient(ctx)
+	input := &verifiedpermissions.ListPolicyStoresInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := verifiedpermissions.NewListPolicyStoresPaginator(conn, input)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.PolicyStores {
+			id := aws.ToString(v.PolicyStoreId)
+
+			log.Printf("[INFO] Deleting Verified Permissions Policy Store: %s", id)
+			sweepResources = append(sweepResources, framework.NewSweepResource(newResourcePolicyStore, client,
+				framework.NewAttribute("id", id),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
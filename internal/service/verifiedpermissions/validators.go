@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// policyStatementValidator validates that a string Attribute's value is a Cedar policy or policy template.
+type policyStatementValidator struct {
+	template bool
+}
+
+// Description describes the validation in plain text formatting.
+func (v policyStatementValidator) Description(_ context.Context) string {
+	if v.template {
+		return "value must be a Cedar policy template"
+	}
+
+	return "value must be a static Cedar policy"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v policyStatementValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v policyStatementValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	policy, err := parseCedarPolicy(request.ConfigValue.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddAttributeError(request.Path, "Invalid Cedar Policy", err.Error())
+
+		return
+	}
+
+	switch {
+	case v.template && !policy.IsTemplate():
+		response.Diagnostics.AddAttributeError(request.Path, "Invalid Cedar Policy Template", "A policy template must contain at least one of the ?principal or ?resource slots.")
+	case !v.template && policy.IsTemplate():
+		response.Diagnostics.AddAttributeError(request.Path, "Invalid Cedar Policy", "A static policy must not contain the ?principal or ?resource slots. Use a policy template instead.")
+	}
+}
+
+// policyStatement returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a syntactically valid static Cedar policy.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func policyStatement() validator.String {
+	return policyStatementValidator{}
+}
+
+// policyTemplateStatement returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a syntactically valid Cedar policy template.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func policyTemplateStatement() validator.String {
+	return policyStatementValidator{template: true}
+}
+
+// schemaDefinitionValidator validates that a string Attribute's value is a Cedar schema in JSON format.
+type schemaDefinitionValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v schemaDefinitionValidator) Description(_ context.Context) string {
+	return "value must be a Cedar schema in JSON format"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v schemaDefinitionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v schemaDefinitionValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseCedarSchema(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(request.Path, "Invalid Cedar Schema", err.Error())
+	}
+}
+
+// schemaDefinition returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a valid Cedar schema in JSON format.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func schemaDefinition() validator.String {
+	return schemaDefinitionValidator{}
+}
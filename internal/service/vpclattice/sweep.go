@@ -7,6 +7,7 @@
 package vpclattice
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,8 +15,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -23,6 +26,7 @@ func init() {
 		Name: "aws_vpclattice_service",
 		F:    sweepServices,
 	})
+	discovery.Register("aws_vpclattice_service", listServices)
 
 	resource.AddTestSweepers("aws_vpclattice_service_network", &resource.Sweeper{
 		Name: "aws_vpclattice_service_network",
@@ -31,6 +35,7 @@ func init() {
 			"aws_vpclattice_service",
 		},
 	})
+	discovery.Register("aws_vpclattice_service_network", listServiceNetworks)
 }
 
 func sweepServices(region string) error {
@@ -39,6 +44,28 @@ func sweepServices(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listServices(ctx, client)
+
+	if awsv2.SkipSweepError(err) || skipSweepErr(err) {
+		log.Printf("[WARN] Skipping VPC Lattice Service sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing VPC Lattice Services (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping VPC Lattice Services (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listServices(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.VPCLatticeClient(ctx)
 	input := &vpclattice.ListServicesInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
@@ -47,13 +74,8 @@ func sweepServices(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if awsv2.SkipSweepError(err) || skipSweepErr(err) {
-			log.Printf("[WARN] Skipping VPC Lattice Service sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error listing VPC Lattice Services (%s): %w", region, err)
+			return nil, err
 		}
 
 		for _, v := range page.Items {
@@ -65,13 +87,7 @@ func sweepServices(region string) error {
 		}
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping VPC Lattice Services (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepServiceNetworks(region string) error {
@@ -80,6 +96,28 @@ func sweepServiceNetworks(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listServiceNetworks(ctx, client)
+
+	if awsv2.SkipSweepError(err) || skipSweepErr(err) {
+		log.Printf("[WARN] Skipping VPC Lattice Service Network sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing VPC Lattice Service Networks (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping VPC Lattice Service Networks (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listServiceNetworks(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.VPCLatticeClient(ctx)
 	input := &vpclattice.ListServiceNetworksInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
@@ -88,13 +126,8 @@ func sweepServiceNetworks(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 
-		if awsv2.SkipSweepError(err) || skipSweepErr(err) {
-			log.Printf("[WARN] Skipping VPC Lattice Service Network sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error listing VPC Lattice Service Networks (%s): %w", region, err)
+			return nil, err
 		}
 
 		for _, v := range page.Items {
@@ -106,13 +139,7 @@ func sweepServiceNetworks(region string) error {
 		}
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping VPC Lattice Service Networks (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func skipSweepErr(err error) bool {
@@ -14,8 +14,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/workspaces"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
 )
 
 func init() {
@@ -27,16 +29,19 @@ func init() {
 			"aws_workspaces_ip_group",
 		},
 	})
+	discovery.Register("aws_workspaces_directory", listDirectories)
 
 	resource.AddTestSweepers("aws_workspaces_ip_group", &resource.Sweeper{
 		Name: "aws_workspaces_ip_group",
 		F:    sweepIPGroups,
 	})
+	discovery.Register("aws_workspaces_ip_group", listIPGroups)
 
 	resource.AddTestSweepers("aws_workspaces_workspace", &resource.Sweeper{
 		Name: "aws_workspaces_workspace",
 		F:    sweepWorkspace,
 	})
+	discovery.Register("aws_workspaces_workspace", listWorkspace)
 }
 
 func sweepDirectories(region string) error {
@@ -45,6 +50,28 @@ func sweepDirectories(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listDirectories(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping WorkSpaces Directory sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing WorkSpaces Directories (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WorkSpaces Directories (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listDirectories(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &workspaces.DescribeWorkspaceDirectoriesInput{}
 	conn := client.WorkSpacesClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
@@ -53,13 +80,8 @@ func sweepDirectories(region string) error {
 	for paginator.HasMorePages() {
 		page, err := paginator.NextPage(ctx)
 
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping WorkSpaces Directory sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error listing WorkSpaces Directories (%s): %w", region, err)
+			return nil, err
 		}
 
 		for _, v := range page.Directories {
@@ -71,13 +93,7 @@ func sweepDirectories(region string) error {
 		}
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping WorkSpaces Directories (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
 
 func sweepIPGroups(region string) error {
@@ -86,11 +102,33 @@ func sweepIPGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
+
+	sweepResources, err := listIPGroups(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping WorkSpaces IP Group sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing WorkSpaces IP Groups (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WorkSpaces IP Groups (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listIPGroups(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.WorkSpacesClient(ctx)
 	input := &workspaces.DescribeIpGroupsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = describeIPGroupsPages(ctx, conn, input, func(page *workspaces.DescribeIpGroupsOutput, lastPage bool) bool {
+	err := describeIPGroupsPages(ctx, conn, input, func(page *workspaces.DescribeIpGroupsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -106,22 +144,11 @@ func sweepIPGroups(region string) error {
 		return !lastPage
 	})
 
-	if awsv2.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping WorkSpaces IP Group sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("error listing WorkSpaces IP Groups (%s): %w", region, err)
-	}
-
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
 	if err != nil {
-		return fmt.Errorf("error sweeping WorkSpaces IP Groups (%s): %w", region, err)
+		return nil, err
 	}
 
-	return nil
+	return sweepResources, nil
 }
 
 func describeIPGroupsPages(ctx context.Context, conn *workspaces.Client, input *workspaces.DescribeIpGroupsInput, fn func(*workspaces.DescribeIpGroupsOutput, bool) bool) error {
@@ -147,6 +174,28 @@ func sweepWorkspace(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
+
+	sweepResources, err := listWorkspace(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping WorkSpaces Workspace sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing WorkSpaces Workspaces (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WorkSpaces Workspaces (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listWorkspace(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &workspaces.DescribeWorkspacesInput{}
 	conn := client.WorkSpacesClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
@@ -155,13 +204,8 @@ func sweepWorkspace(region string) error {
 	for paginator.HasMorePages() {
 		page, err := paginator.NextPage(ctx)
 
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping WorkSpaces Workspace sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error listing WorkSpaces Workspaces (%s): %w", region, err)
+			return nil, err
 		}
 
 		for _, v := range page.Workspaces {
@@ -173,11 +217,5 @@ func sweepWorkspace(region string) error {
 		}
 	}
 
-	err = sweep.SweepOrchestrator(ctx, sweepResources)
-
-	if err != nil {
-		return fmt.Errorf("error sweeping WorkSpaces Workspaces (%s): %w", region, err)
-	}
-
-	return nil
+	return sweepResources, nil
 }
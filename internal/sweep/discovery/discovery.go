@@ -0,0 +1,208 @@
+// Package discovery lists existing resources so that Terraform import blocks can be generated for them.
+// Resource types are discovered using the list step of their sweepers: each sweeper that lists its resources in a
+// separate function registers that function, e.g.
+//
+//	resource.AddTestSweepers("aws_sqs_queue", &resource.Sweeper{
+//		Name: "aws_sqs_queue",
+//		F:    sweepQueues,
+//	})
+//	discovery.Register("aws_sqs_queue", listQueues)
+//
+// Sweepers are only built with the "sweep" build tag.
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv1"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// Resource is an existing resource.
+type Resource struct {
+	// ImportID is the ID that the resource is imported with.
+	ImportID string
+	Region   string
+	Type     string
+
+	sweepable identifiable
+}
+
+// ListFunc lists the existing resources of a type in the AWS client's Region, as Sweepables.
+// It is the list step of the resource type's sweeper, so only calls AWS API operations that don't modify resources.
+type ListFunc func(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error)
+
+// identifiable is implemented by the Sweepables of Plugin SDK and Framework resources,
+// see sweep.NewSweepResource and framework.NewSweepResource.
+type identifiable interface {
+	// ImportID returns the ID that the resource is imported with.
+	ImportID(ctx context.Context) (string, error)
+	// Attribute returns the value of a top-level string attribute, reading the resource if the value isn't known.
+	Attribute(ctx context.Context, name string) (string, error)
+}
+
+// listFuncs holds the registered ListFuncs, by resource type.
+var listFuncs = make(map[string]ListFunc)
+
+// Register registers the function that lists the existing resources of the specified type.
+func Register(resourceType string, f ListFunc) {
+	if _, ok := listFuncs[resourceType]; ok {
+		panic(fmt.Sprintf("discovery: duplicate registration for %s", resourceType))
+	}
+
+	listFuncs[resourceType] = f
+}
+
+// List lists the existing resources of the specified type in the AWS client's Region.
+// An error is returned if the resource type doesn't support discovery.
+func List(ctx context.Context, client *conns.AWSClient, resourceType string) ([]Resource, error) {
+	f, ok := listFuncs[resourceType]
+
+	if !ok {
+		return nil, fmt.Errorf("resource type (%s) doesn't support discovery", resourceType)
+	}
+
+	sweepables, err := f(ctx, client)
+
+	if awsv1.SkipSweepError(err) || awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping %s discovery for %s: %s", resourceType, client.Region, err)
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]Resource, 0, len(sweepables))
+
+	for _, v := range sweepables {
+		sweepable, ok := v.(identifiable)
+
+		if !ok {
+			return nil, fmt.Errorf("resource type (%s) lists resources that can't be identified (%T)", resourceType, v)
+		}
+
+		id, err := sweepable.ImportID(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("resource type (%s): %w", resourceType, err)
+		}
+
+		resources = append(resources, Resource{
+			ImportID:  id,
+			Region:    client.Region,
+			Type:      resourceType,
+			sweepable: sweepable,
+		})
+	}
+
+	return resources, nil
+}
+
+// ResourceTypes returns the types of the resources that can be discovered, sorted.
+func ResourceTypes() []string {
+	resourceTypes := make([]string, 0, len(listFuncs))
+
+	for k := range listFuncs {
+		resourceTypes = append(resourceTypes, k)
+	}
+
+	sort.Strings(resourceTypes)
+
+	return resourceTypes
+}
+
+// ReadTags reads a discovered resource's tags using its service package's generic tag listing function.
+func ReadTags(ctx context.Context, meta *conns.AWSClient, resource Resource) (map[string]string, error) {
+	sp, spt, ok := resourceTagsSpec(ctx, meta, resource.Type)
+
+	if !ok || resource.sweepable == nil {
+		return nil, fmt.Errorf("resource type (%s) doesn't support reading tags", resource.Type)
+	}
+
+	identifier, err := resource.sweepable.Attribute(ctx, spt.IdentifierAttribute)
+
+	if err != nil {
+		return nil, fmt.Errorf("resource type (%s) tags identifier: %w", resource.Type, err)
+	}
+
+	ctx = conns.NewResourceContext(ctx, sp.ServicePackageName(), resource.Type)
+	ctx = tftags.NewContext(ctx, nil, nil)
+
+	return listTags(ctx, meta, sp, spt, identifier)
+}
+
+// resourceTagsSpec returns the service package implementing the specified resource type and the resource's tagging information.
+func resourceTagsSpec(ctx context.Context, meta *conns.AWSClient, resourceType string) (conns.ServicePackage, *types.ServicePackageResourceTags, bool) {
+	for _, sp := range meta.ServicePackages {
+		for _, v := range sp.SDKResources(ctx) {
+			if v.TypeName == resourceType {
+				return sp, v.Tags, v.Tags != nil
+			}
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			r, err := v.Factory(ctx)
+
+			if err != nil {
+				continue
+			}
+
+			var response fwresource.MetadataResponse
+			r.Metadata(ctx, fwresource.MetadataRequest{}, &response)
+
+			if response.TypeName == resourceType {
+				return sp, v.Tags, v.Tags != nil
+			}
+		}
+	}
+
+	return nil, nil, false
+}
+
+// listTags lists a resource's tags using its service package's generic tag listing function.
+// The Context must have been enhanced with tagging information.
+func listTags(ctx context.Context, meta *conns.AWSClient, sp conns.ServicePackage, spt *types.ServicePackageResourceTags, identifier string) (map[string]string, error) {
+	var err error
+
+	if v, ok := sp.(interface {
+		ListTags(context.Context, any, string) error
+	}); ok {
+		err = v.ListTags(ctx, meta, identifier) // Sets tags in Context
+	} else if v, ok := sp.(interface {
+		ListTags(context.Context, any, string, string) error
+	}); ok && spt.ResourceType != "" {
+		err = v.ListTags(ctx, meta, identifier, spt.ResourceType) // Sets tags in Context
+	} else {
+		return nil, fmt.Errorf("service package (%s) doesn't support listing tags", sp.ServicePackageName())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tagsFromContext(ctx, sp.ServicePackageName()), nil
+}
+
+// tagsFromContext returns the non-system tags read from AWS and set in Context, if any.
+func tagsFromContext(ctx context.Context, servicePackageName string) map[string]string {
+	inContext, ok := tftags.FromContext(ctx)
+
+	if !ok {
+		return nil
+	}
+
+	if inContext.TagsOut.IsNone() {
+		return nil
+	}
+
+	return inContext.TagsOut.MustUnwrap().IgnoreSystem(servicePackageName).Map()
+}
@@ -5,6 +5,8 @@ package framework
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -99,6 +101,33 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// ImportID returns the ID that the resource is imported with, its "id" attribute.
+func (sr *sweepResource) ImportID(ctx context.Context) (string, error) {
+	resource, err := sr.factory(ctx)
+
+	if err != nil {
+		return "", err
+	}
+
+	if _, ok := resource.(fwresource.ResourceWithImportState); !ok {
+		return "", errors.New("resource doesn't support import")
+	}
+
+	return sr.Attribute(ctx, "id")
+}
+
+// Attribute returns the value of a top-level string attribute.
+// Only the attributes that the resource was created with are known.
+func (sr *sweepResource) Attribute(_ context.Context, name string) (string, error) {
+	for _, attr := range sr.attributes {
+		if v, ok := attr.value.(string); ok && attr.path == name && v != "" {
+			return v, nil
+		}
+	}
+
+	return "", fmt.Errorf("attribute (%s) not set", name)
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
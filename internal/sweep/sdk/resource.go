@@ -5,6 +5,8 @@ package sdk
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -58,6 +60,42 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// ImportID returns the ID that the resource is imported with, its ID.
+func (sr *sweepResource) ImportID(_ context.Context) (string, error) {
+	if sr.resource.Importer == nil {
+		return "", errors.New("resource doesn't support import")
+	}
+
+	id := sr.d.Id()
+
+	if id == "" {
+		return "", errors.New("resource has no ID")
+	}
+
+	return id, nil
+}
+
+// Attribute returns the value of a top-level string attribute, reading the resource if the value isn't set.
+func (sr *sweepResource) Attribute(ctx context.Context, name string) (string, error) {
+	if name == "id" {
+		return sr.d.Id(), nil
+	}
+
+	if v, ok := sr.d.Get(name).(string); ok && v != "" {
+		return v, nil
+	}
+
+	if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return "", err
+	}
+
+	if v, ok := sr.d.Get(name).(string); ok && v != "" {
+		return v, nil
+	}
+
+	return "", fmt.Errorf("attribute (%s) not set", name)
+}
+
 func deleteResource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient) error {
 	if resource.DeleteContext != nil || resource.DeleteWithoutTimeout != nil {
 		var diags diag.Diagnostics
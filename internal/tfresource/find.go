@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfresource
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// ListFunc lists objects of type T, passing each page of objects to `fn`.
+// Listing stops early if `fn` returns false.
+type ListFunc[T any] func(ctx context.Context, fn func([]T) bool) error
+
+// ListPages returns a ListFunc that pages through the results of an AWS SDK for Go v1 `...PagesWithContext` method.
+// `items` extracts the objects from a single page of output.
+func ListPages[I, O, T any](pages func(context.Context, I, func(O, bool) bool, ...request.Option) error, input I, items func(O) []T) ListFunc[T] {
+	return func(ctx context.Context, fn func([]T) bool) error {
+		return pages(ctx, input, func(page O, lastPage bool) bool {
+			if isNil(page) {
+				return !lastPage
+			}
+
+			return fn(items(page)) && !lastPage
+		})
+	}
+}
+
+// ListPagesV2 returns a ListFunc that pages through the results of an AWS SDK for Go v2 paginator.
+// The paginator's HasMorePages and NextPage methods are passed as method values, e.g.
+//
+//	paginator := ec2.NewDescribeVpcsPaginator(conn, input)
+//	list := tfresource.ListPagesV2(paginator.HasMorePages, paginator.NextPage, func(page *ec2.DescribeVpcsOutput) []awstypes.Vpc { return page.Vpcs })
+func ListPagesV2[O, T, Options any](hasMorePages func() bool, nextPage func(context.Context, ...func(*Options)) (O, error), items func(O) []T) ListFunc[T] {
+	return func(ctx context.Context, fn func([]T) bool) error {
+		for hasMorePages() {
+			page, err := nextPage(ctx)
+
+			if err != nil {
+				return err
+			}
+
+			if isNil(page) {
+				continue
+			}
+
+			if !fn(items(page)) {
+				break
+			}
+		}
+
+		return nil
+	}
+}
+
+// ListOnce returns a ListFunc for a non-paginated AWS SDK for Go v1 or v2 API operation.
+func ListOnce[I, O, T, Option any](op func(context.Context, I, ...Option) (O, error), input I, items func(O) []T) ListFunc[T] {
+	return func(ctx context.Context, fn func([]T) bool) error {
+		output, err := op(ctx, input)
+
+		if err != nil {
+			return err
+		}
+
+		if !isNil(output) {
+			fn(items(output))
+		}
+
+		return nil
+	}
+}
+
+// Finder describes how objects of type T are found.
+type Finder[T any] struct {
+	// List lists candidate objects. Required.
+	List ListFunc[T]
+	// Filter, if set, is a predicate that each object must satisfy to be returned.
+	Filter func(T) bool
+	// NotFound, if set, reports whether an API error indicates that the requested object does not exist.
+	NotFound func(error) bool
+	// LastRequest is recorded on any returned retry.NotFoundError.
+	LastRequest interface{}
+}
+
+// NotFoundErrCodes returns a Finder.NotFound function matching any of the specified AWS SDK for Go v1 error codes.
+func NotFoundErrCodes(codes ...string) func(error) bool {
+	return func(err error) bool {
+		return tfawserr.ErrCodeEquals(err, codes...)
+	}
+}
+
+// NotFoundErrCodesV2 returns a Finder.NotFound function matching any of the specified AWS SDK for Go v2 error codes.
+func NotFoundErrCodesV2(codes ...string) func(error) bool {
+	return func(err error) bool {
+		return tfawserr_sdkv2.ErrCodeEquals(err, codes...)
+	}
+}
+
+// Find returns all objects matching the Finder.
+// API errors matching the Finder's NotFound function are returned as retry.NotFoundError.
+func Find[T any](ctx context.Context, f Finder[T]) ([]T, error) {
+	var output []T
+
+	err := f.List(ctx, func(page []T) bool {
+		for _, v := range page {
+			if f.Filter == nil || f.Filter(v) {
+				output = append(output, v)
+			}
+		}
+
+		return true
+	})
+
+	if f.NotFound != nil && f.NotFound(err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: f.LastRequest,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindPtrs returns all non-nil objects matching the Finder.
+// It is typically used with AWS SDK for Go v1 APIs, which return slices of pointers.
+func FindPtrs[T any](ctx context.Context, f Finder[*T]) ([]*T, error) {
+	filter := f.Filter
+	f.Filter = func(v *T) bool {
+		return v != nil && (filter == nil || filter(v))
+	}
+
+	return Find(ctx, f)
+}
+
+// FindSinglePtr returns the single non-nil object matching the Finder.
+// An EmptyResultError is returned if there is no matching object and a TooManyResultsError if there is more than one.
+func FindSinglePtr[T any](ctx context.Context, f Finder[*T]) (*T, error) {
+	output, err := FindPtrs(ctx, f)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return assertSingleResult(output, f.LastRequest)
+}
+
+// FindSingleValue returns the single object matching the Finder.
+// An EmptyResultError is returned if there is no matching object and a TooManyResultsError if there is more than one.
+// It is typically used with AWS SDK for Go v2 APIs, which return slices of values.
+func FindSingleValue[T any](ctx context.Context, f Finder[T]) (*T, error) {
+	output, err := Find(ctx, f)
+
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := assertSingleResult(output, f.LastRequest)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+func assertSingleResult[T any](a []T, lastRequest interface{}) (T, error) {
+	var zero T
+
+	if l := len(a); l == 0 {
+		return zero, NewEmptyResultError(lastRequest)
+	} else if l > 1 {
+		return zero, NewTooManyResultsError(l, lastRequest)
+	}
+
+	return a[0], nil
+}
+
+// isNil returns whether v is nil, including typed nil pointers.
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+		return rv.IsNil()
+	}
+
+	return false
+}
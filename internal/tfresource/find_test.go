@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfresource
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+type testFindInput struct {
+	Name string
+}
+
+type testFindOutput struct {
+	Items []*string
+}
+
+func testListPagesFunc(pages [][]*string, err error) func(context.Context, *testFindInput, func(*testFindOutput, bool) bool, ...request.Option) error {
+	return func(_ context.Context, _ *testFindInput, fn func(*testFindOutput, bool) bool, _ ...request.Option) error {
+		for i, page := range pages {
+			var output *testFindOutput
+			if page != nil {
+				output = &testFindOutput{Items: page}
+			}
+			if !fn(output, i == len(pages)-1) {
+				break
+			}
+		}
+
+		return err
+	}
+}
+
+func testFindItems(page *testFindOutput) []*string {
+	return page.Items
+}
+
+func testString(s string) *string {
+	return &s
+}
+
+func TestFindPtrs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	errNotFound := errors.New("not found")
+
+	testCases := []struct {
+		name            string
+		pages           [][]*string
+		listErr         error
+		filter          func(*string) bool
+		expectedCount   int
+		expectNotFound  bool
+		expectOtherFail bool
+	}{
+		{
+			name: "no pages",
+		},
+		{
+			name:          "single page",
+			pages:         [][]*string{{testString("a"), testString("b")}},
+			expectedCount: 2,
+		},
+		{
+			name:          "multiple pages with nils",
+			pages:         [][]*string{{testString("a"), nil}, nil, {testString("c")}},
+			expectedCount: 2,
+		},
+		{
+			name:   "filtered",
+			pages:  [][]*string{{testString("a"), testString("b")}, {testString("c")}},
+			filter: func(v *string) bool { return *v != "b" },
+
+			expectedCount: 2,
+		},
+		{
+			name:           "not found error",
+			listErr:        errNotFound,
+			expectNotFound: true,
+		},
+		{
+			name:            "other error",
+			listErr:         errors.New("test"),
+			expectOtherFail: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			input := &testFindInput{Name: "test"}
+			output, err := FindPtrs(ctx, Finder[*string]{
+				List:        ListPages(testListPagesFunc(testCase.pages, testCase.listErr), input, testFindItems),
+				Filter:      testCase.filter,
+				NotFound:    func(err error) bool { return errors.Is(err, errNotFound) },
+				LastRequest: input,
+			})
+
+			if got, want := NotFound(err), testCase.expectNotFound; got != want {
+				t.Errorf("NotFound = %t, want %t (err: %v)", got, want, err)
+			}
+			if got, want := err != nil && !NotFound(err), testCase.expectOtherFail; got != want {
+				t.Errorf("other error = %t, want %t (err: %v)", got, want, err)
+			}
+			if got, want := len(output), testCase.expectedCount; got != want {
+				t.Errorf("len(output) = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestFindSinglePtr(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		name             string
+		pages            [][]*string
+		expected         string
+		expectEmpty      bool
+		expectTooManyErr bool
+	}{
+		{
+			name:        "empty",
+			expectEmpty: true,
+		},
+		{
+			name:        "only nils",
+			pages:       [][]*string{{nil}},
+			expectEmpty: true,
+		},
+		{
+			name:     "one",
+			pages:    [][]*string{{nil}, {testString("a")}},
+			expected: "a",
+		},
+		{
+			name:             "too many",
+			pages:            [][]*string{{testString("a")}, {testString("b")}},
+			expectTooManyErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			input := &testFindInput{}
+			output, err := FindSinglePtr(ctx, Finder[*string]{
+				List: ListPages(testListPagesFunc(testCase.pages, nil), input, testFindItems),
+			})
+
+			if got, want := errors.Is(err, ErrEmptyResult), testCase.expectEmpty; got != want {
+				t.Errorf("EmptyResultError = %t, want %t (err: %v)", got, want, err)
+			}
+			if got, want := errors.Is(err, ErrTooManyResults), testCase.expectTooManyErr; got != want {
+				t.Errorf("TooManyResultsError = %t, want %t (err: %v)", got, want, err)
+			}
+			if err == nil {
+				if got, want := *output, testCase.expected; got != want {
+					t.Errorf("output = %q, want %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+type testPaginator struct {
+	pages [][]string
+}
+
+type testPaginatorOptions struct{}
+
+func (p *testPaginator) HasMorePages() bool {
+	return len(p.pages) > 0
+}
+
+func (p *testPaginator) NextPage(_ context.Context, _ ...func(*testPaginatorOptions)) (*[]string, error) {
+	page := p.pages[0]
+	p.pages = p.pages[1:]
+
+	return &page, nil
+}
+
+func TestFindSingleValue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	paginator := &testPaginator{pages: [][]string{{"a", "b"}, {"c"}}}
+
+	output, err := FindSingleValue(ctx, Finder[string]{
+		List:   ListPagesV2(paginator.HasMorePages, paginator.NextPage, func(page *[]string) []string { return *page }),
+		Filter: func(v string) bool { return v == "c" },
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := *output, "c"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestListOnce(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	op := func(_ context.Context, input *testFindInput, _ ...request.Option) (*testFindOutput, error) {
+		if input.Name == "" {
+			return nil, nil
+		}
+
+		return &testFindOutput{Items: []*string{testString(input.Name)}}, nil
+	}
+
+	output, err := FindSinglePtr(ctx, Finder[*string]{
+		List: ListOnce(op, &testFindInput{Name: "a"}, testFindItems),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := *output, "a"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+
+	_, err = FindSinglePtr(ctx, Finder[*string]{
+		List: ListOnce(op, &testFindInput{}, testFindItems),
+	})
+
+	if !NotFound(err) {
+		t.Errorf("expected NotFound error, got %v", err)
+	}
+}
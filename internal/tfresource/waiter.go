@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfresource
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// StatusFunc returns a retry.StateRefreshFunc that finds an object using `find` and extracts its status using `status`.
+// If `find` returns a retry.NotFoundError the refresh function returns a nil object and an empty status,
+// which a retry.StateChangeConf with an empty Target treats as success.
+func StatusFunc[T any, S ~string](ctx context.Context, find func(context.Context) (*T, error), status func(*T) S) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := find(ctx)
+
+		if NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(status(output)), nil
+	}
+}
+
+// Waiter describes waiting for an object of type T to reach one of a set of target statuses.
+// S is the status type, typically `string` for AWS SDK for Go v1 APIs or an enum type for AWS SDK for Go v2 APIs.
+// Any status other than the Pending and Target statuses, e.g. a failed status, stops the wait with a retry.UnexpectedStateError.
+type Waiter[T any, S ~string] struct {
+	// Find finds the object. Required.
+	Find func(context.Context) (*T, error)
+	// Status extracts the object's status. Required.
+	Status func(*T) S
+	// FailureReason, if set, extracts the reason for an unexpected status, e.g. the object's status message.
+	// The reason is set as the LastError of any timeout or unexpected state error.
+	FailureReason func(*T) error
+
+	Pending []S
+	// Target statuses. An empty Target waits for the object to be deleted.
+	Target  []S
+	Timeout time.Duration
+	Options Options
+}
+
+// Wait waits for the object to reach a target status, returning the last object found.
+func (w Waiter[T, S]) Wait(ctx context.Context) (*T, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: statusStrings(w.Pending),
+		Target:  statusStrings(w.Target),
+		Refresh: StatusFunc(ctx, w.Find, w.Status),
+		Timeout: w.Timeout,
+	}
+
+	w.Options.Apply(stateConf)
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*T); ok {
+		if w.FailureReason != nil {
+			if reason := w.FailureReason(output); reason != nil {
+				SetLastError(err, reason)
+			}
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func statusStrings[S ~string](statuses []S) []string {
+	if statuses == nil {
+		return []string{}
+	}
+
+	output := make([]string, len(statuses))
+
+	for i, v := range statuses {
+		output[i] = string(v)
+	}
+
+	return output
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfresource
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+type testWaiterStatus string
+
+type testWaiterObject struct {
+	Status  testWaiterStatus
+	Message string
+}
+
+// testWaiterFinder returns a finder that returns each of the specified statuses in turn.
+// An empty status is returned as a retry.NotFoundError.
+func testWaiterFinder(statuses ...testWaiterStatus) func(context.Context) (*testWaiterObject, error) {
+	i := 0
+
+	return func(context.Context) (*testWaiterObject, error) {
+		status := statuses[i]
+		if i < len(statuses)-1 {
+			i++
+		}
+
+		if status == "" {
+			return nil, &retry.NotFoundError{}
+		}
+
+		return &testWaiterObject{Status: status, Message: "message: " + string(status)}, nil
+	}
+}
+
+func TestWaiter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		name              string
+		find              func(context.Context) (*testWaiterObject, error)
+		pending           []testWaiterStatus
+		target            []testWaiterStatus
+		expectedStatus    testWaiterStatus
+		expectError       bool
+		expectedLastError string
+	}{
+		{
+			name:           "target reached",
+			find:           testWaiterFinder("CREATING", "CREATING", "ACTIVE"),
+			pending:        []testWaiterStatus{"CREATING"},
+			target:         []testWaiterStatus{"ACTIVE"},
+			expectedStatus: "ACTIVE",
+		},
+		{
+			name:              "failed",
+			find:              testWaiterFinder("CREATING", "FAILED"),
+			pending:           []testWaiterStatus{"CREATING"},
+			target:            []testWaiterStatus{"ACTIVE"},
+			expectedStatus:    "FAILED",
+			expectError:       true,
+			expectedLastError: "message: FAILED",
+		},
+		{
+			name:    "deleted",
+			find:    testWaiterFinder("DELETING", ""),
+			pending: []testWaiterStatus{"DELETING"},
+		},
+		{
+			name: "find error",
+			find: func(context.Context) (*testWaiterObject, error) {
+				return nil, errors.New("test")
+			},
+			pending:     []testWaiterStatus{"CREATING"},
+			target:      []testWaiterStatus{"ACTIVE"},
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			output, err := Waiter[testWaiterObject, testWaiterStatus]{
+				Find:    testCase.find,
+				Status:  func(v *testWaiterObject) testWaiterStatus { return v.Status },
+				Pending: testCase.pending,
+				Target:  testCase.target,
+				FailureReason: func(v *testWaiterObject) error {
+					return errors.New(v.Message)
+				},
+				Timeout: 1 * time.Minute,
+				Options: Options{PollInterval: 1 * time.Millisecond},
+			}.Wait(ctx)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expected error: %t", err, want)
+			}
+
+			if testCase.expectedLastError != "" {
+				var e *retry.UnexpectedStateError
+				if !errors.As(err, &e) {
+					t.Fatalf("expected UnexpectedStateError, got %T", err)
+				}
+				if got, want := e.LastError.Error(), testCase.expectedLastError; got != want {
+					t.Errorf("LastError = %q, want %q", got, want)
+				}
+			}
+
+			if testCase.expectedStatus != "" {
+				if output == nil {
+					t.Fatal("expected output")
+				}
+				if got, want := output.Status, testCase.expectedStatus; got != want {
+					t.Errorf("status = %q, want %q", got, want)
+				}
+			}
+		})
+	}
+}
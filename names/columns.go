@@ -28,5 +28,6 @@ const (
 	ColAllowedSubcategory      = 21
 	ColDeprecatedEnvVar        = 22
 	ColEnvVar                  = 23
-	ColNote                    = 24
+	ColSDKID                   = 24
+	ColNote                    = 25
 )
@@ -35,6 +35,7 @@ const (
 	CodeStarNotificationsEndpointID      = "codestar-notifications"
 	ComprehendEndpointID                 = "comprehend"
 	ComputeOptimizerEndpointID           = "computeoptimizer"
+	DocDBElasticEndpointID               = "docdb-elastic"
 	DSEndpointID                         = "ds"
 	EMRServerlessEndpointID              = "emrserverless"
 	GlacierEndpointID                    = "glacier"
@@ -57,6 +58,7 @@ const (
 	Route53DomainsEndpointID             = "route53domains"
 	SchedulerEndpointID                  = "scheduler"
 	S3EndpointID                         = "s3"
+	SecurityLakeEndpointID               = "securitylake"
 	SESV2EndpointID                      = "sesv2"
 	SSMEndpointID                        = "ssm"
 	SSMContactsEndpointID                = "ssm-contacts"
@@ -64,6 +66,7 @@ const (
 	SWFEndpointID                        = "swf"
 	TimestreamWriteEndpointID            = "ingest.timestream"
 	TranscribeEndpointID                 = "transcribe"
+	VerifiedPermissionsEndpointID        = "verifiedpermissions"
 	VPCLatticeEndpointID                 = "vpc-lattice"
 	XRayEndpointID                       = "xray"
 )
@@ -80,6 +83,7 @@ type ServiceDatum struct {
 	GoV2Package        string
 	HumanFriendly      string
 	ProviderNameUpper  string
+	SDKID              string
 }
 
 // serviceData key is the AWS provider service package
@@ -140,6 +144,7 @@ func readCSVIntoServiceData() error {
 			GoV2Package:        l[ColGoV2Package],
 			HumanFriendly:      l[ColHumanFriendly],
 			ProviderNameUpper:  l[ColProviderNameUpper],
+			SDKID:              l[ColSDKID],
 		}
 
 		a := []string{p}
@@ -212,6 +217,35 @@ func EnvVar(service string) string {
 	return ""
 }
 
+// SDKID returns the service's SDK ID, the unique identifier AWS SDKs use for the service, e.g. "Elastic Beanstalk".
+func SDKID(service string) string {
+	if v, ok := serviceData[service]; ok {
+		return v.SDKID
+	}
+
+	return ""
+}
+
+// AWSServiceEnvVar returns the AWS-standard environment variable used to configure the service's endpoint,
+// e.g. "AWS_ENDPOINT_URL_ELASTIC_BEANSTALK".
+func AWSServiceEnvVar(service string) string {
+	if v := SDKID(service); v != "" {
+		return "AWS_ENDPOINT_URL_" + strings.ToUpper(strings.ReplaceAll(v, " ", "_"))
+	}
+
+	return ""
+}
+
+// AWSConfigFileServiceKey returns the key used to configure the service's endpoint in a `services` section
+// of the AWS shared config file, e.g. "elastic_beanstalk".
+func AWSConfigFileServiceKey(service string) string {
+	if v := SDKID(service); v != "" {
+		return strings.ToLower(strings.ReplaceAll(v, " ", "_"))
+	}
+
+	return ""
+}
+
 func FullHumanFriendly(service string) (string, error) {
 	if v, ok := serviceData[service]; ok {
 		if v.Brand == "" {
@@ -282,6 +282,57 @@ func TestProviderNameUpper(t *testing.T) {
 	}
 }
 
+func TestAWSServiceEnvVar(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName        string
+		Input           string
+		ExpectedEnvVar  string
+		ExpectedFileKey string
+	}{
+		{
+			TestName:        "empty",
+			Input:           "",
+			ExpectedEnvVar:  "",
+			ExpectedFileKey: "",
+		},
+		{
+			TestName:        STS,
+			Input:           STS,
+			ExpectedEnvVar:  "AWS_ENDPOINT_URL_STS",
+			ExpectedFileKey: "sts",
+		},
+		{
+			TestName:        ElasticBeanstalk,
+			Input:           ElasticBeanstalk,
+			ExpectedEnvVar:  "AWS_ENDPOINT_URL_ELASTIC_BEANSTALK",
+			ExpectedFileKey: "elastic_beanstalk",
+		},
+		{
+			TestName:        "doesnotexist",
+			Input:           "doesnotexist",
+			ExpectedEnvVar:  "",
+			ExpectedFileKey: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, expected := AWSServiceEnvVar(testCase.Input), testCase.ExpectedEnvVar; got != expected {
+				t.Errorf("got %s, expected %s", got, expected)
+			}
+
+			if got, expected := AWSConfigFileServiceKey(testCase.Input), testCase.ExpectedFileKey; got != expected {
+				t.Errorf("got %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
 func TestFullHumanFriendly(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
+)
+
+const configurationHeader = `# Generated by tools/importgen.
+#
+# Each resource stub must be completed before applying. Alternatively, remove the stubs and run
+#   terraform plan -generate-config-out=generated.tf
+# to have Terraform generate their configuration.
+`
+
+// writeConfiguration writes Terraform import blocks and resource stubs for the discovered resources.
+// If multiRegion is true, a provider alias is configured for each Region.
+func writeConfiguration(w io.Writer, resources []discovery.Resource, multiRegion bool) error {
+	var sb strings.Builder
+
+	sb.WriteString(configurationHeader)
+
+	if multiRegion {
+		seen := make(map[string]bool)
+
+		for _, r := range resources {
+			if seen[r.Region] {
+				continue
+			}
+			seen[r.Region] = true
+
+			fmt.Fprintf(&sb, "\nprovider \"aws\" {\n  alias  = %s\n  region = %s\n}\n", quote(providerAlias(r.Region)), quote(r.Region))
+		}
+	}
+
+	names := make(map[string]map[string]bool)
+
+	for _, r := range resources {
+		if names[r.Type] == nil {
+			names[r.Type] = make(map[string]bool)
+		}
+		name := uniqueName(resourceName(r.ImportID), names[r.Type])
+		address := r.Type + "." + name
+
+		sb.WriteString("\nimport {\n")
+		if multiRegion {
+			fmt.Fprintf(&sb, "  provider = aws.%s\n", providerAlias(r.Region))
+		}
+		fmt.Fprintf(&sb, "  to       = %s\n", address)
+		fmt.Fprintf(&sb, "  id       = %s\n", quote(r.ImportID))
+		sb.WriteString("}\n")
+
+		fmt.Fprintf(&sb, "\nresource %s %s {\n", quote(r.Type), quote(name))
+		if multiRegion {
+			fmt.Fprintf(&sb, "  provider = aws.%s\n", providerAlias(r.Region))
+		}
+		sb.WriteString("}\n")
+	}
+
+	_, err := io.WriteString(w, sb.String())
+
+	return err
+}
+
+// providerAlias returns the provider alias used for a Region, e.g. "us_west_2".
+func providerAlias(region string) string {
+	return strings.ReplaceAll(region, "-", "_")
+}
+
+// resourceName returns a valid Terraform resource name derived from a resource's import ID.
+func resourceName(id string) string {
+	// Use the last element of any ARN, URL or path.
+	if i := strings.LastIndexAny(id, "/:"); i >= 0 && i < len(id)-1 {
+		id = id[i+1:]
+	}
+
+	var sb strings.Builder
+
+	for _, r := range strings.ToLower(id) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_', r == '-':
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune('_')
+		}
+	}
+
+	name := strings.Trim(sb.String(), "_-")
+
+	if name == "" {
+		return "resource"
+	}
+
+	// Names must start with a letter or underscore.
+	if c := name[0]; c < 'a' || c > 'z' {
+		name = "r_" + name
+	}
+
+	return name
+}
+
+// uniqueName returns name, or name with a numeric suffix if already used.
+func uniqueName(name string, used map[string]bool) string {
+	v := name
+
+	for i := 2; used[v]; i++ {
+		v = fmt.Sprintf("%s_%d", name, i)
+	}
+
+	used[v] = true
+
+	return v
+}
+
+// quote returns a quoted HCL string literal.
+func quote(s string) string {
+	var sb strings.Builder
+
+	sb.WriteRune('"')
+
+	for i, r := range s {
+		switch r {
+		case '"':
+			sb.WriteString(`\"`)
+		case '\\':
+			sb.WriteString(`\\`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\t':
+			sb.WriteString(`\t`)
+		case '$', '%':
+			// Escape template sequences, "${" and "%{".
+			if strings.HasPrefix(s[i+1:], "{") {
+				sb.WriteRune(r)
+			}
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+
+	sb.WriteRune('"')
+
+	return sb.String()
+}
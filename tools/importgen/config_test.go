@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
+)
+
+func TestResourceName(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		ID       string
+		Expected string
+	}{
+		{
+			TestName: "simple",
+			ID:       "example",
+			Expected: "example",
+		},
+		{
+			TestName: "ARN",
+			ID:       "arn:aws:sns:us-west-2:123456789012:My.Topic",
+			Expected: "my_topic",
+		},
+		{
+			TestName: "URL",
+			ID:       "https://sqs.us-west-2.amazonaws.com/123456789012/queue-1",
+			Expected: "queue-1",
+		},
+		{
+			TestName: "leading digit",
+			ID:       "123abc",
+			Expected: "r_123abc",
+		},
+		{
+			TestName: "empty",
+			ID:       "",
+			Expected: "resource",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, expected := resourceName(testCase.ID), testCase.Expected; got != expected {
+				t.Errorf("got %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestQuote(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+	}{
+		{
+			TestName: "simple",
+			Input:    "vpc-12345678",
+			Expected: `"vpc-12345678"`,
+		},
+		{
+			TestName: "escapes",
+			Input:    "a\"b\\c\n",
+			Expected: `"a\"b\\c\n"`,
+		},
+		{
+			TestName: "template sequences",
+			Input:    "${var}%{if}$5%",
+			Expected: `"$${var}%%{if}$5%"`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, expected := quote(testCase.Input), testCase.Expected; got != expected {
+				t.Errorf("got %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestWriteConfiguration(t *testing.T) {
+	t.Parallel()
+
+	resources := []discovery.Resource{
+		{ImportID: "https://sqs.us-west-2.amazonaws.com/123456789012/example", Region: "us-west-2", Type: "aws_sqs_queue"},
+		{ImportID: "https://sqs.us-east-1.amazonaws.com/123456789012/example", Region: "us-east-1", Type: "aws_sqs_queue"},
+	}
+
+	var sb strings.Builder
+
+	if err := writeConfiguration(&sb, resources, true); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := sb.String()
+
+	for _, expected := range []string{
+		"provider \"aws\" {\n  alias  = \"us_west_2\"\n  region = \"us-west-2\"\n}\n",
+		"import {\n  provider = aws.us_west_2\n  to       = aws_sqs_queue.example\n  id       = \"https://sqs.us-west-2.amazonaws.com/123456789012/example\"\n}\n",
+		"import {\n  provider = aws.us_east_1\n  to       = aws_sqs_queue.example_2\n  id       = \"https://sqs.us-east-1.amazonaws.com/123456789012/example\"\n}\n",
+		"resource \"aws_sqs_queue\" \"example_2\" {\n  provider = aws.us_east_1\n}\n",
+	} {
+		if !strings.Contains(got, expected) {
+			t.Errorf("expected configuration to contain:\n%s\ngot:\n%s", expected, got)
+		}
+	}
+}
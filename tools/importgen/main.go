@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// importgen discovers existing AWS resources and generates Terraform import blocks and resource stubs for them.
+// Resources are listed by the list step of their sweepers, so it must be built with the "sweep" build tag.
+// Only resource types whose sweepers register a list function with the discovery package can be discovered.
+//
+//	go run -tags sweep ./tools/importgen -regions=us-west-2 -types=aws_sqs_queue -tag=Environment=production
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/discovery"
+)
+
+type tagsFlag map[string]string
+
+func (f tagsFlag) String() string {
+	var tags []string
+	for k, v := range f {
+		tags = append(tags, k+"="+v)
+	}
+	sort.Strings(tags)
+
+	return strings.Join(tags, ",")
+}
+
+func (f tagsFlag) Set(s string) error {
+	k, v, ok := strings.Cut(s, "=")
+
+	if !ok || k == "" {
+		return fmt.Errorf("invalid tag (%s), expected key=value", s)
+	}
+
+	f[k] = v
+
+	return nil
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tgo run -tags sweep ./tools/importgen -regions=<region>[,<region>...] [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	var (
+		output  = flag.String("output", "", "file to write generated configuration to (default stdout)")
+		regions = flag.String("regions", "", "comma-separated list of AWS Regions to discover resources in (required)")
+		types   = flag.String("types", "", "comma-separated list of resource types to discover (default all types that support discovery)")
+		tags    = tagsFlag{}
+	)
+	flag.Var(tags, "tag", "only discover resources with the specified tag, as key=value (may be repeated)")
+	flag.Usage = usage
+	flag.Parse()
+
+	if *regions == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	resourceTypes := discovery.ResourceTypes()
+
+	if len(resourceTypes) == 0 {
+		log.Fatal("no resource types support discovery, build with -tags sweep")
+	}
+
+	if *types != "" {
+		supported := make(map[string]bool)
+		for _, v := range resourceTypes {
+			supported[v] = true
+		}
+
+		resourceTypes = nil
+		var unsupported []string
+
+		for _, v := range strings.Split(*types, ",") {
+			v = strings.TrimSpace(v)
+
+			if !supported[v] {
+				unsupported = append(unsupported, v)
+				continue
+			}
+
+			resourceTypes = append(resourceTypes, v)
+		}
+
+		if len(unsupported) > 0 {
+			log.Fatalf("resource types don't support discovery: %s\nsupported resource types: %s", strings.Join(unsupported, ", "), strings.Join(discovery.ResourceTypes(), ", "))
+		}
+	}
+
+	ctx := context.Background()
+	sweep.ServicePackages = servicePackages(ctx)
+
+	var resources []discovery.Resource
+	var errs []error
+
+	regionList := strings.Split(*regions, ",")
+	for _, region := range regionList {
+		region = strings.TrimSpace(region)
+		ctx := sweep.Context(region)
+
+		client, err := sweep.SharedRegionalSweepClient(ctx, region)
+
+		if err != nil {
+			log.Fatalf("getting client for %s: %s", region, err)
+		}
+
+		for _, resourceType := range resourceTypes {
+			log.Printf("[INFO] Discovering %s resources in %s", resourceType, region)
+
+			v, err := discovery.List(ctx, client, resourceType)
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("discovering %s resources in %s: %w", resourceType, region, err))
+				continue
+			}
+
+			for _, v := range v {
+				if len(tags) > 0 {
+					resourceTags, err := discovery.ReadTags(ctx, client, v)
+
+					if err != nil {
+						errs = append(errs, fmt.Errorf("reading %s (%s) tags in %s: %w", resourceType, v.ImportID, region, err))
+						continue
+					}
+
+					if !hasTags(resourceTags, tags) {
+						continue
+					}
+				}
+
+				resources = append(resources, v)
+			}
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		log.Fatal(err)
+	}
+
+	sort.SliceStable(resources, func(i, j int) bool {
+		if resources[i].Type != resources[j].Type {
+			return resources[i].Type < resources[j].Type
+		}
+		if resources[i].Region != resources[j].Region {
+			return resources[i].Region < resources[j].Region
+		}
+		return resources[i].ImportID < resources[j].ImportID
+	})
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+
+		if err != nil {
+			log.Fatalf("creating %s: %s", *output, err)
+		}
+
+		defer f.Close()
+
+		w = f
+	}
+
+	if err := writeConfiguration(w, resources, len(regionList) > 1); err != nil {
+		log.Fatalf("writing configuration: %s", err)
+	}
+
+	log.Printf("[INFO] Generated import blocks for %d resources", len(resources))
+}
+
+// hasTags returns whether a resource has all of the specified tags.
+func hasTags(resourceTags, tags map[string]string) bool {
+	for k, v := range tags {
+		if tag, ok := resourceTags[k]; !ok || tag != v {
+			return false
+		}
+	}
+
+	return true
+}